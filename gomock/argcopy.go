@@ -0,0 +1,180 @@
+package gomock
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+// logger is implemented by TestReporters such as [*testing.T] that can log
+// notes which are not failures.
+type logger interface {
+	Logf(format string, args ...any)
+}
+
+// copyArgs returns deep copies of the arguments of a call to method.
+func (ctrl *Controller) copyArgs(method string, args []any) []any {
+	copies := make([]any, len(args))
+	for i, arg := range args {
+		s, err := deepCopy(arg)
+		if err != nil {
+			if l, ok := unwrapTestReporter(ctrl.T).(logger); ok {
+				l.Logf("gomock: recording argument %d of call to %s by reference: %v", i, method, err)
+			}
+			s = arg
+		}
+		copies[i] = s
+	}
+	return copies
+}
+
+// deepCopy returns a deep copy of x.
+func deepCopy(x any) (any, error) {
+	if x == nil {
+		return nil, nil
+	}
+	c := copier{seen: make(map[seenKey]reflect.Value)}
+	v, err := c.copy(reflect.ValueOf(x))
+	if err != nil {
+		return nil, err
+	}
+	return v.Interface(), nil
+}
+
+var (
+	binaryMarshalerType   = reflect.TypeOf((*encoding.BinaryMarshaler)(nil)).Elem()
+	binaryUnmarshalerType = reflect.TypeOf((*encoding.BinaryUnmarshaler)(nil)).Elem()
+)
+
+// seenKey identifies a pointer or map that was already copied, so that
+// shared and cyclic references are preserved in the copy.
+type seenKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// copier deep-copies values using reflection. Types that implement
+// encoding.BinaryMarshaler and encoding.BinaryUnmarshaler are copied by
+// marshaling them, as gob does, which copies types such as time.Time whose
+// fields are unexported. Functions and channels are shared.
+type copier struct {
+	seen map[seenKey]reflect.Value
+}
+
+func (c *copier) copy(v reflect.Value) (reflect.Value, error) {
+	t := v.Type()
+	if t.Kind() != reflect.Pointer && t.Kind() != reflect.Interface {
+		pt := reflect.PointerTo(t)
+		if pt.Implements(binaryMarshalerType) && pt.Implements(binaryUnmarshalerType) {
+			return copyBinary(v)
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v, nil
+		}
+		k := seenKey{v.Pointer(), t}
+		if p, ok := c.seen[k]; ok {
+			return p, nil
+		}
+		p := reflect.New(t.Elem())
+		c.seen[k] = p
+		elem, err := c.copy(v.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		p.Elem().Set(elem)
+		return p, nil
+	case reflect.Map:
+		if v.IsNil() {
+			return v, nil
+		}
+		k := seenKey{v.Pointer(), t}
+		if m, ok := c.seen[k]; ok {
+			return m, nil
+		}
+		m := reflect.MakeMapWithSize(t, v.Len())
+		c.seen[k] = m
+		iter := v.MapRange()
+		for iter.Next() {
+			key, err := c.copy(iter.Key())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			elem, err := c.copy(iter.Value())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			m.SetMapIndex(key, elem)
+		}
+		return m, nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return v, nil
+		}
+		s := reflect.MakeSlice(t, v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem, err := c.copy(v.Index(i))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			s.Index(i).Set(elem)
+		}
+		return s, nil
+	case reflect.Array:
+		a := reflect.New(t).Elem()
+		for i := 0; i < v.Len(); i++ {
+			elem, err := c.copy(v.Index(i))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			a.Index(i).Set(elem)
+		}
+		return a, nil
+	case reflect.Struct:
+		s := reflect.New(t).Elem()
+		for i := 0; i < t.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				return reflect.Value{}, fmt.Errorf("cannot copy %v: it has unexported field %s", t, t.Field(i).Name)
+			}
+			field, err := c.copy(v.Field(i))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			s.Field(i).Set(field)
+		}
+		return s, nil
+	case reflect.Interface:
+		if v.IsNil() {
+			return v, nil
+		}
+		elem, err := c.copy(v.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		i := reflect.New(t).Elem()
+		i.Set(elem)
+		return i, nil
+	default:
+		// Booleans, numbers and strings are values, and functions, channels
+		// and unsafe pointers are shared.
+		return v, nil
+	}
+}
+
+// copyBinary copies v by marshaling and unmarshaling it.
+func copyBinary(v reflect.Value) (reflect.Value, error) {
+	src := reflect.New(v.Type())
+	src.Elem().Set(v)
+	data, err := src.Interface().(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("cannot copy %v: %v", v.Type(), err)
+	}
+	dst := reflect.New(v.Type())
+	if err := dst.Interface().(encoding.BinaryUnmarshaler).UnmarshalBinary(data); err != nil {
+		return reflect.Value{}, fmt.Errorf("cannot copy %v: %v", v.Type(), err)
+	}
+	return dst.Elem(), nil
+}
@@ -0,0 +1,72 @@
+package gomock
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDeepCopy(t *testing.T) {
+	type node struct {
+		Value int
+		Next  *node
+	}
+	loop := &node{Value: 1}
+	loop.Next = loop
+
+	tests := []struct {
+		name string
+		x    any
+	}{
+		{"nil", nil},
+		{"int", 1},
+		{"string", "a"},
+		{"slice", []string{"a", "b"}},
+		{"nil slice", []int(nil)},
+		{"array", [2][]int{{1}, {2}}},
+		{"map", map[string][]int{"a": {1}}},
+		{"struct", struct{ A []int }{[]int{1}}},
+		{"interface", []any{1, "a", []int{1}}},
+		{"time", time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := deepCopy(tt.x)
+			if err != nil {
+				t.Fatalf("deepCopy(%v) failed: %v", tt.x, err)
+			}
+			if !reflect.DeepEqual(got, tt.x) {
+				t.Errorf("deepCopy(%v) = %v, want an equal copy", tt.x, got)
+			}
+		})
+	}
+
+	t.Run("cycle", func(t *testing.T) {
+		got, err := deepCopy(loop)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := got.(*node)
+		if n == loop || n.Next != n || n.Value != 1 {
+			t.Errorf("copy of a cyclic list = %+v, want a distinct cyclic copy", n)
+		}
+	})
+
+	t.Run("shared pointer", func(t *testing.T) {
+		x := new(int)
+		got, err := deepCopy([]*int{x, x})
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := got.([]*int)
+		if s[0] == x || s[0] != s[1] {
+			t.Errorf("copy of a shared pointer = %v, want one new shared pointer", s)
+		}
+	})
+
+	t.Run("unexported field", func(t *testing.T) {
+		if _, err := deepCopy(struct{ a int }{1}); err == nil {
+			t.Error("copy of a struct with an unexported field succeeded")
+		}
+	})
+}
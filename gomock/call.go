@@ -15,10 +15,12 @@
 package gomock
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 // Call represents an expected call to a mock.
@@ -31,6 +33,14 @@ type Call struct {
 	args       []Matcher    // the args
 	origin     string       // file and line number of call setup
 
+	argumentDiffs bool   // report every argument on a mismatch
+	callArgs      bool   // record the args of matched calls in calledArgs
+	mockName      string // the label of the receiver, may be empty
+
+	// contextAwareDefaults makes defaultReturns return the error of a done
+	// context.
+	contextAwareDefaults bool
+
 	preReqs []*Call // prerequisite calls
 
 	// Expectations
@@ -42,6 +52,14 @@ type Call struct {
 	// can set the return values by returning a non-nil slice. Actions run in the
 	// order they are created.
 	actions []func([]any) []any
+
+	goroutine Goroutine // the goroutine the call must be made on, if set
+
+	sequences []*returnSequence // the positions of ReturnsInOrder
+
+	argsMu     sync.Mutex
+	calledArgs [][]any  // the args of each matched call, guarded by argsMu
+	goroutines []string // the goroutines of the matched calls with WithGoroutineTracking, guarded by argsMu
 }
 
 // newCall creates a *Call. It requires the method type in order to support
@@ -67,16 +85,38 @@ func newCall(t TestHelper, receiver any, method string, methodType reflect.Type,
 	// and this line changes, i.e. this code is wrapped in another anonymous function.
 	// 0 is us, 1 is RecordCallWithMethodType(), 2 is the generated recorder, and 3 is the user's test.
 	origin := callerInfo(3)
-	actions := []func([]any) []any{func([]any) []any {
-		// Synthesize the zero value for each of the return args' types.
-		rets := make([]any, methodType.NumOut())
-		for i := 0; i < methodType.NumOut(); i++ {
-			rets[i] = reflect.Zero(methodType.Out(i)).Interface()
-		}
+	c := &Call{t: t, receiver: receiver, method: method, methodType: methodType,
+		args: mArgs, origin: origin, minCalls: 1, maxCalls: 1}
+	c.actions = []func([]any) []any{c.defaultReturns}
+	return c
+}
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// defaultReturns synthesizes the values returned by a call that has no
+// Return, DoAndReturn or ReturnsInOrder: the zero value for each of the
+// return args' types. With contextAwareDefaults, if the method takes a
+// context.Context as its first argument and returns an error as its last
+// result, a context that is done at the time of the call makes that result
+// the error of the context.
+func (c *Call) defaultReturns(args []any) []any {
+	rets := make([]any, c.methodType.NumOut())
+	for i := 0; i < c.methodType.NumOut(); i++ {
+		rets[i] = reflect.Zero(c.methodType.Out(i)).Interface()
+	}
+	if !c.contextAwareDefaults || len(rets) == 0 || c.methodType.Out(len(rets)-1) != errorType ||
+		c.methodType.NumIn() == 0 || c.methodType.In(0) != contextType || len(args) == 0 {
 		return rets
-	}}
-	return &Call{t: t, receiver: receiver, method: method, methodType: methodType,
-		args: mArgs, origin: origin, minCalls: 1, maxCalls: 1, actions: actions}
+	}
+	if ctx, ok := args[0].(context.Context); ok && ctx != nil {
+		if err := ctx.Err(); err != nil {
+			rets[len(rets)-1] = err
+		}
+	}
+	return rets
 }
 
 // AnyTimes allows the expectation to be called 0 or more times
@@ -118,11 +158,11 @@ func (c *Call) DoAndReturn(f any) *Call {
 		ft := v.Type()
 		if c.methodType.NumIn() != ft.NumIn() {
 			if ft.IsVariadic() {
-				c.t.Fatalf("wrong number of arguments in DoAndReturn func for %T.%v The function signature must match the mocked method, a variadic function cannot be used.",
-					c.receiver, c.method)
+				c.t.Fatalf("wrong number of arguments in DoAndReturn func for %s.%v The function signature must match the mocked method, a variadic function cannot be used.",
+					c.receiverString(), c.method)
 			} else {
-				c.t.Fatalf("wrong number of arguments in DoAndReturn func for %T.%v: got %d, want %d [%s]",
-					c.receiver, c.method, ft.NumIn(), c.methodType.NumIn(), c.origin)
+				c.t.Fatalf("wrong number of arguments in DoAndReturn func for %s.%v: got %d, want %d [%s]",
+					c.receiverString(), c.method, ft.NumIn(), c.methodType.NumIn(), c.origin)
 			}
 			return nil
 		}
@@ -159,11 +199,11 @@ func (c *Call) Do(f any) *Call {
 		ft := v.Type()
 		if c.methodType.NumIn() != ft.NumIn() {
 			if ft.IsVariadic() {
-				c.t.Fatalf("wrong number of arguments in Do func for %T.%v The function signature must match the mocked method, a variadic function cannot be used.",
-					c.receiver, c.method)
+				c.t.Fatalf("wrong number of arguments in Do func for %s.%v The function signature must match the mocked method, a variadic function cannot be used.",
+					c.receiverString(), c.method)
 			} else {
-				c.t.Fatalf("wrong number of arguments in Do func for %T.%v: got %d, want %d [%s]",
-					c.receiver, c.method, ft.NumIn(), c.methodType.NumIn(), c.origin)
+				c.t.Fatalf("wrong number of arguments in Do func for %s.%v: got %d, want %d [%s]",
+					c.receiverString(), c.method, ft.NumIn(), c.methodType.NumIn(), c.origin)
 			}
 			return nil
 		}
@@ -186,10 +226,72 @@ func (c *Call) Do(f any) *Call {
 func (c *Call) Return(rets ...any) *Call {
 	c.t.Helper()
 
+	c.checkReturnValues("Return", rets)
+	c.addAction(func([]any) []any {
+		return rets
+	})
+
+	return c
+}
+
+// ReturnsInOrder declares the values to be returned by successive calls: the
+// nth call returns the values in rets[n-1]. It expects exactly len(rets)
+// calls, so a call after the values are exhausted fails as unexpected. Raise
+// the limit afterwards with MaxTimes to have further calls return the last
+// values again; AnyTimes does so too, but no longer requires every value to be
+// returned.
+//
+// Example usage:
+//
+//	m.EXPECT().List(gomock.Any()).ReturnsInOrder(
+//		[]any{page1, nil},
+//		[]any{page2, nil},
+//		[]any{nil, io.EOF},
+//	)
+func (c *Call) ReturnsInOrder(rets ...[]any) *Call {
+	c.t.Helper()
+
+	if len(rets) == 0 {
+		c.t.Fatalf("no return values given to ReturnsInOrder for %s.%v [%s]",
+			c.receiverString(), c.method, c.origin)
+	}
+	for i, r := range rets {
+		c.checkReturnValues(fmt.Sprintf("ReturnsInOrder at index %d", i), r)
+	}
+
+	seq := &returnSequence{}
+	c.sequences = append(c.sequences, seq)
+	c.addAction(func([]any) []any {
+		seq.mu.Lock()
+		defer seq.mu.Unlock()
+		r := rets[seq.next]
+		if seq.next < len(rets)-1 {
+			seq.next++
+		}
+		return r
+	})
+
+	return c.Times(len(rets))
+}
+
+// returnSequence is the position of ReturnsInOrder in its values.
+type returnSequence struct {
+	mu   sync.Mutex
+	next int // index of the values the next call returns
+}
+
+// checkReturnValues fails the test unless rets can be returned by the method.
+// Values of types assignable to the result types are converted in place so
+// that the generated code can return them with a type assertion. api names
+// the method given rets in the failure messages.
+func (c *Call) checkReturnValues(api string, rets []any) {
+	c.t.Helper()
+
 	mt := c.methodType
 	if len(rets) != mt.NumOut() {
-		c.t.Fatalf("wrong number of arguments to Return for %T.%v: got %d, want %d [%s]",
-			c.receiver, c.method, len(rets), mt.NumOut(), c.origin)
+		c.t.Fatalf("wrong number of arguments to %s for %s.%v: got %d, want %d [%s]",
+			api, c.receiverString(), c.method, len(rets), mt.NumOut(), c.origin)
+		return
 	}
 	for i, ret := range rets {
 		if got, want := reflect.TypeOf(ret), mt.Out(i); got == want {
@@ -200,8 +302,8 @@ func (c *Call) Return(rets ...any) *Call {
 			case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
 				// ok
 			default:
-				c.t.Fatalf("argument %d to Return for %T.%v is nil, but %v is not nillable [%s]",
-					i, c.receiver, c.method, want, c.origin)
+				c.t.Fatalf("argument %d to %s for %s.%v is nil, but %v is not nillable [%s]",
+					i, api, c.receiverString(), c.method, want, c.origin)
 			}
 		} else if got.AssignableTo(want) {
 			// Assignable type relation. Make the assignment now so that the generated code
@@ -210,16 +312,57 @@ func (c *Call) Return(rets ...any) *Call {
 			v.Set(reflect.ValueOf(ret))
 			rets[i] = v.Interface()
 		} else {
-			c.t.Fatalf("wrong type of argument %d to Return for %T.%v: %v is not assignable to %v [%s]",
-				i, c.receiver, c.method, got, want, c.origin)
+			c.t.Fatalf("wrong type of argument %d to %s for %s.%v: %v is not assignable to %v [%s]",
+				i, api, c.receiverString(), c.method, got, want, c.origin)
 		}
 	}
+}
 
-	c.addAction(func([]any) []any {
-		return rets
-	})
+// CallArgs returns the arguments of each call matched by c so far, in the
+// order the calls were made. It requires the Controller to be created with
+// [WithCallArgs] and fails the test otherwise. The arguments are recorded as
+// the mock received them, so changes the caller makes afterwards to the
+// values they point to show through, unless the Controller was created with
+// [WithArgumentSnapshots].
+func (c *Call) CallArgs() [][]any {
+	c.t.Helper()
+	if !c.callArgs {
+		c.t.Fatalf("CallArgs of %s.%v requires a Controller created with WithCallArgs [%s]",
+			c.receiverString(), c.method, c.origin)
+		return nil
+	}
+	c.argsMu.Lock()
+	defer c.argsMu.Unlock()
+	return append([][]any(nil), c.calledArgs...)
+}
 
-	return c
+// recordArgs records the args of a matched call with WithCallArgs, and the
+// goroutine it was made on if it is not "".
+func (c *Call) recordArgs(args []any, goroutine string) {
+	c.argsMu.Lock()
+	defer c.argsMu.Unlock()
+	if c.callArgs {
+		c.calledArgs = append(c.calledArgs, args)
+	}
+	if goroutine != "" {
+		c.goroutines = append(c.goroutines, goroutine)
+	}
+}
+
+// calledOn lists the distinct goroutines recorded by recordArgs, in the order
+// they first called c.
+func (c *Call) calledOn() string {
+	c.argsMu.Lock()
+	defer c.argsMu.Unlock()
+	var goroutines []string
+	seen := make(map[string]bool)
+	for _, g := range c.goroutines {
+		if !seen[g] {
+			seen[g] = true
+			goroutines = append(goroutines, g)
+		}
+	}
+	return strings.Join(goroutines, ", ")
 }
 
 // Times declares the exact number of times a function call is expected to be executed.
@@ -228,6 +371,16 @@ func (c *Call) Times(n int) *Call {
 	return c
 }
 
+// OnGoroutine declares that the call must be made on the goroutine g, as
+// returned by [CurrentGoroutine] on it, for APIs that may only be used from
+// a designated goroutine. A matched call made on another goroutine is reported
+// as a failure with Errorf, and otherwise handled as usual. The zero
+// Goroutine removes the restriction.
+func (c *Call) OnGoroutine(g Goroutine) *Call {
+	c.goroutine = g
+	return c
+}
+
 // SetArg declares an action that will set the nth argument's value,
 // indirected through a pointer. Or, in the case of a slice and map, SetArg
 // will copy value's elements/key-value pairs into the nth argument.
@@ -312,13 +465,61 @@ func (c *Call) exhausted() bool {
 	return c.numCalls >= c.maxCalls
 }
 
+// receiverString describes the receiver in failure messages.
+func (c *Call) receiverString() string {
+	return describeReceiver(c.receiver, c.mockName)
+}
+
+// describeReceiver describes receiver by its type, prefixed by its label from
+// Controller.SetMockName if it has one.
+func describeReceiver(receiver any, mockName string) string {
+	if mockName == "" {
+		return fmt.Sprintf("%T", receiver)
+	}
+	return fmt.Sprintf("[%s] %T", mockName, receiver)
+}
+
 func (c *Call) String() string {
+	return fmt.Sprintf("%s.%v(%s) %s", c.receiverString(), c.method, c.argsString(), c.origin)
+}
+
+// unsatisfiedString describes an unsatisfied c for the failure of Finish,
+// including where the expectation was registered.
+func (c *Call) unsatisfiedString() string {
+	want := "at least " + strconv.Itoa(c.minCalls)
+	if c.minCalls == c.maxCalls {
+		want = strconv.Itoa(c.minCalls)
+	}
+	return fmt.Sprintf("%s.%v(%s) registered at %s: called %d time(s), expected %s",
+		c.receiverString(), c.method, c.argsString(), c.origin, c.numCalls, want)
+}
+
+func (c *Call) argsString() string {
 	args := make([]string, len(c.args))
 	for i, arg := range c.args {
 		args[i] = arg.String()
 	}
-	arguments := strings.Join(args, ", ")
-	return fmt.Sprintf("%T.%v(%s) %s", c.receiver, c.method, arguments, c.origin)
+	return strings.Join(args, ", ")
+}
+
+// diffArgs returns an error describing how each of the first n arguments
+// compares to its matcher if any of them does not match, or nil otherwise.
+func (c *Call) diffArgs(args []any, n int) error {
+	var mismatched []string
+	var diff strings.Builder
+	for i, m := range c.args[:n] {
+		if m.Matches(args[i]) {
+			fmt.Fprintf(&diff, "\n[%d] matches: %v", i, m)
+			continue
+		}
+		mismatched = append(mismatched, strconv.Itoa(i))
+		fmt.Fprintf(&diff, "\n[%d] Got: %v\n    Want: %v", i, formatGottenArg(m, args[i]), m)
+	}
+	if len(mismatched) == 0 {
+		return nil
+	}
+	return fmt.Errorf("expected call at %s doesn't match the arguments at index %s.%s",
+		c.origin, strings.Join(mismatched, ", "), diff.String())
 }
 
 // Tests if the given call matches the expected call.
@@ -330,6 +531,9 @@ func (c *Call) matches(args []any) error {
 				c.origin, len(args), len(c.args))
 		}
 
+		if c.argumentDiffs {
+			return c.diffArgs(args, len(c.args))
+		}
 		for i, m := range c.args {
 			if !m.Matches(args[i]) {
 				return fmt.Errorf(
@@ -352,54 +556,56 @@ func (c *Call) matches(args []any) error {
 				c.origin, len(args), len(c.args)-1)
 		}
 
+		if c.argumentDiffs {
+			if err := c.diffArgs(args, c.methodType.NumIn()-1); err != nil {
+				return err
+			}
+		}
 		for i, m := range c.args {
 			if i < c.methodType.NumIn()-1 {
 				// Non-variadic args
-				if !m.Matches(args[i]) {
+				if !c.argumentDiffs && !m.Matches(args[i]) {
 					return fmt.Errorf("expected call at %s doesn't match the argument at index %s.\nGot: %v\nWant: %v",
 						c.origin, strconv.Itoa(i), formatGottenArg(m, args[i]), m)
 				}
 				continue
 			}
-			// The last arg has a possibility of a variadic argument, so let it branch
-
 			// sample: Foo(a int, b int, c ...int)
-			if i < len(c.args) && i < len(args) {
-				if m.Matches(args[i]) {
-					// Got Foo(a, b, c) want Foo(matcherA, matcherB, gomock.Any())
-					// Got Foo(a, b, c) want Foo(matcherA, matcherB, someSliceMatcher)
-					// Got Foo(a, b, c) want Foo(matcherA, matcherB, matcherC)
-					// Got Foo(a, b) want Foo(matcherA, matcherB)
-					// Got Foo(a, b, c, d) want Foo(matcherA, matcherB, matcherC, matcherD)
-					continue
+			if len(c.args) != c.methodType.NumIn() {
+				// Several matchers in the variadic position match the
+				// variadic arguments element-wise.
+				// Got Foo(a, b) want Foo(matcherA, matcherB)
+				// Got Foo(a, b, c, d) want Foo(matcherA, matcherB, matcherC, matcherD)
+				if !m.Matches(args[i]) {
+					return fmt.Errorf("expected call at %s doesn't match the argument at index %s.\nGot: %v\nWant: %v",
+						c.origin, strconv.Itoa(i), formatGottenArg(m, args[i]), m)
 				}
+				continue
 			}
 
-			// The number of actual args don't match the number of matchers,
-			// or the last matcher is a slice and the last arg is not.
-			// If this function still matches it is because the last matcher
-			// matches all the remaining arguments or the lack of any.
-			// Convert the remaining arguments, if any, into a slice of the
-			// expected type.
+			// A single matcher in the variadic position matches the slice of
+			// all the variadic arguments, of the method's variadic type. As
+			// the recorder can't tell it from a matcher for a single variadic
+			// argument, it may also match the only variadic argument, which
+			// is tried first so that matchers such as Cond get the type they
+			// expect.
+			// Got Foo(a, b, c) want Foo(matcherA, matcherB, matcherC)
+			if len(args) == len(c.args) && m.Matches(args[i]) {
+				break
+			}
+			// Got Foo(a, b, c, d, e) want Foo(matcherA, matcherB, gomock.Any())
+			// Got Foo(a, b, c, d, e) want Foo(matcherA, matcherB, someSliceMatcher)
+			// Got Foo(a, b) want Foo(matcherA, matcherB, gomock.Any())
+			// Got Foo(a, b) want Foo(matcherA, matcherB, someEmptySliceMatcher)
 			vArgsType := c.methodType.In(c.methodType.NumIn() - 1)
 			vArgs := reflect.MakeSlice(vArgsType, 0, len(args)-i)
 			for _, arg := range args[i:] {
 				vArgs = reflect.Append(vArgs, reflect.ValueOf(arg))
 			}
 			if m.Matches(vArgs.Interface()) {
-				// Got Foo(a, b, c, d, e) want Foo(matcherA, matcherB, gomock.Any())
-				// Got Foo(a, b, c, d, e) want Foo(matcherA, matcherB, someSliceMatcher)
-				// Got Foo(a, b) want Foo(matcherA, matcherB, gomock.Any())
-				// Got Foo(a, b) want Foo(matcherA, matcherB, someEmptySliceMatcher)
 				break
 			}
-			// Wrong number of matchers or not match. Fail.
-			// Got Foo(a, b) want Foo(matcherA, matcherB, matcherC, matcherD)
-			// Got Foo(a, b, c) want Foo(matcherA, matcherB, matcherC, matcherD)
-			// Got Foo(a, b, c, d) want Foo(matcherA, matcherB, matcherC, matcherD, matcherE)
-			// Got Foo(a, b, c, d, e) want Foo(matcherA, matcherB, matcherC, matcherD)
-			// Got Foo(a, b, c) want Foo(matcherA, matcherB)
-
+			// Got Foo(a, b, c, d) want Foo(matcherA, matcherB, matcherC)
 			return fmt.Errorf("expected call at %s doesn't match the argument at index %s.\nGot: %v\nWant: %v",
 				c.origin, strconv.Itoa(i), formatGottenArg(m, args[i:]), c.args[i])
 		}
@@ -415,6 +621,9 @@ func (c *Call) matches(args []any) error {
 
 	// Check that the call is not exhausted.
 	if c.exhausted() {
+		if goroutines := c.calledOn(); goroutines != "" {
+			return fmt.Errorf("expected call at %s has already been called the max number of times, on %s", c.origin, goroutines)
+		}
 		return fmt.Errorf("expected call at %s has already been called the max number of times", c.origin)
 	}
 
@@ -15,8 +15,10 @@
 package gomock
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -49,6 +51,7 @@ func (testObj b) Foo() string {
 type mockTestReporter struct {
 	errorCalls int
 	fatalCalls int
+	fatalMsgs  []string
 }
 
 func (o *mockTestReporter) Errorf(format string, args ...any) {
@@ -57,6 +60,7 @@ func (o *mockTestReporter) Errorf(format string, args ...any) {
 
 func (o *mockTestReporter) Fatalf(format string, args ...any) {
 	o.fatalCalls++
+	o.fatalMsgs = append(o.fatalMsgs, fmt.Sprintf(format, args...))
 }
 
 func (o *mockTestReporter) Helper() {}
@@ -597,6 +601,90 @@ func TestCall_DoAndReturn_NumArgValidation(t *testing.T) {
 	}
 }
 
+type myError struct{}
+
+func (*myError) Error() string { return "my error" }
+
+func TestCall_Return_TypeValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		methodType reflect.Type
+		rets       []any
+		wantMsg    string
+	}{
+		{
+			name:       "identical types",
+			methodType: reflect.TypeOf(func() (int, error) { return 0, nil }),
+			rets:       []any{1, nil},
+		},
+		{
+			name:       "error implementation",
+			methodType: reflect.TypeOf(func() error { return nil }),
+			rets:       []any{&myError{}},
+		},
+		{
+			name:       "wrong count",
+			methodType: reflect.TypeOf(func() (int, error) { return 0, nil }),
+			rets:       []any{1},
+			wantMsg:    "wrong number of arguments to Return for <nil>.Foo: got 1, want 2",
+		},
+		{
+			name:       "too many",
+			methodType: reflect.TypeOf(func() error { return nil }),
+			rets:       []any{1, nil},
+			wantMsg:    "wrong number of arguments to Return for <nil>.Foo: got 2, want 1",
+		},
+		{
+			name:       "not assignable",
+			methodType: reflect.TypeOf(func() (int, error) { return 0, nil }),
+			rets:       []any{1, "oops"},
+			wantMsg:    "wrong type of argument 1 to Return for <nil>.Foo: string is not assignable to error",
+		},
+		{
+			name:       "nil for non-nillable",
+			methodType: reflect.TypeOf(func() int { return 0 }),
+			rets:       []any{nil},
+			wantMsg:    "argument 0 to Return for <nil>.Foo is nil, but int is not nillable",
+		},
+		{
+			name:       "multiple errors",
+			methodType: reflect.TypeOf(func() (error, error) { return nil, nil }),
+			rets:       []any{errors.New("first"), &myError{}},
+		},
+		{
+			name:       "multiple errors with nil",
+			methodType: reflect.TypeOf(func() (error, error) { return nil, nil }),
+			rets:       []any{nil, errors.New("second")},
+		},
+		{
+			name:       "multiple errors not assignable",
+			methodType: reflect.TypeOf(func() (error, error) { return nil, nil }),
+			rets:       []any{errors.New("first"), "second"},
+			wantMsg:    "wrong type of argument 1 to Return for <nil>.Foo: string is not assignable to error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &mockTestReporter{}
+			call := &Call{
+				t:          tr,
+				method:     "Foo",
+				methodType: tt.methodType,
+			}
+			call.Return(tt.rets...)
+			if tt.wantMsg == "" {
+				if tr.fatalCalls != 0 {
+					t.Fatalf("expected Return to pass, got %q", tr.fatalMsgs)
+				}
+				return
+			}
+			if tr.fatalCalls == 0 || !strings.HasPrefix(tr.fatalMsgs[0], tt.wantMsg) {
+				t.Fatalf("got %q, want a failure starting with %q", tr.fatalMsgs, tt.wantMsg)
+			}
+		})
+	}
+}
+
 func TestCall_DoAndReturn(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.description, func(t *testing.T) {
@@ -131,6 +131,38 @@ func (cs callSet) FindMatch(receiver any, method string, args []any) (*Call, err
 	return nil, errors.New(callsErrors.String())
 }
 
+// Matches returns the expected calls matching the call of method on receiver
+// with args, in the order they were added.
+func (cs callSet) Matches(receiver any, method string, args []any) []*Call {
+	cs.expectedMu.Lock()
+	defer cs.expectedMu.Unlock()
+
+	var matches []*Call
+	for _, call := range cs.expected[callSetKey{receiver, method}] {
+		if call.matches(args) == nil {
+			matches = append(matches, call)
+		}
+	}
+	return matches
+}
+
+// SetMockName labels the calls of receiver with mockName.
+func (cs callSet) SetMockName(receiver any, mockName string) {
+	cs.expectedMu.Lock()
+	defer cs.expectedMu.Unlock()
+
+	for _, m := range []map[callSetKey][]*Call{cs.expected, cs.exhausted} {
+		for key, calls := range m {
+			if key.receiver != receiver {
+				continue
+			}
+			for _, call := range calls {
+				call.mockName = mockName
+			}
+		}
+	}
+}
+
 // Failures returns the calls that are not satisfied.
 func (cs callSet) Failures() []*Call {
 	cs.expectedMu.Lock()
@@ -147,6 +179,25 @@ func (cs callSet) Failures() []*Call {
 	return failures
 }
 
+// Pending returns the number of calls of receiver that are not satisfied.
+func (cs callSet) Pending(receiver any) int {
+	cs.expectedMu.Lock()
+	defer cs.expectedMu.Unlock()
+
+	n := 0
+	for key, calls := range cs.expected {
+		if key.receiver != receiver {
+			continue
+		}
+		for _, call := range calls {
+			if !call.satisfied() {
+				n++
+			}
+		}
+	}
+	return n
+}
+
 // Satisfied returns true in case all expected calls in this callSet are satisfied.
 func (cs callSet) Satisfied() bool {
 	cs.expectedMu.Lock()
@@ -0,0 +1,43 @@
+package gomock
+
+import "context"
+
+// recordContext records the context of a call to key that matched expected,
+// if the method takes a context.Context as its first argument. The caller
+// must hold ctrl.mu.
+func (ctrl *Controller) recordContext(key mockMethod, expected *Call, args []any) {
+	if expected.methodType.NumIn() == 0 || expected.methodType.In(0) != contextType || len(args) == 0 {
+		return
+	}
+	ctx, _ := args[0].(context.Context)
+	if ctrl.lastContexts == nil {
+		ctrl.lastContexts = make(map[mockMethod]context.Context)
+	}
+	ctrl.lastContexts[key] = ctx
+}
+
+// LastContext returns the context.Context passed as the first argument of the
+// most recent call to method of mock that matched an expected call, or nil if
+// there was none. The Controller must have been created with
+// [WithContextAssertions], as LastContext fails the test otherwise. It allows
+// asserting on what the code under test passed down:
+//
+//	ctrl := gomock.NewController(t, gomock.WithContextAssertions())
+//	m := NewMockStore(ctrl)
+//	m.EXPECT().Get(gomock.Any(), "key")
+//	handle(ctx, m)
+//	if id := ctrl.LastContext(m, "Get").Value(traceIDKey); id != "trace-1" {
+//		t.Errorf("Get was called with trace ID %v", id)
+//	}
+//
+// LastContext is safe to call concurrently with calls to the mock.
+func (ctrl *Controller) LastContext(mock any, method string) context.Context {
+	ctrl.T.Helper()
+	if !ctrl.contextAssertions {
+		ctrl.T.Fatalf("gomock: LastContext requires a Controller created with WithContextAssertions")
+		return nil
+	}
+	ctrl.mu.Lock()
+	defer ctrl.mu.Unlock()
+	return ctrl.lastContexts[mockMethod{mock, method}]
+}
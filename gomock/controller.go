@@ -17,13 +17,22 @@ package gomock
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"reflect"
 	"runtime"
 	"sync"
+	"time"
 )
 
 // A TestReporter is something that can be used to report test failures.  It
 // is satisfied by the standard library's *testing.T.
+//
+// The Controller calls Errorf for a failure after which the mock can go on,
+// such as a missing call, and Fatalf for one after which it can't, such as an
+// unexpected call, whose results the mock has no values for. Fatalf must
+// therefore not return to its caller: *testing.T stops the goroutine with
+// runtime.Goexit, and [PanicReporter] panics. The Controller holds no locks
+// once it unwinds, so a Fatalf that panics may be recovered from.
 type TestReporter interface {
 	Errorf(format string, args ...any)
 	Fatalf(format string, args ...any)
@@ -76,6 +85,51 @@ type Controller struct {
 	mu            sync.Mutex
 	expectedCalls *callSet
 	finished      bool
+	// called is broadcast, with mu held, whenever a call is matched.
+	called *sync.Cond
+	// argumentDiffs makes argument mismatches report every argument.
+	argumentDiffs bool
+	// callArgs makes matched calls record their arguments for
+	// Call.CallArgs and in history.
+	callArgs bool
+	// argumentSnapshots makes matched calls record deep copies of their
+	// arguments.
+	argumentSnapshots bool
+	// contextAwareDefaults makes calls without return values return the
+	// error of a done context.
+	contextAwareDefaults bool
+	// mockNames maps mocks to their labels from SetMockName.
+	mockNames map[any]string
+	// history holds the arguments of the matched calls of each method of
+	// each mock, in the order the calls were made, with callArgs.
+	history map[mockMethod][][]any
+	// failFast makes unexpected calls on goroutines other than owner abort
+	// the test on owner, and failure is the first such call.
+	failFast bool
+	owner    uint64
+	failure  string
+	// goroutineTracking makes calls record the goroutine they are made on.
+	goroutineTracking bool
+	// defaultTimes makes recorded calls expect between defaultMinCalls and
+	// defaultMaxCalls calls instead of exactly one.
+	defaultTimes                     bool
+	defaultMinCalls, defaultMaxCalls int
+	// timeline makes matched calls be recorded in timelineEntries, which
+	// are reported along with missing calls.
+	timeline        bool
+	created         time.Time
+	timelineEntries []timelineEntry
+	// callbackLock, if not nil, is held while the actions of a call run.
+	callbackLock sync.Locker
+	// contextAssertions makes matched calls whose first argument is a
+	// context.Context record it in lastContexts.
+	contextAssertions bool
+	lastContexts      map[mockMethod]context.Context
+	// callLogger, if not nil, is called with each matched call.
+	callLogger func(method string, args []any)
+	// random, if not nil, picks among the most specific expected calls
+	// matching a call, with mu held.
+	random *rand.Rand
 }
 
 // NewController returns a new Controller. It is the preferred way to create a Controller.
@@ -91,6 +145,7 @@ func NewController(t TestReporter, opts ...ControllerOption) *Controller {
 		T:             h,
 		expectedCalls: newCallSet(),
 	}
+	ctrl.called = sync.NewCond(&ctrl.mu)
 	for _, opt := range opts {
 		opt.apply(ctrl)
 	}
@@ -121,12 +176,241 @@ func (o overridableExpectationsOption) apply(ctrl *Controller) {
 	ctrl.expectedCalls = newOverridableCallSet()
 }
 
+type argumentDiffsOption struct{}
+
+// WithArgumentDiffs makes the failure for a call whose arguments do not match
+// an expected call list every argument, marking which matched and showing
+// the actual value and matcher of each one that did not. Without it, only the
+// first mismatched argument is reported.
+func WithArgumentDiffs() argumentDiffsOption {
+	return argumentDiffsOption{}
+}
+
+func (o argumentDiffsOption) apply(ctrl *Controller) {
+	ctrl.argumentDiffs = true
+}
+
+type callArgsOption struct{}
+
+// WithCallArgs makes the controller record the arguments of each matched
+// call, which [Call.CallArgs] and [Controller.CallsTo] report. It is off by default, so that mocks
+// called many times do not keep their arguments alive until the test ends.
+func WithCallArgs() callArgsOption {
+	return callArgsOption{}
+}
+
+func (o callArgsOption) apply(ctrl *Controller) {
+	ctrl.callArgs = true
+}
+
+type argumentSnapshotsOption struct{}
+
+// WithArgumentSnapshots makes the controller deep-copy the arguments of each
+// matched call before recording them, so that [Call.CallArgs] reports the
+// arguments as they were at the time of the call even if the caller changes
+// them afterwards. It implies [WithCallArgs]. Arguments that cannot be copied, such as structs with
+// unexported fields that do not implement [encoding.BinaryMarshaler] and
+// [encoding.BinaryUnmarshaler], are recorded by reference and a note is
+// logged if the TestReporter has a Logf method.
+func WithArgumentSnapshots() argumentSnapshotsOption {
+	return argumentSnapshotsOption{}
+}
+
+func (o argumentSnapshotsOption) apply(ctrl *Controller) {
+	ctrl.callArgs = true
+	ctrl.argumentSnapshots = true
+}
+
+type contextAwareDefaultsOption struct{}
+
+// WithContextAwareDefaults makes expected calls that are not given return
+// values, by Return, DoAndReturn or ReturnsInOrder, respect the cancellation
+// of their context. If the method takes a context.Context as its first
+// argument and returns an error as its last result, and the context is done
+// at the time of the call, the call returns the error of the context, such as
+// [context.Canceled], as that result. The other results are zero values, as
+// they are without this option.
+func WithContextAwareDefaults() contextAwareDefaultsOption {
+	return contextAwareDefaultsOption{}
+}
+
+func (o contextAwareDefaultsOption) apply(ctrl *Controller) {
+	ctrl.contextAwareDefaults = true
+}
+
+type failFastOption struct{}
+
+// WithFailFast makes the test fail at the first unexpected call to a mock of
+// the controller, even if the call is made on a goroutine other than the one
+// that created the controller. Calling Fatalf from such a goroutine would
+// only stop that goroutine, so the controller instead reports the call with
+// Errorf, returns zero values to the caller and aborts the test with Fatalf
+// the next time the creating goroutine records or makes a call, or calls
+// [Controller.Finish] or [Controller.FinishWithin], which stops waiting. An
+// unexpected call on the creating goroutine is fatal right away, as it is
+// without this option.
+func WithFailFast() failFastOption {
+	return failFastOption{}
+}
+
+func (o failFastOption) apply(ctrl *Controller) {
+	ctrl.failFast = true
+	ctrl.owner = goroutineID()
+}
+
+type goroutineTrackingOption struct{}
+
+// WithGoroutineTracking makes the controller note the goroutine each call to
+// its mocks is made on, by its ID and the site that started it, to help
+// debugging concurrent tests. The failure for an unexpected call names its
+// goroutine, and the failure for a call beyond its expected number of calls
+// also names the goroutines of the calls it already matched. It is off by
+// default, since each call then takes a stack trace with [runtime.Stack].
+func WithGoroutineTracking() goroutineTrackingOption {
+	return goroutineTrackingOption{}
+}
+
+func (o goroutineTrackingOption) apply(ctrl *Controller) {
+	ctrl.goroutineTracking = true
+}
+
+type defaultTimesOption struct {
+	min, max int
+}
+
+// WithDefaultTimes makes the calls recorded with the controller expect at
+// least min and at most max calls instead of exactly one. A negative max sets
+// no upper bound, so WithDefaultTimes(0, -1) makes every call AnyTimes.
+// Times, MinTimes, MaxTimes and AnyTimes on a call override the default: the
+// bound they set wins, and the other keeps its default unless they change it
+// as they do without this option, such as MinTimes lifting a maximum of 1.
+// WithDefaultTimes panics if min is negative or greater than a non-negative
+// max.
+func WithDefaultTimes(min, max int) defaultTimesOption {
+	if min < 0 || max >= 0 && min > max {
+		panic(fmt.Sprintf("gomock: invalid default times [%d, %d]", min, max))
+	}
+	if max < 0 {
+		max = 1e8 // close enough to infinity, as for AnyTimes
+	}
+	return defaultTimesOption{min, max}
+}
+
+func (o defaultTimesOption) apply(ctrl *Controller) {
+	ctrl.defaultTimes = true
+	ctrl.defaultMinCalls, ctrl.defaultMaxCalls = o.min, o.max
+}
+
+type timelineOption struct{}
+
+// WithTimeline makes the controller record the calls to its mocks that match
+// an expected call, with the time since the controller was created, and
+// report them in the order they were made when a call is unexpected or
+// [Controller.Finish] reports missing calls, each with where it was made and
+// the expected call it matched. This helps debugging failures of ordered
+// calls, such as those of [InOrder] and [Call.After]. It is off by default.
+func WithTimeline() timelineOption {
+	return timelineOption{}
+}
+
+func (o timelineOption) apply(ctrl *Controller) {
+	ctrl.timeline = true
+	ctrl.created = time.Now()
+}
+
+type callbackLockOption struct {
+	l sync.Locker
+}
+
+// WithCallbackLock makes the controller hold l while it runs the actions of
+// a matched call, such as the functions given to Do and DoAndReturn, so that
+// the callbacks of calls made on several goroutines can share test state
+// without locking of their own. The test can hold l to access that state
+// too. Calls are matched without l; only the actions are serialized. A callback
+// must not call a mock of the controller if l is not reentrant, such as a
+// [sync.Mutex], since that call would wait for l forever.
+func WithCallbackLock(l sync.Locker) callbackLockOption {
+	return callbackLockOption{l}
+}
+
+func (o callbackLockOption) apply(ctrl *Controller) {
+	ctrl.callbackLock = o.l
+}
+
+type contextAssertionsOption struct{}
+
+// WithContextAssertions makes the controller record the context.Context that
+// each matched call of a method taking one as its first argument was made
+// with, so that the test can check with [Controller.LastContext] that the
+// code under test propagated its deadline or values, such as a trace ID,
+// without a custom matcher.
+func WithContextAssertions() contextAssertionsOption {
+	return contextAssertionsOption{}
+}
+
+func (o contextAssertionsOption) apply(ctrl *Controller) {
+	ctrl.contextAssertions = true
+}
+
+type callLoggerOption struct {
+	log func(method string, args []any)
+}
+
+// WithCallLogger makes the controller call log with the method name and
+// arguments of every call to its mocks that matches an expected call, such
+// as to print the calls an integration test makes while debugging it:
+//
+//	ctrl := gomock.NewController(t, gomock.WithCallLogger(func(method string, args []any) {
+//		t.Logf("%s%v", method, args)
+//	}))
+//
+// log is called after the call is matched and before its actions, such as
+// Return, Do and DoAndReturn, run, on the goroutine making the call and
+// without holding the lock of the controller, so it may call the controller
+// and its mocks. Calls made on several goroutines call log concurrently, so
+// log must be safe for concurrent use. Unexpected calls are not logged.
+func WithCallLogger(log func(method string, args []any)) callLoggerOption {
+	return callLoggerOption{log}
+}
+
+func (o callLoggerOption) apply(ctrl *Controller) {
+	ctrl.callLogger = o.log
+}
+
+type randomMatchingOption struct {
+	seed int64
+}
+
+// WithRandomMatching makes a call that matches several expected calls match
+// a random one of them, chosen by a source seeded with seed, instead of the
+// first one recorded. This simulates nondeterministic dependencies, such as
+// a backend whose AnyTimes expectations return different values:
+//
+//	ctrl := gomock.NewController(t, gomock.WithRandomMatching(1))
+//	m := NewMockBackend(ctrl)
+//	m.EXPECT().Get(gomock.Any()).Return("a", nil).AnyTimes()
+//	m.EXPECT().Get(gomock.Any()).Return("", errUnavailable).AnyTimes()
+//
+// The most specific expected calls still take precedence and the choice is
+// only random among ties: an expected call that must be made after other
+// calls, as with After and InOrder, is preferred to one that need not, and
+// then one with more arguments that are not matched by Any. Calls made in
+// the same order with the same seed match the same expected calls.
+func WithRandomMatching(seed int64) randomMatchingOption {
+	return randomMatchingOption{seed}
+}
+
+func (o randomMatchingOption) apply(ctrl *Controller) {
+	ctrl.random = rand.New(rand.NewSource(o.seed))
+}
+
 type cancelReporter struct {
 	t      TestHelper
 	cancel func()
 }
 
 func (r *cancelReporter) Errorf(format string, args ...any) {
+	defer r.cancel()
 	r.t.Errorf(format, args...)
 }
 func (r *cancelReporter) Fatalf(format string, args ...any) {
@@ -139,7 +423,14 @@ func (r *cancelReporter) Helper() {
 }
 
 // WithContext returns a new Controller and a Context, which is cancelled on any
-// fatal failure.
+// failure reported through the Controller, such as an unexpected call or a
+// missing call detected by Finish. Goroutines blocked on the returned Context
+// are thereby released when the mock reports a failure, instead of hanging
+// the test.
+//
+// Finish, which is registered as a cleanup function when t is a
+// [*testing.T], only cancels the Context if expectations are left
+// unsatisfied; otherwise the Context stays live until its parent is done.
 func WithContext(ctx context.Context, t TestReporter) (*Controller, context.Context) {
 	h, ok := t.(TestHelper)
 	if !ok {
@@ -163,6 +454,46 @@ func (h *nopTestHelper) Fatalf(format string, args ...any) {
 
 func (h nopTestHelper) Helper() {}
 
+// PanicReporter is a TestReporter for using mocks outside of tests, such as
+// in a standalone tool or a custom harness, where there is no *testing.T.
+// Both Errorf and Fatalf panic with the failure message, so the first
+// unexpected call or unmet expectation ends the program, or the function
+// recovering from it, with the full detail of the call:
+//
+//	ctrl := gomock.NewController(gomock.PanicReporter{})
+//	defer ctrl.Finish()
+type PanicReporter struct{}
+
+// Errorf panics with the formatted message.
+func (PanicReporter) Errorf(format string, args ...any) {
+	panic(fmt.Sprintf(format, args...))
+}
+
+// Fatalf panics with the formatted message.
+func (PanicReporter) Fatalf(format string, args ...any) {
+	panic(fmt.Sprintf(format, args...))
+}
+
+// Helper does nothing.
+func (PanicReporter) Helper() {}
+
+// SetMockName labels mock, a mock created with this Controller, in failure
+// messages to tell apart several mocks of the same type. For example, after
+//
+//	ctrl.SetMockName(primary, "primaryDB")
+//
+// an unexpected call fails with "Unexpected call to [primaryDB] *mock_db.MockDB.Get(...)"
+// and a missing call is reported as "missing call(s) to [primaryDB] *mock_db.MockDB.Get(...)".
+func (ctrl *Controller) SetMockName(mock any, name string) {
+	ctrl.mu.Lock()
+	defer ctrl.mu.Unlock()
+	if ctrl.mockNames == nil {
+		ctrl.mockNames = make(map[any]string)
+	}
+	ctrl.mockNames[mock] = name
+	ctrl.expectedCalls.SetMockName(mock, name)
+}
+
 // RecordCall is called by a mock. It should not be called by user code.
 func (ctrl *Controller) RecordCall(receiver any, method string, args ...any) *Call {
 	ctrl.T.Helper()
@@ -182,9 +513,17 @@ func (ctrl *Controller) RecordCallWithMethodType(receiver any, method string, me
 	ctrl.T.Helper()
 
 	call := newCall(ctrl.T, receiver, method, methodType, args...)
+	call.argumentDiffs = ctrl.argumentDiffs
+	call.callArgs = ctrl.callArgs
+	call.contextAwareDefaults = ctrl.contextAwareDefaults
+	if ctrl.defaultTimes {
+		call.minCalls, call.maxCalls = ctrl.defaultMinCalls, ctrl.defaultMaxCalls
+	}
 
 	ctrl.mu.Lock()
 	defer ctrl.mu.Unlock()
+	ctrl.abortOnFailure()
+	call.mockName = ctrl.mockNames[receiver]
 	ctrl.expectedCalls.Add(call)
 
 	return call
@@ -194,23 +533,58 @@ func (ctrl *Controller) RecordCallWithMethodType(receiver any, method string, me
 func (ctrl *Controller) Call(receiver any, method string, args ...any) []any {
 	ctrl.T.Helper()
 
+	var goroutine string
+	if ctrl.goroutineTracking {
+		goroutine = goroutineSite()
+	}
+
 	// Nest this code so we can use defer to make sure the lock is released.
+	matched := false
 	actions := func() []func([]any) []any {
 		ctrl.T.Helper()
 		ctrl.mu.Lock()
 		defer ctrl.mu.Unlock()
+		ctrl.abortOnFailure()
 
-		expected, err := ctrl.expectedCalls.FindMatch(receiver, method, args)
+		var expected *Call
+		var err error
+		if ctrl.random != nil {
+			expected = ctrl.randomMatch(ctrl.expectedCalls.Matches(receiver, method, args))
+		}
+		if expected == nil {
+			expected, err = ctrl.expectedCalls.FindMatch(receiver, method, args)
+		}
 		if err != nil {
 			// callerInfo's skip should be updated if the number of calls between the user's test
 			// and this line changes, i.e. this code is wrapped in another anonymous function.
 			// 0 is us, 1 is controller.Call(), 2 is the generated mock, and 3 is the user's test.
 			origin := callerInfo(3)
-			stringArgs := make([]string, len(args))
-			for i, arg := range args {
-				stringArgs[i] = getString(arg)
+			if goroutine != "" {
+				origin += " on " + goroutine
+			}
+			msg := fmt.Sprintf("Unexpected call to %s.%v(%v) at %s because: %s", describeReceiver(receiver, ctrl.mockNames[receiver]), method, formatArgs(args), origin, err)
+			if ctrl.failFast && goroutineID() != ctrl.owner {
+				ctrl.recordFailure(msg)
+				return []func([]any) []any{func([]any) []any {
+					return zeroResults(receiver, method)
+				}}
+			}
+			if ctrl.timeline {
+				ctrl.reportTimeline()
+			}
+			ctrl.T.Fatalf("%s", msg)
+		}
+
+		if expected.goroutine != (Goroutine{}) {
+			if g := CurrentGoroutine(); g != expected.goroutine {
+				origin := callerInfo(3)
+				msg := fmt.Sprintf("Call to %s.%v(%v) at %s on %s matched the expected call at %s, which must be made on %s", describeReceiver(receiver, ctrl.mockNames[receiver]), method, formatArgs(args), origin, g, expected.origin, expected.goroutine)
+				if ctrl.failFast && g.id != ctrl.owner {
+					ctrl.recordFailure(msg)
+				} else {
+					ctrl.T.Errorf("%s", msg)
+				}
 			}
-			ctrl.T.Fatalf("Unexpected call to %T.%v(%v) at %s because: %s", receiver, method, stringArgs, origin, err)
 		}
 
 		// Two things happen here:
@@ -221,13 +595,45 @@ func (ctrl *Controller) Call(receiver any, method string, args ...any) []any {
 			ctrl.expectedCalls.Remove(preReqCall)
 		}
 
+		recorded := args
+		if ctrl.argumentSnapshots {
+			recorded = ctrl.copyArgs(method, args)
+		}
+		expected.recordArgs(recorded, goroutine)
+		if ctrl.timeline {
+			ctrl.recordTimeline(receiver, method, args, callerInfo(3), expected)
+		}
+		key := mockMethod{receiver, method}
+		if ctrl.callArgs {
+			if ctrl.history == nil {
+				ctrl.history = make(map[mockMethod][][]any)
+			}
+			ctrl.history[key] = append(ctrl.history[key], recorded)
+		}
+		if ctrl.contextAssertions {
+			ctrl.recordContext(key, expected, args)
+		}
+
 		actions := expected.call()
 		if expected.exhausted() {
 			ctrl.expectedCalls.Remove(expected)
 		}
+		ctrl.called.Broadcast()
+		matched = true
 		return actions
 	}()
 
+	if matched && ctrl.callLogger != nil {
+		ctrl.callLogger(method, args)
+	}
+
+	// The actions run without holding the lock, so that Do and DoAndReturn
+	// callbacks may call mocks of this controller, and a panicking callback
+	// propagates to the caller without leaving the controller locked.
+	if ctrl.callbackLock != nil {
+		ctrl.callbackLock.Lock()
+		defer ctrl.callbackLock.Unlock()
+	}
 	var rets []any
 	for _, action := range actions {
 		if r := action(args); r != nil {
@@ -247,6 +653,49 @@ func (ctrl *Controller) Finish() {
 	ctrl.finish(false, err)
 }
 
+// FinishWithin is like Finish, but first waits up to d for all expected calls
+// to be satisfied. This is useful when the mock is called from other
+// goroutines, which might still be running when the test is done. Like
+// Finish, it can only be invoked once.
+func (ctrl *Controller) FinishWithin(d time.Duration) {
+	err := recover()
+	ctrl.T.Helper()
+
+	deadline := time.Now().Add(d)
+	timer := time.AfterFunc(d, func() {
+		ctrl.mu.Lock()
+		defer ctrl.mu.Unlock()
+		ctrl.called.Broadcast()
+	})
+	defer timer.Stop()
+
+	ctrl.mu.Lock()
+	// Wake-ups only signal that something changed, so re-check both the
+	// expectations and the deadline every time.
+	for err == nil && !ctrl.finished && ctrl.failure == "" && !ctrl.expectedCalls.Satisfied() && time.Now().Before(deadline) {
+		ctrl.called.Wait()
+	}
+	ctrl.mu.Unlock()
+
+	ctrl.finish(false, err)
+}
+
+// Verify reports with Errorf, like Finish, each expected call that has not yet
+// been made its minimum number of times, as a checkpoint in the middle of a
+// test. Unlike Finish, it does not abort the test or finish the controller:
+// the mocks can still be called and expected calls added afterwards, and Verify
+// can be called any number of times.
+//
+//	m.EXPECT().Open()
+//	setUp(m)
+//	ctrl.Verify() // setUp must have opened m
+func (ctrl *Controller) Verify() {
+	ctrl.T.Helper()
+	ctrl.mu.Lock()
+	defer ctrl.mu.Unlock()
+	ctrl.reportMissingCalls()
+}
+
 // Satisfied returns whether all expected calls bound to this Controller have been satisfied.
 // Calling Finish is then guaranteed to not fail due to missing calls.
 func (ctrl *Controller) Satisfied() bool {
@@ -255,31 +704,69 @@ func (ctrl *Controller) Satisfied() bool {
 	return ctrl.expectedCalls.Satisfied()
 }
 
+// mockMethod identifies a method of a mock.
+type mockMethod struct {
+	mock   any
+	method string
+}
+
+// CallsTo returns the arguments of each call to method of mock that matched an
+// expected call so far, across all its expected calls and in the order the
+// calls were made. Unexpected calls are not included. Together with
+// expectations such as
+//
+//	m.EXPECT().Send(gomock.Any()).AnyTimes()
+//
+// it allows asserting on the calls a mock received after the fact:
+//
+//	if calls := ctrl.CallsTo(m, "Send"); len(calls) != 2 || calls[1][0] != "bye" {
+//		t.Errorf("Send calls = %v", calls)
+//	}
+//
+// It requires the Controller to be created with [WithCallArgs] and fails the
+// test otherwise. The arguments are captured when the mock is called, as for
+// [Call.CallArgs], so changes to the values they point to show through unless
+// the Controller was created with [WithArgumentSnapshots]. CallsTo is safe to
+// call concurrently with calls to the mock; the returned slice is a copy.
+func (ctrl *Controller) CallsTo(mock any, method string) [][]any {
+	ctrl.T.Helper()
+	if !ctrl.callArgs {
+		ctrl.T.Fatalf("CallsTo requires a Controller created with WithCallArgs")
+		return nil
+	}
+	ctrl.mu.Lock()
+	defer ctrl.mu.Unlock()
+	return append([][]any(nil), ctrl.history[mockMethod{mock, method}]...)
+}
+
+// PendingCalls returns the number of expected calls of mock that are not
+// satisfied yet, which Finish would report as missing if it were called now.
+// Mocks generated with mockgen -formatter print it.
+func (ctrl *Controller) PendingCalls(mock any) int {
+	ctrl.mu.Lock()
+	defer ctrl.mu.Unlock()
+	return ctrl.expectedCalls.Pending(mock)
+}
+
 func (ctrl *Controller) finish(cleanup bool, panicErr any) {
 	ctrl.T.Helper()
 
 	ctrl.mu.Lock()
 	defer ctrl.mu.Unlock()
 
-	if ctrl.finished {
-		if _, ok := isCleanuper(ctrl.T); !ok {
-			ctrl.T.Fatalf("Controller.Finish was called more than once. It has to be called exactly once.")
-		}
+	if !ctrl.markFinished() {
 		return
 	}
-	ctrl.finished = true
 
 	// Short-circuit, pass through the panic.
 	if panicErr != nil {
 		panic(panicErr)
 	}
-
-	// Check that all remaining expected calls are satisfied.
-	failures := ctrl.expectedCalls.Failures()
-	for _, call := range failures {
-		ctrl.T.Errorf("missing call(s) to %v", call)
+	if !cleanup {
+		ctrl.abortOnFailure()
 	}
-	if len(failures) != 0 {
+
+	if ctrl.reportMissingCalls() {
 		if !cleanup {
 			ctrl.T.Fatalf("aborting test due to missing call(s)")
 			return
@@ -288,6 +775,44 @@ func (ctrl *Controller) finish(cleanup bool, panicErr any) {
 	}
 }
 
+// markFinished marks the controller as finished, and returns false if it
+// already was. The caller must hold ctrl.mu.
+func (ctrl *Controller) markFinished() bool {
+	ctrl.T.Helper()
+	if ctrl.finished {
+		if _, ok := isCleanuper(ctrl.T); !ok {
+			ctrl.T.Fatalf("Controller.Finish was called more than once. It has to be called exactly once.")
+		}
+		return false
+	}
+	ctrl.finished = true
+	return true
+}
+
+// reportMissingCalls checks that all remaining expected calls are satisfied,
+// reports those that are not, and returns whether there were any. The caller
+// must hold ctrl.mu.
+func (ctrl *Controller) reportMissingCalls() bool {
+	ctrl.T.Helper()
+	failures := ctrl.expectedCalls.Failures()
+	for _, call := range failures {
+		ctrl.T.Errorf("missing call(s) to %s", call.unsatisfiedString())
+	}
+	if len(failures) != 0 && ctrl.timeline {
+		ctrl.reportTimeline()
+	}
+	return len(failures) != 0
+}
+
+// formatArgs formats the arguments of a call for failure messages.
+func formatArgs(args []any) []string {
+	stringArgs := make([]string, len(args))
+	for i, arg := range args {
+		stringArgs[i] = getString(arg)
+	}
+	return stringArgs
+}
+
 // callerInfo returns the file:line of the call site. skip is the number
 // of stack frames to skip when reporting. 0 is callerInfo's call site.
 func callerInfo(skip int) string {
@@ -15,12 +15,17 @@
 package gomock_test
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"runtime"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/gomock/internal/mock_gomock"
 )
 
 type ErrorReporter struct {
@@ -157,6 +162,10 @@ func (s *Subject) ActOnTestStructMethod(arg TestStruct, arg1 int) int {
 	return 0
 }
 
+func (s *Subject) FetchMethod(ctx context.Context, key string) (string, error) {
+	return "", nil
+}
+
 func (s *Subject) SetArgMethod(sliceArg []byte, ptrArg *int, mapArg map[any]any) {}
 func (s *Subject) SetArgMethodInterface(sliceArg, ptrArg, mapArg any)            {}
 
@@ -312,6 +321,185 @@ func TestUnexpectedArgValue_FirstArg(t *testing.T) {
 	})
 }
 
+func TestUnexpectedArgValue_WithArgumentDiffs(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithArgumentDiffs())
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	expectedArg0 := TestStruct{Number: 123, Message: "hello"}
+	ctrl.RecordCall(subject, "ActOnTestStructMethod", expectedArg0, 15)
+	ctrl.RecordCall(subject, "VariadicMethod", 1, "a")
+
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "ActOnTestStructMethod", expectedArg0, 3)
+	}, "Unexpected call to", "doesn't match the arguments at index 1.",
+		"[0] matches: is equal to {123 hello} (gomock_test.TestStruct)",
+		"[1] Got: 3 (int)\n    Want: is equal to 15 (int)")
+
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "ActOnTestStructMethod", TestStruct{Number: 1}, 3)
+	}, "Unexpected call to", "doesn't match the arguments at index 0, 1.",
+		"[0] Got: {1 } (gomock_test.TestStruct)\n    Want: is equal to {123 hello} (gomock_test.TestStruct)",
+		"[1] Got: 3 (int)\n    Want: is equal to 15 (int)")
+
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "VariadicMethod", 2, "a")
+	}, "Unexpected call to", "doesn't match the arguments at index 0.",
+		"[0] Got: 2 (int)\n    Want: is equal to 1 (int)")
+
+	// Matching calls are unaffected; a failure would be an unexpected fatal.
+	ctrl.Call(subject, "ActOnTestStructMethod", expectedArg0, 15)
+	ctrl.Call(subject, "VariadicMethod", 1, "a")
+}
+
+func TestSetMockName(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	primary, replica := mock_gomock.NewMockMatcher(ctrl), mock_gomock.NewMockMatcher(ctrl)
+
+	ctrl.SetMockName(primary, "primaryDB")
+	primary.EXPECT().Matches("argument")
+	// Naming a mock also labels the calls it already expects.
+	replica.EXPECT().Matches("argument")
+	ctrl.SetMockName(replica, "replicaDB")
+
+	reporter.assertFatal(func() {
+		primary.Matches("other")
+	}, "Unexpected call to [primaryDB] *mock_gomock.MockMatcher.Matches([other])")
+
+	reporter.assertFatal(func() {
+		ctrl.Finish()
+	}, "aborting test due to missing call(s)")
+	for _, want := range []string{
+		"missing call(s) to [primaryDB] *mock_gomock.MockMatcher.Matches(is equal to argument (string))",
+		"missing call(s) to [replicaDB] *mock_gomock.MockMatcher.Matches(is equal to argument (string))",
+	} {
+		found := false
+		for _, msg := range reporter.log {
+			found = found || strings.Contains(msg, want)
+		}
+		if !found {
+			t.Errorf("no error contains %q in %q", want, reporter.log)
+		}
+	}
+}
+
+func TestReturnsInOrder(t *testing.T) {
+	t.Run("FailsWhenExhausted", func(t *testing.T) {
+		reporter, ctrl := createFixtures(t)
+		defer reporter.recoverUnexpectedFatal()
+		subject := new(Subject)
+
+		ctrl.RecordCall(subject, "FooMethod", "argument").ReturnsInOrder([]any{1}, []any{2}, []any{3})
+		for want := 1; want <= 3; want++ {
+			rets := ctrl.Call(subject, "FooMethod", "argument")
+			assertEqual(t, []any{want}, rets)
+		}
+		reporter.assertFatal(func() {
+			ctrl.Call(subject, "FooMethod", "argument")
+		}, "has already been called the max number of times")
+	})
+
+	t.Run("RepeatsLast", func(t *testing.T) {
+		reporter, ctrl := createFixtures(t)
+		defer reporter.recoverUnexpectedFatal()
+		subject := new(Subject)
+
+		ctrl.RecordCall(subject, "FooMethod", "argument").ReturnsInOrder([]any{1}, []any{2}).MaxTimes(4)
+		for _, want := range []int{1, 2, 2, 2} {
+			rets := ctrl.Call(subject, "FooMethod", "argument")
+			assertEqual(t, []any{want}, rets)
+		}
+		ctrl.Finish()
+		reporter.assertPass("calls after the queued values are exhausted repeat the last values")
+	})
+
+	t.Run("RequiresEveryValue", func(t *testing.T) {
+		reporter, ctrl := createFixtures(t)
+		subject := new(Subject)
+
+		ctrl.RecordCall(subject, "FooMethod", "argument").ReturnsInOrder([]any{1}, []any{2})
+		ctrl.Call(subject, "FooMethod", "argument")
+		reporter.assertFatal(func() {
+			ctrl.Finish()
+		}, "aborting test due to missing call(s)")
+	})
+
+	t.Run("ValidatesValues", func(t *testing.T) {
+		reporter, ctrl := createFixtures(t)
+		subject := new(Subject)
+
+		reporter.assertFatal(func() {
+			ctrl.RecordCall(subject, "FooMethod", "argument").ReturnsInOrder([]any{1}, []any{"two"})
+		}, "wrong type of argument 0 to ReturnsInOrder at index 1 for *gomock_test.Subject.FooMethod: string is not assignable to int")
+	})
+}
+
+func TestPanickingCallbackLeavesControllerUsable(t *testing.T) {
+	tests := []struct {
+		name   string
+		record func(ctrl *gomock.Controller, subject *Subject)
+	}{
+		{
+			name: "DoAndReturn",
+			record: func(ctrl *gomock.Controller, subject *Subject) {
+				ctrl.RecordCall(subject, "FooMethod", "panic").DoAndReturn(func(string) int { panic("boom") })
+			},
+		},
+		{
+			name: "Do",
+			record: func(ctrl *gomock.Controller, subject *Subject) {
+				ctrl.RecordCall(subject, "FooMethod", "panic").Do(func(string) { panic("boom") })
+			},
+		},
+		{
+			name: "Cond",
+			record: func(ctrl *gomock.Controller, subject *Subject) {
+				ctrl.RecordCall(subject, "FooMethod", gomock.Cond(func(x any) bool {
+					if x == "panic" {
+						panic("boom")
+					}
+					return false
+				})).AnyTimes()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reporter, ctrl := createFixtures(t)
+			defer reporter.recoverUnexpectedFatal()
+			subject := new(Subject)
+
+			tt.record(ctrl, subject)
+			ctrl.RecordCall(subject, "FooMethod", "argument").Return(1).Times(10)
+
+			func() {
+				defer func() {
+					if r := recover(); r != "boom" {
+						t.Errorf("recovered %v, want the panic of the callback", r)
+					}
+				}()
+				ctrl.Call(subject, "FooMethod", "panic")
+			}()
+
+			// The panic must not leave the controller locked, which concurrent
+			// calls would deadlock on.
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					ctrl.Call(subject, "FooMethod", "argument")
+				}()
+			}
+			wg.Wait()
+			ctrl.Finish()
+			reporter.assertPass("the controller keeps working after a callback panicked")
+		})
+	}
+}
+
 func TestUnexpectedArgValue_SecondArg(t *testing.T) {
 	reporter, ctrl := createFixtures(t)
 	defer reporter.recoverUnexpectedFatal()
@@ -770,6 +958,44 @@ func TestVariadicMatchingWithSlice(t *testing.T) {
 	}
 }
 
+func TestVariadicMatcherSemantics(t *testing.T) {
+	testCases := []struct {
+		name     string
+		matchers []any
+		args     []any
+		want     bool
+	}{
+		{"single matcher matches slice", []any{gomock.Len(2)}, []any{"a", "b"}, true},
+		{"single matcher matches empty slice", []any{gomock.Len(0)}, nil, true},
+		{"single matcher matches slice of one", []any{gomock.Len(1)}, []any{"abc"}, true},
+		{"single matcher matches only argument", []any{gomock.Eq("a")}, []any{"a"}, true},
+		{"single matcher gets only argument first", []any{gomock.Cond(func(x any) bool { return x.(string) == "a" })}, []any{"a"}, true},
+		{"single matcher does not match", []any{gomock.Len(3)}, []any{"a", "b"}, false},
+		{"several matchers match element-wise", []any{gomock.Eq("a"), gomock.Any()}, []any{"a", "b"}, true},
+		{"several matchers do not match", []any{gomock.Eq("a"), gomock.Eq("c")}, []any{"a", "b"}, false},
+		{"several matchers not matched as slice", []any{gomock.Len(2), gomock.Eq("c")}, []any{"a", "b"}, false},
+		{"several matchers with more arguments", []any{gomock.Eq("a"), gomock.Eq("b")}, []any{"a", "b", "c"}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rep, ctrl := createFixtures(t)
+			defer rep.recoverUnexpectedFatal()
+
+			s := new(Subject)
+			ctrl.RecordCall(s, "VariadicMethod", append([]any{0}, tc.matchers...)...)
+			args := append([]any{0}, tc.args...)
+			if tc.want {
+				ctrl.Call(s, "VariadicMethod", args...)
+				rep.assertPass(tc.name)
+				return
+			}
+			rep.assertFatal(func() {
+				ctrl.Call(s, "VariadicMethod", args...)
+			}, "Unexpected call to")
+		})
+	}
+}
+
 func TestVariadicArgumentsGotFormatter(t *testing.T) {
 	rep, ctrl := createFixtures(t)
 	defer rep.recoverUnexpectedFatal()
@@ -928,3 +1154,1105 @@ func TestCallAfterLoopPanic(t *testing.T) {
 	})
 	ctrl = gomock.NewController(reporter)
 }
+
+func TestWithContextCancelsOnUnexpectedCall(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl, ctx := gomock.WithContext(context.Background(), reporter)
+	subject := new(Subject)
+
+	unblocked := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		close(unblocked)
+	}()
+
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "argument")
+	}, "Unexpected call to")
+
+	select {
+	case <-unblocked:
+	case <-time.After(time.Second):
+		t.Fatal("goroutine blocked on context was not released by the failure")
+	}
+}
+
+func TestWithContextCancelsOnMissingCall(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl, ctx := gomock.WithContext(context.Background(), reporter)
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument")
+
+	reporter.assertFatal(func() {
+		ctrl.Finish()
+	}, "aborting test due to missing call(s)")
+
+	if ctx.Err() == nil {
+		t.Error("expected context to be cancelled after Finish reported missing calls")
+	}
+}
+
+func TestWithContextNotCancelledOnSuccess(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl, ctx := gomock.WithContext(context.Background(), reporter)
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument")
+	ctrl.Call(subject, "FooMethod", "argument")
+	ctrl.Finish()
+
+	reporter.assertPass("expected call was made")
+	if ctx.Err() != nil {
+		t.Error("expected context to remain live after a successful Finish")
+	}
+}
+
+func TestFinishWithinWaitsForCalls(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument").Times(2)
+
+	go func() {
+		for i := 0; i < 2; i++ {
+			time.Sleep(10 * time.Millisecond)
+			ctrl.Call(subject, "FooMethod", "argument")
+		}
+	}()
+
+	ctrl.FinishWithin(time.Minute)
+	reporter.assertPass("expected calls were made before the deadline")
+}
+
+func TestFinishWithinReportsMissingCallsAfterDeadline(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument")
+
+	start := time.Now()
+	reporter.assertFatal(func() {
+		ctrl.FinishWithin(20 * time.Millisecond)
+	}, "aborting test due to missing call(s)")
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("FinishWithin returned after %v, before the deadline", elapsed)
+	}
+}
+
+func TestFinishWithinReturnsImmediatelyWhenSatisfied(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument").AnyTimes()
+
+	start := time.Now()
+	ctrl.FinishWithin(time.Minute)
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("FinishWithin waited %v although expectations were satisfied", elapsed)
+	}
+	reporter.assertPass("no calls were required")
+}
+
+func TestArgumentSnapshots(t *testing.T) {
+	t.Run("without call args", func(t *testing.T) {
+		reporter, ctrl := createFixtures(t)
+		subject := new(Subject)
+		call := ctrl.RecordCall(subject, "SetArgMethod", gomock.Any(), gomock.Any(), gomock.Any())
+		ctrl.Call(subject, "SetArgMethod", []byte("abc"), (*int)(nil), map[any]any(nil))
+
+		reporter.assertFatal(func() {
+			call.CallArgs()
+		}, "requires a Controller created with WithCallArgs")
+	})
+
+	t.Run("without snapshots", func(t *testing.T) {
+		reporter := NewErrorReporter(t)
+		ctrl := gomock.NewController(reporter, gomock.WithCallArgs())
+		subject := new(Subject)
+		call := ctrl.RecordCall(subject, "SetArgMethod", gomock.Any(), gomock.Any(), gomock.Any())
+
+		arg := []byte("abc")
+		ctrl.Call(subject, "SetArgMethod", arg, (*int)(nil), map[any]any(nil))
+		arg[0] = 'x'
+
+		if got := call.CallArgs()[0][0].([]byte); string(got) != "xbc" {
+			t.Errorf("recorded argument = %q, want the mutated %q", got, "xbc")
+		}
+	})
+
+	t.Run("with snapshots", func(t *testing.T) {
+		reporter := NewErrorReporter(t)
+		ctrl := gomock.NewController(reporter, gomock.WithArgumentSnapshots())
+		subject := new(Subject)
+		call := ctrl.RecordCall(subject, "SetArgMethod", gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+
+		arg := []byte("abc")
+		n := 1
+		m := map[any]any{"k": []int{1}}
+		ctrl.Call(subject, "SetArgMethod", arg, &n, m)
+		arg[0], n, m["k"].([]int)[0] = 'x', 2, 2
+		ctrl.Call(subject, "SetArgMethod", arg, &n, m)
+
+		args := call.CallArgs()
+		if len(args) != 2 {
+			t.Fatalf("recorded %d calls, want 2", len(args))
+		}
+		if got := args[0][0].([]byte); string(got) != "abc" {
+			t.Errorf("first slice argument = %q, want %q", got, "abc")
+		}
+		if got := *args[0][1].(*int); got != 1 {
+			t.Errorf("first pointer argument points to %d, want 1", got)
+		}
+		if got := args[0][2].(map[any]any)["k"].([]int)[0]; got != 1 {
+			t.Errorf("first map argument holds %d, want 1", got)
+		}
+		if got := args[1][0].([]byte); string(got) != "xbc" {
+			t.Errorf("second slice argument = %q, want %q", got, "xbc")
+		}
+		reporter.assertPass("snapshots taken")
+	})
+
+	t.Run("uncopyable argument", func(t *testing.T) {
+		reporter := NewErrorReporter(t)
+		ctrl := gomock.NewController(reporter, gomock.WithArgumentSnapshots())
+		subject := new(Subject)
+		call := ctrl.RecordCall(subject, "SetArgMethodInterface", gomock.Any(), gomock.Any(), gomock.Any())
+
+		var mu sync.Mutex
+		ctrl.Call(subject, "SetArgMethodInterface", &mu, nil, nil)
+
+		if got := call.CallArgs()[0][0]; got != &mu {
+			t.Errorf("recorded argument = %p, want the argument %p by reference", got, &mu)
+		}
+		if len(reporter.log) != 1 || !strings.Contains(reporter.log[0], "by reference") {
+			t.Errorf("log = %q, want a note that the argument was recorded by reference", reporter.log)
+		}
+		reporter.assertPass("uncopyable argument recorded")
+	})
+}
+
+func TestCallsTo(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithCallArgs())
+	subject, other := new(Subject), mock_gomock.NewMockMatcher(ctrl)
+	ctrl.RecordCall(subject, "FooMethod", "a")
+	ctrl.RecordCall(subject, "FooMethod", gomock.Any()).AnyTimes()
+	ctrl.RecordCall(subject, "BarMethod", gomock.Any()).AnyTimes()
+	other.EXPECT().Matches(gomock.Any()).Return(true).AnyTimes()
+	ctrl.RecordCall(subject, "VariadicMethod", gomock.Any(), gomock.Any()).AnyTimes()
+
+	if got := ctrl.CallsTo(subject, "FooMethod"); len(got) != 0 {
+		t.Errorf("CallsTo() before any call = %v, want none", got)
+	}
+
+	ctrl.Call(subject, "FooMethod", "a")
+	other.Matches("x")
+	ctrl.Call(subject, "BarMethod", "y")
+	ctrl.Call(subject, "FooMethod", "b")
+	ctrl.Call(subject, "FooMethod", "c")
+	ctrl.Call(subject, "VariadicMethod", 1, "d", "e")
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "ActOnTestStructMethod", TestStruct{}, 1)
+	}, "Unexpected call")
+
+	assertEqual(t, [][]any{{"a"}, {"b"}, {"c"}}, ctrl.CallsTo(subject, "FooMethod"))
+	assertEqual(t, [][]any{{"x"}}, ctrl.CallsTo(other, "Matches"))
+	assertEqual(t, [][]any{{"y"}}, ctrl.CallsTo(subject, "BarMethod"))
+	assertEqual(t, [][]any{{1, "d", "e"}}, ctrl.CallsTo(subject, "VariadicMethod"))
+	if got := ctrl.CallsTo(subject, "ActOnTestStructMethod"); len(got) != 0 {
+		t.Errorf("CallsTo() of an unexpected call = %v, want none", got)
+	}
+
+	calls := ctrl.CallsTo(subject, "FooMethod")
+	calls[0] = nil
+	ctrl.Call(subject, "FooMethod", "d")
+	assertEqual(t, [][]any{{"a"}, {"b"}, {"c"}, {"d"}}, ctrl.CallsTo(subject, "FooMethod"))
+}
+
+func TestCallsToConcurrent(t *testing.T) {
+	ctrl := gomock.NewController(NewErrorReporter(t), gomock.WithCallArgs())
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", gomock.Any()).AnyTimes()
+
+	const n = 50
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctrl.Call(subject, "FooMethod", "a")
+			ctrl.CallsTo(subject, "FooMethod")
+		}()
+	}
+	wg.Wait()
+	if got := len(ctrl.CallsTo(subject, "FooMethod")); got != n {
+		t.Errorf("CallsTo() has %d calls, want %d", got, n)
+	}
+}
+
+func TestCallsToWithoutCallArgs(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "a")
+	ctrl.Call(subject, "FooMethod", "a")
+
+	reporter.assertFatal(func() {
+		ctrl.CallsTo(subject, "FooMethod")
+	}, "CallsTo requires a Controller created with WithCallArgs")
+}
+
+func TestMissingCallReportsRegistration(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+
+	_, file, line, _ := runtime.Caller(0)
+	ctrl.RecordCall(subject, "FooMethod", "argument").MinTimes(2)
+	ctrl.Call(subject, "FooMethod", "argument")
+	ctrl.RecordCall(subject, "BarMethod", "argument")
+	mock_gomock.NewMockMatcher(ctrl).EXPECT().Matches("argument")
+
+	reporter.assertFatal(func() {
+		ctrl.Finish()
+	}, "aborting test due to missing call(s)")
+	for _, want := range []string{
+		fmt.Sprintf("missing call(s) to *gomock_test.Subject.FooMethod(is equal to argument (string)) registered at %s:%d: called 1 time(s), expected at least 2", file, line+1),
+		fmt.Sprintf("missing call(s) to *gomock_test.Subject.BarMethod(is equal to argument (string)) registered at %s:%d: called 0 time(s), expected 1", file, line+3),
+		fmt.Sprintf("missing call(s) to *mock_gomock.MockMatcher.Matches(is equal to argument (string)) registered at %s:%d: called 0 time(s), expected 1", file, line+4),
+	} {
+		found := false
+		for _, msg := range reporter.log {
+			found = found || msg == want
+		}
+		if !found {
+			t.Errorf("no error is %q in %q", want, reporter.log)
+		}
+	}
+}
+
+func TestFailFastOnOtherGoroutine(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithFailFast())
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument").AnyTimes()
+
+	done := make(chan []any)
+	go func() {
+		done <- ctrl.Call(subject, "BarMethod", "argument")
+	}()
+	if rets := <-done; !reflect.DeepEqual(rets, []any{0}) {
+		t.Errorf("unexpected call returned %v, want zero values", rets)
+	}
+	reporter.assertFail("unexpected call on another goroutine")
+	if len(reporter.log) != 1 || !strings.Contains(reporter.log[0], "Unexpected call to *gomock_test.Subject.BarMethod") {
+		t.Errorf("log = %q, want the unexpected call", reporter.log)
+	}
+
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "argument")
+	}, "aborting test due to unexpected call(s) on other goroutines", "BarMethod")
+}
+
+func TestFailFastAbortsFinishWithin(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithFailFast())
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument")
+
+	go ctrl.Call(subject, "BarMethod", "argument")
+
+	start := time.Now()
+	reporter.assertFatal(func() {
+		ctrl.FinishWithin(time.Minute)
+	}, "aborting test due to unexpected call(s) on other goroutines")
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("FinishWithin waited %v after an unexpected call", elapsed)
+	}
+}
+
+func TestFailFastOnSameGoroutine(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithFailFast())
+	subject := new(Subject)
+
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "argument")
+	}, "Unexpected call to")
+}
+
+func TestGoroutineTrackingOnUnexpectedCall(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithGoroutineTracking())
+	subject := new(Subject)
+
+	done := make(chan struct{})
+	_, file, line, _ := runtime.Caller(0)
+	go func() {
+		defer close(done)
+		reporter.assertFatal(func() {
+			ctrl.Call(subject, "FooMethod", "argument")
+		}, "Unexpected call to", " on goroutine ", "(created by go.uber.org/mock/gomock_test.TestGoroutineTrackingOnUnexpectedCall", fmt.Sprintf(" at %s:%d)", file, line+1))
+	}()
+	<-done
+}
+
+func TestGoroutineTrackingOnExhaustedCall(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithGoroutineTracking())
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument")
+
+	done := make(chan struct{})
+	_, file, line, _ := runtime.Caller(0)
+	go func() {
+		defer close(done)
+		ctrl.Call(subject, "FooMethod", "argument")
+	}()
+	<-done
+
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "argument")
+	}, "has already been called the max number of times, on goroutine ", fmt.Sprintf("at %s:%d)", file, line+1))
+}
+
+func TestNoGoroutineTrackingByDefault(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter)
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument")
+	ctrl.Call(subject, "FooMethod", "argument")
+
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "argument")
+	}, "has already been called the max number of times")
+	if strings.Contains(reporter.log[len(reporter.log)-1], "goroutine") {
+		t.Errorf("failure names a goroutine without WithGoroutineTracking: %s", reporter.log[len(reporter.log)-1])
+	}
+}
+
+func TestOnGoroutine(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter)
+	subject := new(Subject)
+
+	// loop runs functions on a designated goroutine, whose marker it sends
+	// first.
+	loop := make(chan func())
+	markers := make(chan gomock.Goroutine)
+	go func() {
+		markers <- gomock.CurrentGoroutine()
+		for f := range loop {
+			f()
+		}
+	}()
+	defer close(loop)
+	marker := <-markers
+	run := func(f func()) {
+		done := make(chan struct{})
+		loop <- func() {
+			defer close(done)
+			f()
+		}
+		<-done
+	}
+
+	ctrl.RecordCall(subject, "FooMethod", "argument").Return(1).OnGoroutine(marker).Times(2)
+	var rets []any
+	run(func() { rets = ctrl.Call(subject, "FooMethod", "argument") })
+	if !reflect.DeepEqual(rets, []any{1}) {
+		t.Errorf("call on the designated goroutine returned %v, want [1]", rets)
+	}
+	reporter.assertPass("call on the designated goroutine")
+
+	rets = ctrl.Call(subject, "FooMethod", "argument")
+	if !reflect.DeepEqual(rets, []any{1}) {
+		t.Errorf("call on another goroutine returned %v, want [1]", rets)
+	}
+	reporter.assertFail("call on another goroutine")
+	if len(reporter.log) != 1 || !strings.Contains(reporter.log[0], "Call to *gomock_test.Subject.FooMethod([argument])") ||
+		!strings.Contains(reporter.log[0], "which must be made on "+marker.String()) {
+		t.Errorf("log = %q, want the call on another goroutine", reporter.log)
+	}
+	ctrl.Finish()
+}
+
+func TestOnGoroutineZeroValue(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+
+	ctrl.RecordCall(subject, "FooMethod", "argument").OnGoroutine(gomock.Goroutine{})
+	ctrl.Call(subject, "FooMethod", "argument")
+	ctrl.Finish()
+	reporter.assertPass("zero Goroutine does not restrict the call")
+}
+
+func TestControllerGroupReportsMissingCallsOfAnyMember(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	group := gomock.NewControllerGroup(reporter)
+	first, second := group.NewController(), group.NewController()
+	subject := new(Subject)
+	first.RecordCall(subject, "FooMethod", "argument")
+	second.RecordCall(subject, "BarMethod", "argument")
+
+	first.Call(subject, "FooMethod", "argument")
+	reporter.assertFatal(func() {
+		group.Finish()
+	}, "aborting test due to missing call(s) in 1 of 2 controllers")
+	if !strings.Contains(strings.Join(reporter.log, "\n"), "missing call(s) to *gomock_test.Subject.BarMethod(is equal to argument (string))") {
+		t.Errorf("log does not report the missing call of the second controller: %q", reporter.log)
+	}
+}
+
+func TestControllerGroupReportsAllMembersBeforeAborting(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	first, second := gomock.NewController(reporter), gomock.NewController(reporter)
+	group := gomock.NewControllerGroup(reporter)
+	group.Add(first, second)
+	subject := new(Subject)
+	first.RecordCall(subject, "FooMethod", "argument")
+	second.RecordCall(subject, "BarMethod", "argument")
+
+	reporter.assertFatal(func() {
+		group.Finish()
+	}, "aborting test due to missing call(s) in 2 of 2 controllers")
+	log := strings.Join(reporter.log, "\n")
+	for _, method := range []string{"FooMethod", "BarMethod"} {
+		if !strings.Contains(log, "missing call(s) to *gomock_test.Subject."+method) {
+			t.Errorf("log does not report the missing call to %s: %q", method, reporter.log)
+		}
+	}
+}
+
+func TestControllerGroupPasses(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	group := gomock.NewControllerGroup(reporter)
+	ctrl := group.NewController()
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument")
+
+	ctrl.Call(subject, "FooMethod", "argument")
+	group.Finish()
+	reporter.assertPass("all expected calls were made")
+}
+
+func TestContextAwareDefaults(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func(*gomock.Call)
+		want    string
+		wantErr error
+	}{
+		{"canceled", canceled, func(*gomock.Call) {}, "", context.Canceled},
+		{"deadline exceeded", expired, func(*gomock.Call) {}, "", context.DeadlineExceeded},
+		{"live", context.Background(), func(*gomock.Call) {}, "", nil},
+		{"do without results", canceled, func(c *gomock.Call) { c.Do(func(context.Context, string) {}) }, "", context.Canceled},
+		{"return", canceled, func(c *gomock.Call) { c.Return("value", nil) }, "value", nil},
+		{"do and return", canceled, func(c *gomock.Call) {
+			c.DoAndReturn(func(context.Context, string) (string, error) { return "value", nil })
+		}, "value", nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			reporter := NewErrorReporter(t)
+			ctrl := gomock.NewController(reporter, gomock.WithContextAwareDefaults())
+			subject := new(Subject)
+			tc.setup(ctrl.RecordCall(subject, "FetchMethod", tc.ctx, "key"))
+
+			rets := ctrl.Call(subject, "FetchMethod", tc.ctx, "key")
+			if rets[0] != tc.want {
+				t.Errorf("got result %v, want %v", rets[0], tc.want)
+			}
+			if err, _ := rets[1].(error); err != tc.wantErr {
+				t.Errorf("got error %v, want %v", rets[1], tc.wantErr)
+			}
+			ctrl.Finish()
+			reporter.assertPass("expected call was made")
+		})
+	}
+}
+
+func TestContextAwareDefaultsOff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FetchMethod", ctx, "key")
+
+	if rets := ctrl.Call(subject, "FetchMethod", ctx, "key"); rets[1] != nil {
+		t.Errorf("got error %v without WithContextAwareDefaults", rets[1])
+	}
+	ctrl.Finish()
+	reporter.assertPass("expected call was made")
+}
+
+// recoverPanic returns the value f panics with, or nil if it returns.
+func recoverPanic(f func()) (v any) {
+	defer func() { v = recover() }()
+	f()
+	return nil
+}
+
+func TestPanicReporterOnUnexpectedCall(t *testing.T) {
+	ctrl := gomock.NewController(gomock.PanicReporter{})
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument")
+
+	v := recoverPanic(func() { ctrl.Call(subject, "FooMethod", "other") })
+	msg, ok := v.(string)
+	if !ok {
+		t.Fatalf("unexpected call panicked with %v, want a message", v)
+	}
+	for _, want := range []string{
+		"Unexpected call to *gomock_test.Subject.FooMethod([other])",
+		"doesn't match the argument at index 0",
+		"Got: other (string)",
+		"Want: is equal to argument (string)",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("panic message %q does not contain %q", msg, want)
+		}
+	}
+
+	// The panic leaves the controller usable.
+	ctrl.Call(subject, "FooMethod", "argument")
+	if v := recoverPanic(ctrl.Finish); v != nil {
+		t.Errorf("Finish panicked with %v after the expected call", v)
+	}
+}
+
+func TestPanicReporterOnMissingCall(t *testing.T) {
+	ctrl := gomock.NewController(gomock.PanicReporter{})
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument").Times(2)
+	ctrl.Call(subject, "FooMethod", "argument")
+
+	v := recoverPanic(ctrl.Finish)
+	msg, _ := v.(string)
+	want := "missing call(s) to *gomock_test.Subject.FooMethod(is equal to argument (string))"
+	if !strings.HasPrefix(msg, want) || !strings.Contains(msg, "called 1 time(s), expected 2") {
+		t.Errorf("Finish panicked with %v, want a message starting with %q", v, want)
+	}
+}
+
+func TestDefaultTimes(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithDefaultTimes(0, -1))
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "never")
+	ctrl.RecordCall(subject, "FooMethod", "often")
+	for i := 0; i < 5; i++ {
+		ctrl.Call(subject, "FooMethod", "often")
+	}
+	ctrl.Finish()
+	reporter.assertPass("calls within the default times")
+}
+
+func TestDefaultTimesMissingCall(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithDefaultTimes(2, 3))
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument")
+	ctrl.Call(subject, "FooMethod", "argument")
+	reporter.assertFatal(ctrl.Finish, "aborting test due to missing call(s)")
+	want := "called 1 time(s), expected at least 2"
+	if len(reporter.log) == 0 || !strings.HasSuffix(reporter.log[0], want) {
+		t.Errorf("missing call error %q does not end with %q", reporter.log, want)
+	}
+}
+
+func TestDefaultTimesExhausted(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithDefaultTimes(2, 3))
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument")
+	for i := 0; i < 3; i++ {
+		ctrl.Call(subject, "FooMethod", "argument")
+	}
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "argument")
+	}, "Unexpected call to", "has already been called the max number of times")
+}
+
+func TestDefaultTimesOverridden(t *testing.T) {
+	tests := []struct {
+		name     string
+		override func(*gomock.Call)
+		calls    int
+		wantFail bool
+	}{
+		{"Times", func(c *gomock.Call) { c.Times(1) }, 1, false},
+		{"Times missing call", func(c *gomock.Call) { c.Times(2) }, 1, true},
+		{"MinTimes", func(c *gomock.Call) { c.MinTimes(2) }, 10, false},
+		{"MinTimes missing call", func(c *gomock.Call) { c.MinTimes(2) }, 1, true},
+		{"MaxTimes keeps default min", func(c *gomock.Call) { c.MaxTimes(2) }, 0, false},
+		{"AnyTimes", func(c *gomock.Call) { c.AnyTimes() }, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reporter := NewErrorReporter(t)
+			ctrl := gomock.NewController(reporter, gomock.WithDefaultTimes(0, -1))
+			subject := new(Subject)
+			tt.override(ctrl.RecordCall(subject, "FooMethod", "argument"))
+			for i := 0; i < tt.calls; i++ {
+				ctrl.Call(subject, "FooMethod", "argument")
+			}
+			if tt.wantFail {
+				reporter.assertFatal(ctrl.Finish, "missing call(s)")
+				return
+			}
+			ctrl.Finish()
+			reporter.assertPass("calls within the overriding times")
+		})
+	}
+}
+
+func TestDefaultTimesInvalid(t *testing.T) {
+	for _, bounds := range [][2]int{{-1, 1}, {3, 2}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("WithDefaultTimes(%d, %d) did not panic", bounds[0], bounds[1])
+				}
+			}()
+			gomock.WithDefaultTimes(bounds[0], bounds[1])
+		}()
+	}
+}
+
+func TestPendingCalls(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject, other := new(Subject), new(struct{ Subject })
+	ctrl.RecordCall(subject, "FooMethod", "a")
+	ctrl.RecordCall(subject, "BarMethod", "b").MinTimes(2)
+	ctrl.RecordCall(subject, "BarMethod", "c").AnyTimes()
+	ctrl.RecordCall(other, "FooMethod", "a")
+
+	if got := ctrl.PendingCalls(subject); got != 2 {
+		t.Errorf("PendingCalls() = %d, want 2", got)
+	}
+	ctrl.Call(subject, "FooMethod", "a")
+	ctrl.Call(subject, "BarMethod", "b")
+	if got := ctrl.PendingCalls(subject); got != 1 {
+		t.Errorf("PendingCalls() after a call = %d, want 1", got)
+	}
+	ctrl.Call(subject, "BarMethod", "b")
+	if got := ctrl.PendingCalls(subject); got != 0 {
+		t.Errorf("PendingCalls() after all calls = %d, want 0", got)
+	}
+	ctrl.Call(other, "FooMethod", "a")
+	ctrl.Finish()
+	reporter.assertPass("expected calls were made")
+}
+
+// MockFormatted is a mock with the Format method of mockgen -formatter.
+type MockFormatted struct {
+	ctrl *gomock.Controller
+}
+
+func (m *MockFormatted) ISGOMOCK() struct{} { return struct{}{} }
+
+func (m *MockFormatted) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, "MockFormatted(expectations: %d pending)", m.ctrl.PendingCalls(m))
+}
+
+func TestNoFormatterDeadlockOnError(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+	formatted := &MockFormatted{ctrl}
+
+	ctrl.RecordCall(subject, "FooMethod", formatted)
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "argument")
+	}, "Unexpected call to", "Want: is equal to *gomock_test.MockFormatted")
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "NotRecordedMethod", formatted)
+	}, "Unexpected call to *gomock_test.Subject.NotRecordedMethod([*gomock_test.MockFormatted])")
+	if got, want := fmt.Sprint(formatted), "MockFormatted(expectations: 0 pending)"; got != want {
+		t.Errorf("fmt.Sprint() = %q, want %q", got, want)
+	}
+	reporter.assertFatal(func() {
+		ctrl.Finish()
+	})
+}
+
+func TestTimelineOnMissingCall(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithTimeline())
+	mock := NewMockFoo(ctrl)
+
+	_, file, line, _ := runtime.Caller(0)
+	first := mock.EXPECT().Bar("first")
+	second := mock.EXPECT().Bar("second").After(first)
+	mock.EXPECT().Bar("third").After(second)
+	mock.Bar("first")
+	time.Sleep(time.Millisecond)
+	mock.Bar("second")
+
+	reporter.assertFatal(ctrl.Finish, "aborting test due to missing call(s)")
+	var timeline string
+	for _, msg := range reporter.log {
+		if strings.HasPrefix(msg, "timeline") {
+			timeline = msg
+		}
+	}
+	lines := strings.Split(timeline, "\n")
+	if len(lines) != 3 || lines[0] != "timeline of the 2 matched call(s):" {
+		t.Fatalf("timeline = %q, want a header and 2 calls", timeline)
+	}
+	for i, want := range []string{
+		fmt.Sprintf("*gomock_test.MockFoo.Bar([first]) at %s:%d, matching the call expected at %s:%d", file, line+4, file, line+1),
+		fmt.Sprintf("*gomock_test.MockFoo.Bar([second]) at %s:%d, matching the call expected at %s:%d", file, line+6, file, line+2),
+	} {
+		if !strings.HasSuffix(lines[i+1], want) {
+			t.Errorf("timeline entry %d = %q, want it to end with %q", i, lines[i+1], want)
+		}
+	}
+
+	// The calls are ordered by their time since the controller was created.
+	var ats [2]time.Duration
+	for i := range ats {
+		at, _, _ := strings.Cut(strings.TrimPrefix(lines[i+1], "\t+"), " ")
+		d, err := time.ParseDuration(at)
+		if err != nil {
+			t.Fatalf("timeline entry %d has no duration: %q", i, lines[i+1])
+		}
+		ats[i] = d
+	}
+	if ats[1]-ats[0] < time.Millisecond {
+		t.Errorf("timeline calls at %v, want the second one at least 1ms after the first", ats)
+	}
+}
+
+func TestTimelineOnUnexpectedCall(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithTimeline())
+	mock := NewMockFoo(ctrl)
+	first := mock.EXPECT().Bar("first")
+	mock.EXPECT().Bar("second").After(first)
+	mock.EXPECT().Bar("third")
+	mock.Bar("third")
+
+	reporter.assertFatal(func() {
+		mock.Bar("second")
+	}, "Unexpected call to", "doesn't have a prerequisite call satisfied")
+	if len(reporter.log) != 2 || !strings.HasPrefix(reporter.log[0], "timeline of the 1 matched call(s):\n\t+") ||
+		!strings.Contains(reporter.log[0], "*gomock_test.MockFoo.Bar([third])") {
+		t.Errorf("log = %q, want the timeline of the call with third before the failure", reporter.log)
+	}
+}
+
+func TestNoTimelineByDefault(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument").Times(2)
+	ctrl.Call(subject, "FooMethod", "argument")
+
+	reporter.assertFatal(ctrl.Finish, "aborting test due to missing call(s)")
+	for _, msg := range reporter.log {
+		if strings.Contains(msg, "timeline") {
+			t.Errorf("got %q without WithTimeline", msg)
+		}
+	}
+}
+
+func TestCallbackLock(t *testing.T) {
+	var mu sync.Mutex
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithCallbackLock(&mu))
+	subject := new(Subject)
+
+	// The callbacks share counts without locking, which -race would report
+	// if the controller did not serialize them.
+	counts := make(map[string]int)
+	ctrl.RecordCall(subject, "FooMethod", gomock.Any()).DoAndReturn(func(arg string) int {
+		counts[arg]++
+		return counts[arg]
+	}).AnyTimes()
+
+	const goroutines, calls = 8, 100
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(arg string) {
+			defer wg.Done()
+			for j := 0; j < calls; j++ {
+				ctrl.Call(subject, "FooMethod", arg)
+			}
+		}(fmt.Sprint(i % 2))
+	}
+	wg.Wait()
+
+	mu.Lock()
+	got := counts["0"] + counts["1"]
+	mu.Unlock()
+	if want := goroutines * calls; got != want {
+		t.Errorf("callbacks counted %d calls, want %d", got, want)
+	}
+	ctrl.Finish()
+	reporter.assertPass("calls with callbacks")
+}
+
+func TestCallbackLockReleasedOnPanic(t *testing.T) {
+	var mu sync.Mutex
+	ctrl := gomock.NewController(NewErrorReporter(t), gomock.WithCallbackLock(&mu))
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument").Do(func(string) { panic("boom") })
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("recovered %v, want boom", r)
+			}
+		}()
+		ctrl.Call(subject, "FooMethod", "argument")
+	}()
+	if !mu.TryLock() {
+		t.Fatal("callback lock still held after the callback panicked")
+	}
+	mu.Unlock()
+}
+
+func TestVerify(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "setup")
+	ctrl.RecordCall(subject, "BarMethod", "later").AnyTimes()
+
+	ctrl.Verify()
+	if len(reporter.log) != 1 || !strings.HasPrefix(reporter.log[0], "missing call(s) to *gomock_test.Subject.FooMethod(is equal to setup (string))") {
+		t.Fatalf("Verify() before the call logged %q, want the missing call to FooMethod", reporter.log)
+	}
+	reporter.assertFail("Verify() before the expected call")
+	reporter.log, reporter.failed = nil, false
+
+	// The controller is still in use after Verify.
+	ctrl.Call(subject, "FooMethod", "setup")
+	ctrl.Verify()
+	ctrl.Verify()
+	if len(reporter.log) != 0 {
+		t.Errorf("Verify() after the call logged %q, want nothing", reporter.log)
+	}
+	ctrl.RecordCall(subject, "FooMethod", "teardown")
+	ctrl.Call(subject, "BarMethod", "later")
+	ctrl.Call(subject, "FooMethod", "teardown")
+	ctrl.Finish()
+	reporter.assertPass("expected calls were made after Verify")
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithCallArgs())
+	subject := new(Subject)
+	first := ctrl.RecordCall(subject, "FooMethod", "first").Return(1)
+	ctrl.RecordCall(subject, "FooMethod", "second").Return(2).After(first)
+	ctrl.RecordCall(subject, "BarMethod", gomock.Any()).ReturnsInOrder([]any{10}, []any{20})
+	s := ctrl.Snapshot()
+
+	for i := 0; i < 3; i++ {
+		if ctrl.Satisfied() {
+			t.Fatalf("iteration %d: Satisfied() = true before the calls", i)
+		}
+		// The order of first and second is enforced on every iteration.
+		reporter.assertFatal(func() {
+			ctrl.Call(subject, "FooMethod", "second")
+		}, "doesn't have a prerequisite call satisfied")
+		reporter.log, reporter.failed = nil, false
+
+		if got := ctrl.Call(subject, "FooMethod", "first")[0]; got != 1 {
+			t.Errorf("iteration %d: FooMethod(first) = %v, want 1", i, got)
+		}
+		if got := ctrl.Call(subject, "FooMethod", "second")[0]; got != 2 {
+			t.Errorf("iteration %d: FooMethod(second) = %v, want 2", i, got)
+		}
+		for _, want := range []int{10, 20} {
+			if got := ctrl.Call(subject, "BarMethod", "x")[0]; got != want {
+				t.Errorf("iteration %d: BarMethod(x) = %v, want %v", i, got, want)
+			}
+		}
+		if got := len(ctrl.CallsTo(subject, "FooMethod")); got != 2 {
+			t.Errorf("iteration %d: CallsTo(FooMethod) has %d calls, want 2", i, got)
+		}
+		ctrl.Verify()
+		reporter.assertPass("all calls of the iteration were made")
+
+		// Expected calls registered after the snapshot are dropped.
+		ctrl.RecordCall(subject, "FooMethod", "extra")
+		ctrl.Restore(s)
+	}
+
+	if got := ctrl.CallsTo(subject, "FooMethod"); len(got) != 0 {
+		t.Errorf("CallsTo(FooMethod) after Restore = %v, want none", got)
+	}
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "extra")
+	}, "Unexpected call")
+}
+
+func TestRestoreEarlierAndLaterSnapshots(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithCallArgs())
+	subject := new(Subject)
+	call := ctrl.RecordCall(subject, "FooMethod", gomock.Any()).AnyTimes()
+	ctrl.Call(subject, "FooMethod", "a")
+	earlier := ctrl.Snapshot()
+	ctrl.Call(subject, "FooMethod", "b")
+	later := ctrl.Snapshot()
+
+	ctrl.Restore(earlier)
+	ctrl.Call(subject, "FooMethod", "c")
+	assertEqual(t, [][]any{{"a"}, {"c"}}, ctrl.CallsTo(subject, "FooMethod"))
+
+	// The calls made after restoring earlier do not overwrite those of later.
+	ctrl.Restore(later)
+	assertEqual(t, [][]any{{"a"}, {"b"}}, ctrl.CallsTo(subject, "FooMethod"))
+	assertEqual(t, [][]any{{"a"}, {"b"}}, call.CallArgs())
+	reporter.assertPass("snapshots restored")
+}
+
+func TestRestoreSnapshotOfOtherController(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	_, other := createFixtures(t)
+	reporter.assertFatal(func() {
+		ctrl.Restore(other.Snapshot())
+	}, "not taken from this Controller")
+}
+
+type traceIDKey struct{}
+
+func TestContextAssertions(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithContextAssertions())
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FetchMethod", gomock.Any(), gomock.Any()).AnyTimes()
+	ctrl.RecordCall(subject, "FooMethod", gomock.Any()).AnyTimes()
+
+	if ctx := ctrl.LastContext(subject, "FetchMethod"); ctx != nil {
+		t.Errorf("LastContext() before any call = %v, want nil", ctx)
+	}
+
+	deadline := time.Now().Add(time.Hour)
+	ctx, cancel := context.WithDeadline(context.WithValue(context.Background(), traceIDKey{}, "trace-1"), deadline)
+	defer cancel()
+	ctrl.Call(subject, "FetchMethod", ctx, "a")
+	got := ctrl.LastContext(subject, "FetchMethod")
+	if id := got.Value(traceIDKey{}); id != "trace-1" {
+		t.Errorf("LastContext().Value(traceIDKey{}) = %v, want trace-1", id)
+	}
+	if d, ok := got.Deadline(); !ok || !d.Equal(deadline) {
+		t.Errorf("LastContext().Deadline() = %v, %v, want %v, true", d, ok, deadline)
+	}
+
+	// The most recent call wins.
+	ctrl.Call(subject, "FetchMethod", context.WithValue(context.Background(), traceIDKey{}, "trace-2"), "b")
+	if id := ctrl.LastContext(subject, "FetchMethod").Value(traceIDKey{}); id != "trace-2" {
+		t.Errorf("LastContext().Value(traceIDKey{}) after a second call = %v, want trace-2", id)
+	}
+
+	// Methods without a context are not recorded.
+	ctrl.Call(subject, "FooMethod", "c")
+	if ctx := ctrl.LastContext(subject, "FooMethod"); ctx != nil {
+		t.Errorf("LastContext() of a method without a context = %v, want nil", ctx)
+	}
+	ctrl.Finish()
+	reporter.assertPass("calls with contexts")
+}
+
+func TestLastContextWithoutOption(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	reporter.assertFatal(func() {
+		ctrl.LastContext(new(Subject), "FetchMethod")
+	}, "requires a Controller created with WithContextAssertions")
+}
+
+func TestCallLogger(t *testing.T) {
+	type loggedCall struct {
+		method string
+		args   []any
+	}
+	var logged []loggedCall
+	reporter := NewErrorReporter(t)
+	var ctrl *gomock.Controller
+	ctrl = gomock.NewController(reporter, gomock.WithCallLogger(func(method string, args []any) {
+		logged = append(logged, loggedCall{method, args})
+		// The controller is not locked while logging.
+		ctrl.Satisfied()
+	}))
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "a").Do(func(string) {
+		if len(logged) != 1 {
+			t.Errorf("Do ran with %d logged calls, want the call logged before Do", len(logged))
+		}
+	}).Return(1)
+	ctrl.RecordCall(subject, "VariadicMethod", 2, "b", "c")
+
+	ctrl.Call(subject, "FooMethod", "a")
+	ctrl.Call(subject, "VariadicMethod", 2, "b", "c")
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "BarMethod", "unexpected")
+	})
+
+	want := []loggedCall{
+		{"FooMethod", []any{"a"}},
+		{"VariadicMethod", []any{2, "b", "c"}},
+	}
+	if !reflect.DeepEqual(logged, want) {
+		t.Errorf("logged calls %v, want %v", logged, want)
+	}
+}
+
+func TestRandomMatching(t *testing.T) {
+	// foos returns the results of n calls of FooMethod matching several
+	// AnyTimes expectations with seed.
+	foos := func(seed int64, n int) []int {
+		reporter := NewErrorReporter(t)
+		ctrl := gomock.NewController(reporter, gomock.WithRandomMatching(seed))
+		subject := new(Subject)
+		for i := 1; i <= 3; i++ {
+			ctrl.RecordCall(subject, "FooMethod", gomock.Any()).Return(i).AnyTimes()
+		}
+		var results []int
+		for i := 0; i < n; i++ {
+			results = append(results, ctrl.Call(subject, "FooMethod", "a")[0].(int))
+		}
+		reporter.assertPass("random matching")
+		return results
+	}
+
+	results := foos(1, 30)
+	seen := make(map[int]bool)
+	for _, r := range results {
+		seen[r] = true
+	}
+	if len(seen) != 3 {
+		t.Errorf("calls matched the expectations returning %v, want all of 1, 2 and 3", seen)
+	}
+	assertEqual(t, results, foos(1, 30))
+	if reflect.DeepEqual(results, foos(2, 30)) {
+		t.Errorf("seeds 1 and 2 matched the same expectations %v", results)
+	}
+}
+
+func TestRandomMatchingPrefersSpecificCalls(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		reporter := NewErrorReporter(t)
+		ctrl := gomock.NewController(reporter, gomock.WithRandomMatching(seed))
+		subject := new(Subject)
+
+		ctrl.RecordCall(subject, "FooMethod", gomock.Any()).Return(1).AnyTimes()
+		ctrl.RecordCall(subject, "FooMethod", "x").Return(2).AnyTimes()
+		ctrl.RecordCall(subject, "FooMethod", gomock.Any()).Return(3).AnyTimes()
+		for i := 0; i < 10; i++ {
+			if got := ctrl.Call(subject, "FooMethod", "x")[0]; got != 2 {
+				t.Fatalf("seed %d: FooMethod(x) = %v, want 2 from the expectation matching x", seed, got)
+			}
+		}
+
+		first := ctrl.RecordCall(subject, "BarMethod", "a").Return(10)
+		ctrl.RecordCall(subject, "BarMethod", gomock.Any()).Return(20).AnyTimes()
+		ctrl.RecordCall(subject, "BarMethod", gomock.Any()).Return(30).After(first)
+		assertEqual(t, []any{10}, ctrl.Call(subject, "BarMethod", "a"))
+		assertEqual(t, []any{30}, ctrl.Call(subject, "BarMethod", "b"))
+		reporter.assertPass("random matching of specific calls")
+	}
+}
@@ -50,6 +50,18 @@
 //	    mockObj.EXPECT().SomeMethod(3, "third"),
 //	)
 //
+// For a variadic method, a single matcher in the variadic position matches
+// the slice of all the variadic arguments, while several matchers match the
+// variadic arguments one by one:
+//
+//	mockObj.EXPECT().Log("msg", gomock.Len(2))                // matches Log("msg", "a", "b")
+//	mockObj.EXPECT().Log("msg", []string{"a", "b"})           // matches Log("msg", "a", "b")
+//	mockObj.EXPECT().Log("msg", gomock.Eq("a"), gomock.Any()) // matches Log("msg", "a", "b")
+//
+// As a single matcher can't be told from a matcher for a single variadic
+// argument, it also matches a call with exactly one variadic argument that it
+// matches, so that EXPECT().Log("msg", "a") matches Log("msg", "a").
+//
 // The standard TestReporter most users will pass to `NewController` is a
 // `*testing.T` from the context of the test. Note that this will use the
 // standard `t.Error` and `t.Fatal` methods to report what happened in the test.
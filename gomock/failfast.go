@@ -0,0 +1,61 @@
+package gomock
+
+import (
+	"bytes"
+	"reflect"
+	"runtime"
+	"strconv"
+)
+
+// recordFailure reports an unexpected call made on a goroutine other than the
+// one that created the controller, and remembers it so that the creating
+// goroutine aborts the test. The caller must hold ctrl.mu.
+func (ctrl *Controller) recordFailure(msg string) {
+	ctrl.T.Helper()
+	ctrl.T.Errorf("%s", msg)
+	if ctrl.failure == "" {
+		ctrl.failure = msg
+	}
+	// Wake up FinishWithin.
+	ctrl.called.Broadcast()
+}
+
+// abortOnFailure aborts the test if an unexpected call was made on another
+// goroutine and this is the goroutine that created the controller. The
+// caller must hold ctrl.mu.
+func (ctrl *Controller) abortOnFailure() {
+	if ctrl.failure == "" || goroutineID() != ctrl.owner {
+		return
+	}
+	ctrl.T.Helper()
+	first := ctrl.failure
+	ctrl.failure = ""
+	ctrl.T.Fatalf("aborting test due to unexpected call(s) on other goroutines, the first being: %s", first)
+}
+
+// zeroResults returns the zero values of the results of the method of
+// receiver, so that an unexpected call can return to its caller.
+func zeroResults(receiver any, method string) []any {
+	m, ok := reflect.TypeOf(receiver).MethodByName(method)
+	if !ok {
+		return nil
+	}
+	rets := make([]any, m.Type.NumOut())
+	for i := range rets {
+		rets[i] = reflect.Zero(m.Type.Out(i)).Interface()
+	}
+	return rets
+}
+
+// goroutineID returns the ID of the calling goroutine, which runtime.Stack
+// prints in the first line of the trace as "goroutine 1 [running]:".
+func goroutineID() uint64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i != -1 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
@@ -0,0 +1,120 @@
+// Package golden provides a gomock matcher comparing arguments to golden
+// files. It is a separate package so that gomock itself does not tie
+// arguments to a serialization format.
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+// UpdateEnv is the environment variable that makes the matchers of Match
+// update their golden files when it is set to a true value, such as 1.
+const UpdateEnv = "GOMOCK_UPDATE_GOLDEN"
+
+type matcher struct {
+	t    testing.TB
+	path string
+}
+
+// Match returns a matcher for values whose JSON encoding, as indented by
+// [json.MarshalIndent] with two spaces, equals the content of the golden
+// file at path. It brings golden file testing to large arguments:
+//
+//	m.EXPECT().Send(golden.Match(t, "testdata/request.golden"))
+//
+// The golden files are written instead of compared, and every value matches,
+// when the test is run with the environment variable GOMOCK_UPDATE_GOLDEN
+// set to a true value, or when the test binary has a boolean -update flag,
+// as many define for their own golden files, and it is set:
+//
+//	GOMOCK_UPDATE_GOLDEN=1 go test ./...
+//
+// Each value the matcher is given is then written, so the file holds the
+// last one. Failures to read or write the file, other than the file not
+// existing, are reported with t.Errorf.
+func Match(t testing.TB, path string) gomock.Matcher {
+	return matcher{t: t, path: path}
+}
+
+func (m matcher) Matches(x any) bool {
+	m.t.Helper()
+	got, err := encode(x)
+	if err != nil {
+		return false
+	}
+	if update() {
+		if err := os.MkdirAll(filepath.Dir(m.path), 0o755); err != nil {
+			m.t.Errorf("golden: %v", err)
+			return false
+		}
+		if err := os.WriteFile(m.path, got, 0o644); err != nil {
+			m.t.Errorf("golden: %v", err)
+			return false
+		}
+		m.t.Logf("golden: updated %s", m.path)
+		return true
+	}
+	want, err := m.read()
+	return err == nil && bytes.Equal(got, want)
+}
+
+func (m matcher) String() string {
+	return "matches golden file " + m.path
+}
+
+// Got shows the JSON encoding of x and why it does not match.
+func (m matcher) Got(x any) string {
+	got, err := encode(x)
+	if err != nil {
+		return fmt.Sprintf("%v (%T) (%v)", x, x, err)
+	}
+	if _, err := m.read(); err != nil {
+		return fmt.Sprintf("%s (%v; set %s=1 to write it)", bytes.TrimSpace(got), err, UpdateEnv)
+	}
+	return fmt.Sprintf("%s (differs from %s; set %s=1 to update it)", bytes.TrimSpace(got), m.path, UpdateEnv)
+}
+
+// read returns the content of the golden file, with Windows line endings
+// converted, so that checkouts converting line endings still match.
+func (m matcher) read() ([]byte, error) {
+	want, err := os.ReadFile(m.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			m.t.Errorf("golden: %v", err)
+		}
+		return nil, err
+	}
+	return bytes.ReplaceAll(want, []byte("\r\n"), []byte("\n")), nil
+}
+
+// encode returns the indented JSON encoding of x with a trailing newline.
+func encode(x any) ([]byte, error) {
+	b, err := json.MarshalIndent(x, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// update reports whether golden files are to be updated.
+func update() bool {
+	if v, err := strconv.ParseBool(os.Getenv(UpdateEnv)); err == nil && v {
+		return true
+	}
+	if f := flag.Lookup("update"); f != nil {
+		if g, ok := f.Value.(flag.Getter); ok {
+			v, _ := g.Get().(bool)
+			return v
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package golden_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/gomock/golden"
+)
+
+type request struct {
+	Name  string   `json:"name"`
+	Items []string `json:"items"`
+}
+
+func TestMatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "request.golden")
+	m := golden.Match(t, path)
+	req := request{Name: "gopher", Items: []string{"a", "b"}}
+
+	if m.Matches(req) {
+		t.Error("Matches() without a golden file = true, want false")
+	}
+	if got := m.(gomock.GotFormatter).Got(req); !strings.Contains(got, "no such file") || !strings.Contains(got, "GOMOCK_UPDATE_GOLDEN=1") {
+		t.Errorf("Got() without a golden file = %q, want the missing file and how to write it", got)
+	}
+
+	t.Setenv(golden.UpdateEnv, "1")
+	if !m.Matches(req) {
+		t.Error("Matches() while updating = false, want true")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{
+  "name": "gopher",
+  "items": [
+    "a",
+    "b"
+  ]
+}
+`
+	if string(data) != want {
+		t.Errorf("updated golden file holds\n%s\nwant\n%s", data, want)
+	}
+
+	t.Setenv(golden.UpdateEnv, "")
+	if !m.Matches(req) {
+		t.Error("Matches() of the value in the golden file = false, want true")
+	}
+	other := request{Name: "gopher", Items: []string{"a"}}
+	if m.Matches(other) {
+		t.Error("Matches() of another value = true, want false")
+	}
+	if got := m.(gomock.GotFormatter).Got(other); !strings.Contains(got, "differs from "+path) {
+		t.Errorf("Got() of another value = %q, want it to say it differs from %s", got, path)
+	}
+
+	// Windows line endings of the checked-out file are ignored.
+	if err := os.WriteFile(path, []byte(strings.ReplaceAll(want, "\n", "\r\n")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if !m.Matches(req) {
+		t.Error("Matches() of a golden file with Windows line endings = false, want true")
+	}
+}
+
+func TestMatchString(t *testing.T) {
+	if got, want := golden.Match(t, "testdata/a.golden").String(), "matches golden file testdata/a.golden"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestMatchUnmarshalable(t *testing.T) {
+	t.Setenv(golden.UpdateEnv, "1")
+	path := filepath.Join(t.TempDir(), "a.golden")
+	if golden.Match(t, path).Matches(func() {}) {
+		t.Error("Matches() of a func = true, want false")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("golden file of a func was written: %v", err)
+	}
+}
+
+type sender struct{}
+
+func (*sender) Send(request) error { return nil }
+
+func TestMatchInExpectation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "send.golden")
+	if err := os.WriteFile(path, []byte("{\n  \"name\": \"gopher\",\n  \"items\": null\n}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	ctrl := gomock.NewController(t)
+	s := new(sender)
+	ctrl.RecordCall(s, "Send", golden.Match(t, path))
+	ctrl.Call(s, "Send", request{Name: "gopher"})
+}
@@ -0,0 +1,59 @@
+package gomock
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Goroutine identifies a goroutine, for [Call.OnGoroutine]. Its zero value
+// identifies no goroutine.
+type Goroutine struct {
+	id uint64
+}
+
+// CurrentGoroutine returns the calling goroutine.
+func CurrentGoroutine() Goroutine {
+	return Goroutine{goroutineID()}
+}
+
+// String returns a description of g such as "goroutine 7".
+func (g Goroutine) String() string {
+	return "goroutine " + strconv.FormatUint(g.id, 10)
+}
+
+// goroutineSite describes the calling goroutine for WithGoroutineTracking by
+// its ID and the site that started it, as in "goroutine 7 (created by
+// example.TestFoo in goroutine 6 at /src/foo_test.go:12)". Goroutines whose
+// trace has no creator, such as the main goroutine, are described by their
+// ID only.
+func goroutineSite() string {
+	buf := make([]byte, 4096)
+	for {
+		n := runtime.Stack(buf, false)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	// The trace starts with "goroutine 7 [running]:" and, for goroutines
+	// started by a go statement, ends with
+	//
+	//	created by example.TestFoo in goroutine 6
+	//		/src/foo_test.go:12 +0x1d
+	header, _, _ := bytes.Cut(buf, []byte(" ["))
+	i := bytes.LastIndex(buf, []byte("\ncreated by "))
+	if i == -1 {
+		return string(header)
+	}
+	creator, site, _ := strings.Cut(string(buf[i+len("\ncreated by "):]), "\n")
+	site = strings.TrimSpace(site)
+	if j := strings.LastIndex(site, " +0x"); j != -1 {
+		site = site[:j]
+	}
+	return fmt.Sprintf("%s (created by %s at %s)", header, creator, site)
+}
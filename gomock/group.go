@@ -0,0 +1,88 @@
+package gomock
+
+import "sync"
+
+// A ControllerGroup finishes several controllers at once, such as the
+// controllers of the subsystems of an integration test, so that one call to
+// Finish verifies all of them.
+//
+// Example usage:
+//
+//	group := gomock.NewControllerGroup(t)
+//	db := mock_db.NewMockDB(group.NewController())
+//	queue := mock_queue.NewMockQueue(group.NewController())
+//	// ...
+//	group.Finish()
+type ControllerGroup struct {
+	t     TestHelper
+	mu    sync.Mutex
+	ctrls []*Controller
+}
+
+// NewControllerGroup returns an empty ControllerGroup reporting to t.
+func NewControllerGroup(t TestReporter) *ControllerGroup {
+	h, ok := t.(TestHelper)
+	if !ok {
+		h = &nopTestHelper{t}
+	}
+	return &ControllerGroup{t: h}
+}
+
+// NewController returns a new Controller reporting to the TestReporter of the
+// group and adds it to the group.
+func (g *ControllerGroup) NewController(opts ...ControllerOption) *Controller {
+	ctrl := NewController(g.t, opts...)
+	g.Add(ctrl)
+	return ctrl
+}
+
+// Add adds controllers to the group.
+func (g *ControllerGroup) Add(ctrls ...*Controller) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.ctrls = append(g.ctrls, ctrls...)
+}
+
+// Finish finishes all the controllers of the group. Unlike calling
+// [Controller.Finish] on each of them, it reports the missing calls of every
+// controller, each to its own TestReporter, before aborting the test once
+// with the TestReporter of the group. Like Controller.Finish, it can only be
+// invoked once per controller.
+func (g *ControllerGroup) Finish() {
+	// If we're currently panicking, probably because this is a deferred call.
+	err := recover()
+	g.t.Helper()
+
+	g.mu.Lock()
+	ctrls := g.ctrls
+	g.mu.Unlock()
+
+	failed := 0
+	for _, ctrl := range ctrls {
+		if ctrl.finishInGroup(err != nil) {
+			failed++
+		}
+	}
+	// Short-circuit, pass through the panic.
+	if err != nil {
+		panic(err)
+	}
+	if failed != 0 {
+		g.t.Fatalf("aborting test due to missing call(s) in %d of %d controllers", failed, len(ctrls))
+	}
+}
+
+// finishInGroup finishes the controller like Finish, except that it returns
+// whether calls were missing instead of aborting the test. While panicking,
+// it only marks the controller as finished.
+func (ctrl *Controller) finishInGroup(panicking bool) bool {
+	ctrl.T.Helper()
+	ctrl.mu.Lock()
+	defer ctrl.mu.Unlock()
+
+	if !ctrl.markFinished() || panicking {
+		return false
+	}
+	ctrl.abortOnFailure()
+	return ctrl.reportMissingCalls()
+}
@@ -35,11 +35,22 @@ func NewMockMatcher(ctrl *gomock.Controller) *MockMatcher {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockMatcher) EXPECT() *MockMatcherMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockMatcher; create it with NewMockMatcher")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockMatcher) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Matches mocks base method.
 func (m *MockMatcher) Matches(arg0 any) bool {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockMatcher; create it with NewMockMatcher")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Matches", arg0)
 	ret0, _ := ret[0].(bool)
@@ -54,6 +65,9 @@ func (mr *MockMatcherMockRecorder) Matches(arg0 any) *gomock.Call {
 
 // String mocks base method.
 func (m *MockMatcher) String() string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockMatcher; create it with NewMockMatcher")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "String")
 	ret0, _ := ret[0].(string)
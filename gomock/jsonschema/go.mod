@@ -0,0 +1,10 @@
+module go.uber.org/mock/gomock/jsonschema
+
+go 1.19
+
+replace go.uber.org/mock => ../..
+
+require (
+	github.com/santhosh-tekuri/jsonschema/v5 v5.3.1
+	go.uber.org/mock v0.0.0-00010101000000-000000000000
+)
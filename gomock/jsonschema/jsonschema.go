@@ -0,0 +1,88 @@
+// Package jsonschema provides a gomock matcher for JSON values that validate
+// against a JSON Schema. It is a separate module so that gomock itself does
+// not depend on a JSON Schema implementation.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	validator "github.com/santhosh-tekuri/jsonschema/v5"
+	"go.uber.org/mock/gomock"
+)
+
+type matcher struct {
+	source string
+	schema *validator.Schema
+}
+
+// Match returns a matcher for JSON documents that are valid for the given
+// JSON Schema. It panics if the schema is invalid. The matched value can be
+// a []byte, string or json.RawMessage holding the document, or any other
+// value, which is marshaled with encoding/json first. When a value does not
+// match, the failure shows why it is invalid.
+//
+// Example usage:
+//
+//	schema := `{"type": "object", "required": ["name"]}`
+//	Match(schema).Matches(`{"name": "gopher"}`) // returns true
+//	Match(schema).Matches([]byte(`{"age": 7}`)) // returns false
+//	Match(schema).Matches(map[string]int{"age": 7}) // returns false
+func Match(schema string) gomock.Matcher {
+	return matcher{source: schema, schema: validator.MustCompileString("schema.json", schema)}
+}
+
+func (m matcher) Matches(x any) bool {
+	return m.validate(x) == nil
+}
+
+func (m matcher) String() string {
+	return "is valid for JSON Schema " + m.source
+}
+
+// Got shows why x is invalid, so that the failure of a call surfaces the
+// validation error.
+func (m matcher) Got(x any) string {
+	if err := m.validate(x); err != nil {
+		return fmt.Sprintf("%v (%v)", formatValue(x), err)
+	}
+	return formatValue(x)
+}
+
+func (m matcher) validate(x any) error {
+	var doc []byte
+	switch v := x.(type) {
+	case []byte:
+		doc = v
+	case json.RawMessage:
+		doc = v
+	case string:
+		doc = []byte(v)
+	default:
+		var err error
+		if doc, err = json.Marshal(x); err != nil {
+			return err
+		}
+	}
+	d := json.NewDecoder(bytes.NewReader(doc))
+	d.UseNumber()
+	var value any
+	if err := d.Decode(&value); err != nil {
+		return fmt.Errorf("invalid JSON: %v", err)
+	}
+	if d.More() {
+		return fmt.Errorf("invalid JSON: data after the top-level value")
+	}
+	return m.schema.Validate(value)
+}
+
+func formatValue(x any) string {
+	switch v := x.(type) {
+	case []byte:
+		return string(v)
+	case json.RawMessage:
+		return string(v)
+	}
+	return fmt.Sprintf("%v (%T)", x, x)
+}
@@ -0,0 +1,115 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/gomock/jsonschema"
+)
+
+const userSchema = `{
+	"type": "object",
+	"properties": {
+		"name": {"type": "string"},
+		"age": {"type": "integer", "minimum": 0}
+	},
+	"required": ["name"]
+}`
+
+type user struct {
+	Name string `json:"name,omitempty"`
+	Age  int    `json:"age"`
+}
+
+func TestMatch(t *testing.T) {
+	m := jsonschema.Match(userSchema)
+	for _, tc := range []struct {
+		name string
+		x    any
+		want bool
+	}{
+		{"string", `{"name": "gopher", "age": 7}`, true},
+		{"bytes", []byte(`{"name": "gopher"}`), true},
+		{"raw message", json.RawMessage(`{"name": "gopher"}`), true},
+		{"marshaled value", user{Name: "gopher", Age: 7}, true},
+		{"missing property", `{"age": 7}`, false},
+		{"wrong type", []byte(`{"name": 7}`), false},
+		{"below minimum", json.RawMessage(`{"name": "gopher", "age": -1}`), false},
+		{"marshaled invalid value", user{Age: 7}, false},
+		{"invalid JSON", `{"name":`, false},
+		{"trailing data", `{"name": "gopher"} {}`, false},
+		{"unmarshalable value", func() {}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := m.Matches(tc.x); got != tc.want {
+				t.Errorf("Matches(%v) = %v, want %v", tc.x, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMatchString(t *testing.T) {
+	m := jsonschema.Match(`{"type": "string"}`)
+	if got, want := m.String(), `is valid for JSON Schema {"type": "string"}`; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestMatchGot(t *testing.T) {
+	m := jsonschema.Match(userSchema).(gomock.GotFormatter)
+	got := m.Got([]byte(`{"age": 7}`))
+	if !strings.HasPrefix(got, `{"age": 7} (`) || !strings.Contains(got, "missing properties: 'name'") {
+		t.Errorf("Got() = %q, want the value and the validation error", got)
+	}
+	if got := m.Got(`{"name": "gopher"}`); got != `{"name": "gopher"} (string)` {
+		t.Errorf("Got() = %q for a valid value", got)
+	}
+}
+
+func TestMatchInvalidSchema(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Match did not panic for an invalid schema")
+		}
+	}()
+	jsonschema.Match(`{"type": 7}`)
+}
+
+type reporter struct {
+	msgs []string
+}
+
+func (r *reporter) Errorf(format string, args ...any) {
+	r.msgs = append(r.msgs, fmt.Sprintf(format, args...))
+}
+
+func (r *reporter) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	panic(r)
+}
+
+type client struct{}
+
+func (client) Post(body []byte) {}
+
+func TestMatchFailureShowsValidationError(t *testing.T) {
+	r := &reporter{}
+	ctrl := gomock.NewController(r)
+	c := client{}
+	ctrl.RecordCall(c, "Post", jsonschema.Match(userSchema))
+
+	func() {
+		defer func() {
+			if p := recover(); p != r {
+				panic(p)
+			}
+		}()
+		ctrl.Call(c, "Post", []byte(`{"name": 7}`))
+	}()
+	if len(r.msgs) != 1 || !strings.Contains(r.msgs[0], "expected string, but got number") {
+		t.Errorf("failure does not show the validation error: %q", r.msgs)
+	}
+}
@@ -15,10 +15,12 @@
 package gomock
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 )
 
 // A Matcher is a representation of a class of values.
@@ -146,7 +148,7 @@ func (nilMatcher) Matches(x any) bool {
 	v := reflect.ValueOf(x)
 	switch v.Kind() {
 	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map,
-		reflect.Ptr, reflect.Slice:
+		reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
 		return v.IsNil()
 	}
 
@@ -157,6 +159,20 @@ func (nilMatcher) String() string {
 	return "is nil"
 }
 
+type nilOrMatcher struct {
+	m Matcher
+}
+
+// Matches doesn't pass nil values to the inner matcher, which might not
+// handle them.
+func (n nilOrMatcher) Matches(x any) bool {
+	return Nil().Matches(x) || n.m.Matches(x)
+}
+
+func (n nilOrMatcher) String() string {
+	return "is nil or " + n.m.String()
+}
+
 type notMatcher struct {
 	m Matcher
 }
@@ -169,6 +185,18 @@ func (n notMatcher) String() string {
 	return "not(" + n.m.String() + ")"
 }
 
+type xorMatcher struct {
+	a, b Matcher
+}
+
+func (xm xorMatcher) Matches(x any) bool {
+	return xm.a.Matches(x) != xm.b.Matches(x)
+}
+
+func (xm xorMatcher) String() string {
+	return "exactly one of (" + xm.a.String() + ", " + xm.b.String() + ")"
+}
+
 type regexMatcher struct {
 	regex *regexp.Regexp
 }
@@ -200,6 +228,25 @@ func (m assignableToTypeOfMatcher) String() string {
 	return "is assignable to " + m.targetType.Name()
 }
 
+type typeOfMatcher struct {
+	targetType reflect.Type
+}
+
+func (m typeOfMatcher) Matches(x any) bool {
+	// A nil interface has no dynamic type.
+	if x == nil || m.targetType == nil {
+		return false
+	}
+	return reflect.TypeOf(x) == m.targetType
+}
+
+func (m typeOfMatcher) String() string {
+	if m.targetType == nil {
+		return "has exact type <nil>"
+	}
+	return "has exact type " + m.targetType.String()
+}
+
 type anyOfMatcher struct {
 	matchers []Matcher
 }
@@ -221,6 +268,28 @@ func (am anyOfMatcher) String() string {
 	return strings.Join(ss, " | ")
 }
 
+type oneOfMatcher struct {
+	values   []any
+	matchers []Matcher
+}
+
+func (m oneOfMatcher) Matches(x any) bool {
+	for _, matcher := range m.matchers {
+		if matcher.Matches(x) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m oneOfMatcher) String() string {
+	ss := make([]string, 0, len(m.values))
+	for _, v := range m.values {
+		ss = append(ss, fmt.Sprintf("%v", v))
+	}
+	return "is one of [" + strings.Join(ss, ", ") + "]"
+}
+
 type allMatcher struct {
 	matchers []Matcher
 }
@@ -324,6 +393,129 @@ func (m inAnyOrderMatcher) String() string {
 	return fmt.Sprintf("has the same elements as %v", m.x)
 }
 
+type fieldMatcher struct {
+	path  string
+	inner Matcher
+}
+
+func (m fieldMatcher) Matches(x any) bool {
+	v := reflect.ValueOf(x)
+	for _, name := range strings.Split(m.path, ".") {
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return false
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return false
+		}
+		f, ok := v.Type().FieldByName(name)
+		if !ok {
+			return false
+		}
+		// A field promoted through a nil embedded pointer is not there.
+		var err error
+		if v, err = v.FieldByIndexErr(f.Index); err != nil {
+			return false
+		}
+	}
+	if !v.CanInterface() {
+		// Unexported fields cannot be passed on to the inner matcher.
+		return false
+	}
+	return m.inner.Matches(v.Interface())
+}
+
+func (m fieldMatcher) String() string {
+	return fmt.Sprintf("field %q matches %v", m.path, m.inner)
+}
+
+type timeApproxMatcher struct {
+	t         time.Time
+	tolerance time.Duration
+}
+
+func (m timeApproxMatcher) Matches(x any) bool {
+	t, ok := x.(time.Time)
+	if !ok {
+		return false
+	}
+	d := t.Round(0).Sub(m.t)
+	if d < 0 {
+		d = -d
+	}
+	return d <= m.tolerance
+}
+
+func (m timeApproxMatcher) String() string {
+	return fmt.Sprintf("is within %v of %v", m.tolerance, m.t.Format(time.RFC3339Nano))
+}
+
+type errorIsMatcher struct {
+	target error
+}
+
+func (m errorIsMatcher) Matches(x any) bool {
+	err, ok := x.(error)
+	return ok && errors.Is(err, m.target)
+}
+
+func (m errorIsMatcher) String() string {
+	if m.target == nil {
+		return "matches error target <nil>"
+	}
+	return fmt.Sprintf("matches error target %q", m.target.Error())
+}
+
+type errorAsMatcher struct {
+	targetType reflect.Type
+}
+
+func (m errorAsMatcher) Matches(x any) bool {
+	err, ok := x.(error)
+	// A new target is used for every match, so that matching has no side
+	// effects on the target of ErrorAs.
+	return ok && errors.As(err, reflect.New(m.targetType).Interface())
+}
+
+func (m errorAsMatcher) String() string {
+	return fmt.Sprintf("matches error target of type %v", m.targetType)
+}
+
+type implementsMatcher struct {
+	iface reflect.Type
+}
+
+func (m implementsMatcher) Matches(x any) bool {
+	return x != nil && reflect.TypeOf(x).Implements(m.iface)
+}
+
+func (m implementsMatcher) String() string {
+	return fmt.Sprintf("implements %v", m.iface)
+}
+
+type eachMatcher struct {
+	inner Matcher
+}
+
+func (m eachMatcher) Matches(x any) bool {
+	v := reflect.ValueOf(x)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return false
+	}
+	for i := 0; i < v.Len(); i++ {
+		if !m.inner.Matches(v.Index(i).Interface()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m eachMatcher) String() string {
+	return "each element matches " + m.inner.String()
+}
+
 // Constructors
 
 // All returns a composite Matcher that returns true if and only all of the
@@ -365,6 +557,28 @@ func AnyOf(xs ...any) Matcher {
 	return anyOfMatcher{ms}
 }
 
+// OneOf returns a matcher that matches if the received value equals one of
+// the given values. Values that are matchers are used as is, so they may be
+// mixed with plain values. OneOf without values matches nothing.
+//
+// Example usage:
+//
+//	OneOf("red", "green", "blue").Matches("green") // returns true
+//	OneOf("red", "green", "blue").Matches("pink") // returns false
+//	OneOf(1, Nil()).Matches(nil) // returns true
+//	OneOf().Matches(1) // returns false
+func OneOf(values ...any) Matcher {
+	ms := make([]Matcher, 0, len(values))
+	for _, v := range values {
+		if m, ok := v.(Matcher); ok {
+			ms = append(ms, m)
+		} else {
+			ms = append(ms, Eq(v))
+		}
+	}
+	return oneOfMatcher{values: values, matchers: ms}
+}
+
 // Eq returns a matcher that matches on equality.
 //
 // Example usage:
@@ -389,6 +603,18 @@ func Len(i int) Matcher {
 //	Nil().Matches(x) // returns false
 func Nil() Matcher { return nilMatcher{} }
 
+// NilOr returns a matcher that matches if the received value is nil, such as
+// an untyped nil or a nil pointer, or matches inner. Unlike AnyOf(Nil(), inner),
+// inner never sees nil values.
+//
+// Example usage:
+//
+//	var x *B
+//	NilOr(Field("Name", Eq("Dam"))).Matches(x) // returns true
+//	NilOr(Field("Name", Eq("Dam"))).Matches(&B{Name: "Dam"}) // returns true
+//	NilOr(Field("Name", Eq("Dam"))).Matches(&B{Name: "Dave"}) // returns false
+func NilOr(inner Matcher) Matcher { return nilOrMatcher{inner} }
+
 // Not reverses the results of its given child matcher.
 //
 // Example usage:
@@ -402,6 +628,16 @@ func Not(x any) Matcher {
 	return notMatcher{Eq(x)}
 }
 
+// Xor returns a composite Matcher that returns true if exactly one of a and b
+// returns true.
+//
+// Example usage:
+//
+//	Xor(Nil(), Len(0)).Matches([]int{}) // returns true
+//	Xor(Nil(), Len(0)).Matches([]int(nil)) // returns false
+//	Xor(Nil(), Len(0)).Matches([]int{1}) // returns false
+func Xor(a, b Matcher) Matcher { return xorMatcher{a, b} }
+
 // Regex checks whether parameter matches the associated regex.
 //
 // Example usage:
@@ -432,6 +668,23 @@ func AssignableToTypeOf(x any) Matcher {
 	return assignableToTypeOfMatcher{reflect.TypeOf(x)}
 }
 
+// TypeOf is a Matcher that matches if the dynamic type of the parameter to the
+// mock function is identical to the type of the parameter to this function.
+// Unlike AssignableToTypeOf, a value of another type implementing the same
+// interface does not match. A nil parameter never matches.
+//
+// Example usage:
+//
+//	TypeOf(&os.PathError{}).Matches(&os.PathError{Op: "open"}) // returns true
+//	TypeOf(&os.PathError{}).Matches(errors.New("open")) // returns false
+//	TypeOf(reflect.TypeOf(time.Second)).Matches(time.Minute) // returns true
+func TypeOf(x any) Matcher {
+	if xt, ok := x.(reflect.Type); ok {
+		return typeOfMatcher{xt}
+	}
+	return typeOfMatcher{reflect.TypeOf(x)}
+}
+
 // InAnyOrder is a Matcher that returns true for collections of the same elements ignoring the order.
 //
 // Example usage:
@@ -441,3 +694,110 @@ func AssignableToTypeOf(x any) Matcher {
 func InAnyOrder(x any) Matcher {
 	return inAnyOrderMatcher{x}
 }
+
+// Field returns a matcher that matches a struct, or a pointer to one, whose
+// field at the dotted path matches inner. The path goes through pointers and
+// may name fields promoted from embedded structs. It does not match if the
+// path does not exist, goes through a nil pointer, or ends at an unexported
+// field.
+//
+// Example usage:
+//
+//	type User struct{ ID int }
+//	type Request struct{ User *User }
+//
+//	Field("User.ID", Eq(1)).Matches(Request{User: &User{ID: 1}}) // returns true
+//	Field("User.ID", Eq(1)).Matches(Request{}) // returns false
+//	Field("User.Name", Eq(1)).Matches(Request{User: &User{ID: 1}}) // returns false
+func Field(path string, inner Matcher) Matcher {
+	return fieldMatcher{path: path, inner: inner}
+}
+
+// TimeApprox returns a matcher that matches a time.Time within tolerance of
+// the expected time, in either direction. Monotonic clock readings are
+// stripped before comparing, so times derived from time.Now() compare by wall
+// clock. It does not match values of other types.
+//
+// Example usage:
+//
+//	now := time.Now()
+//	TimeApprox(now, time.Second).Matches(now.Add(500 * time.Millisecond)) // returns true
+//	TimeApprox(now, time.Second).Matches(now.Add(2 * time.Second)) // returns false
+//	TimeApprox(now, time.Second).Matches(now.Unix()) // returns false
+func TimeApprox(expected time.Time, tolerance time.Duration) Matcher {
+	if tolerance < 0 {
+		tolerance = -tolerance
+	}
+	return timeApproxMatcher{t: expected.Round(0), tolerance: tolerance}
+}
+
+// ErrorIs returns a matcher that matches an error for which
+// errors.Is(err, target) reports true, such as an error wrapping target. It
+// does not match values that are not errors.
+//
+// Example usage:
+//
+//	ErrorIs(fs.ErrNotExist).Matches(fmt.Errorf("open: %w", fs.ErrNotExist)) // returns true
+//	ErrorIs(fs.ErrNotExist).Matches(fs.ErrExist) // returns false
+//	ErrorIs(fs.ErrNotExist).Matches("file does not exist") // returns false
+func ErrorIs(target error) Matcher {
+	return errorIsMatcher{target}
+}
+
+// ErrorAs returns a matcher that matches an error for which
+// errors.As(err, targetPtr) succeeds, that is an error whose chain has an
+// error assignable to the type targetPtr points to. It does not match values
+// that are not errors. targetPtr is not set by matching; it only provides the
+// type. ErrorAs panics if targetPtr is not a non-nil pointer to a type
+// implementing error or to an interface type, like errors.As.
+//
+// Example usage:
+//
+//	var pathErr *fs.PathError
+//	ErrorAs(&pathErr).Matches(fmt.Errorf("load: %w", &fs.PathError{Op: "open"})) // returns true
+//	ErrorAs(&pathErr).Matches(errors.New("open")) // returns false
+//	ErrorAs(&pathErr).Matches(&fs.PathError{}) // returns true
+func ErrorAs(targetPtr any) Matcher {
+	if targetPtr == nil {
+		panic("gomock: ErrorAs target cannot be nil")
+	}
+	t := reflect.TypeOf(targetPtr)
+	if t.Kind() != reflect.Ptr || reflect.ValueOf(targetPtr).IsNil() {
+		panic("gomock: ErrorAs target must be a non-nil pointer")
+	}
+	if elem := t.Elem(); elem.Kind() != reflect.Interface && !elem.Implements(reflect.TypeOf((*error)(nil)).Elem()) {
+		panic(fmt.Sprintf("gomock: ErrorAs target must be a pointer to an interface or to a type implementing error, got %v", t))
+	}
+	return errorAsMatcher{t.Elem()}
+}
+
+// Implements returns a matcher that matches a value whose dynamic type
+// implements the interface ifacePtr points to, whatever its concrete type. A
+// nil value never matches. Implements panics if ifacePtr is not a pointer to
+// an interface type, such as (*io.Closer)(nil).
+//
+// Example usage:
+//
+//	Implements((*io.Closer)(nil)).Matches(&os.File{}) // returns true
+//	Implements((*io.Closer)(nil)).Matches(&bytes.Buffer{}) // returns false
+//	Implements((*io.Closer)(nil)).Matches(nil) // returns false
+func Implements(ifacePtr any) Matcher {
+	t := reflect.TypeOf(ifacePtr)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Interface {
+		panic(fmt.Sprintf("gomock: Implements argument must be a pointer to an interface, such as (*io.Closer)(nil), got %v", t))
+	}
+	return implementsMatcher{t.Elem()}
+}
+
+// Each returns a matcher that matches a slice or array whose every element
+// matches inner, whatever their number and order. An empty slice or array
+// matches, since it has no element that doesn't; combine Each with Len to
+// require elements. Values other than slices and arrays never match.
+//
+// Example usage:
+//
+//	Each(Not(Nil())).Matches([]*int{new(int), new(int)}) // returns true
+//	Each(Cond(func(x any) bool { return x.(int) > 0 })).Matches([]int{1, -2}) // returns false
+//	Each(Eq(1)).Matches([]int{}) // returns true
+//	Each(Eq(1)).Matches(1) // returns false
+func Each(inner Matcher) Matcher { return eachMatcher{inner} }
@@ -17,10 +17,16 @@ package gomock_test
 //go:generate mockgen -destination internal/mock_gomock/mock_matcher.go go.uber.org/mock/gomock Matcher
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"os"
 	"reflect"
 	"testing"
+	"time"
+	"unsafe"
 
 	"go.uber.org/mock/gomock"
 	"go.uber.org/mock/gomock/internal/mock_gomock"
@@ -44,8 +50,8 @@ func TestMatchers(t *testing.T) {
 			[]e{"s", "", 0, 4, 10}},
 		{"test All", gomock.Eq(4), []e{4}, []e{3, "blah", nil, int64(4)}},
 		{"test Nil", gomock.Nil(),
-			[]e{nil, (error)(nil), (chan bool)(nil), (*int)(nil)},
-			[]e{"", 0, make(chan bool), errors.New("err"), new(int)}},
+			[]e{nil, (error)(nil), (chan bool)(nil), (*int)(nil), unsafe.Pointer(nil)},
+			[]e{"", 0, make(chan bool), errors.New("err"), new(int), unsafe.Pointer(new(int))}},
 		{"test Not", gomock.Not(gomock.Eq(4)), []e{3, "blah", nil, int64(4)}, []e{4}},
 		{"test Regex", gomock.Regex("[0-9]{2}:[0-9]{2}"), []e{"23:02", "[23:02]: Hello world", []byte("23:02")}, []e{4, "23-02", "hello world", true, []byte("23-02")}},
 		{"test All", gomock.All(gomock.Any(), gomock.Eq(4)), []e{4}, []e{3, "blah", nil, int64(4)}},
@@ -57,6 +63,23 @@ func TestMatchers(t *testing.T) {
 			[]e{[]string{"a", "b"}, A{"a", "b"}},
 			[]e{[]string{"a"}, A{"b"}},
 		},
+		{"test OneOf", gomock.OneOf("red", "green", gomock.Nil()),
+			[]e{"red", "green", nil},
+			[]e{"blue", "", 1}},
+		{"test empty OneOf", gomock.OneOf(), nil, []e{nil, 0, ""}},
+		{"test TypeOf", gomock.TypeOf(&B{}),
+			[]e{&B{Name: "Dam"}, (*B)(nil)},
+			[]e{B{}, nil, errors.New("err"), A{}}},
+		{"test TypeOf reflect.Type", gomock.TypeOf(reflect.TypeOf(A{})),
+			[]e{A{"a"}, A(nil)},
+			[]e{[]string{"a"}, nil}},
+		{"test TypeOf nil", gomock.TypeOf(nil), nil, []e{nil, 0, ""}},
+		{"test NilOr", gomock.NilOr(gomock.Field("Name", gomock.Eq("Dam"))),
+			[]e{nil, (*B)(nil), &B{Name: "Dam"}, B{Name: "Dam"}},
+			[]e{&B{Name: "Dave"}, B{}}},
+		{"test Xor", gomock.Xor(gomock.Nil(), gomock.Len(0)),
+			[]e{[]int{}, ""},
+			[]e{[]int(nil), []int{1}, "a"}},
 		{"test Cond", gomock.Cond(func(x any) bool { return x.(B).Name == "Dam" }), []e{B{Name: "Dam"}}, []e{B{Name: "Dave"}}},
 	}
 	for _, tt := range tests {
@@ -356,3 +379,414 @@ func TestInAnyOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeApprox(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		given     any
+		wantMatch bool
+	}{
+		{
+			name:      "match for equal time",
+			given:     now,
+			wantMatch: true,
+		},
+		{
+			name:      "match for wall clock copy of time",
+			given:     now.Round(0),
+			wantMatch: true,
+		},
+		{
+			name:      "match for later time within tolerance",
+			given:     now.Add(time.Second),
+			wantMatch: true,
+		},
+		{
+			name:      "match for earlier time within tolerance",
+			given:     now.Add(-time.Second),
+			wantMatch: true,
+		},
+		{
+			name:      "match for same instant in another location",
+			given:     now.In(time.FixedZone("UTC+5", 5*60*60)),
+			wantMatch: true,
+		},
+		{
+			name:      "not match for later time outside tolerance",
+			given:     now.Add(time.Second + time.Nanosecond),
+			wantMatch: false,
+		},
+		{
+			name:      "not match for earlier time outside tolerance",
+			given:     now.Add(-time.Minute),
+			wantMatch: false,
+		},
+		{
+			name:      "not match for pointer to time",
+			given:     &now,
+			wantMatch: false,
+		},
+		{
+			name:      "not match for unix timestamp",
+			given:     now.Unix(),
+			wantMatch: false,
+		},
+		{
+			name:      "not match for nil",
+			given:     nil,
+			wantMatch: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gomock.TimeApprox(now, time.Second).Matches(tt.given); got != tt.wantMatch {
+				t.Errorf("got = %v, wantMatch %v", got, tt.wantMatch)
+			}
+		})
+	}
+
+	want := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	if got, wantStr := gomock.TimeApprox(want, time.Second).String(), "is within 1s of 2024-01-02T15:04:05Z"; got != wantStr {
+		t.Errorf("String() = %q, want %q", got, wantStr)
+	}
+}
+
+type fieldUser struct {
+	ID   int
+	name string
+}
+
+type fieldAudit struct {
+	By *fieldUser
+}
+
+type fieldRequest struct {
+	fieldAudit
+	User  *fieldUser
+	Owner fieldUser
+	Meta  any
+}
+
+// fieldOwned promotes the fields of an embedded pointer.
+type fieldOwned struct {
+	*fieldUser
+}
+
+func TestField(t *testing.T) {
+	req := fieldRequest{
+		fieldAudit: fieldAudit{By: &fieldUser{ID: 3}},
+		User:       &fieldUser{ID: 1, name: "n"},
+		Owner:      fieldUser{ID: 2},
+		Meta:       &fieldUser{ID: 4},
+	}
+	tests := []struct {
+		name      string
+		matcher   gomock.Matcher
+		given     any
+		wantMatch bool
+	}{
+		{
+			name:      "match for field of struct",
+			matcher:   gomock.Field("Owner.ID", gomock.Eq(2)),
+			given:     req,
+			wantMatch: true,
+		},
+		{
+			name:      "match through pointers",
+			matcher:   gomock.Field("User.ID", gomock.Eq(1)),
+			given:     &req,
+			wantMatch: true,
+		},
+		{
+			name:      "match through embedded struct",
+			matcher:   gomock.Field("By.ID", gomock.Eq(3)),
+			given:     req,
+			wantMatch: true,
+		},
+		{
+			name:      "match through interface",
+			matcher:   gomock.Field("Meta.ID", gomock.Eq(4)),
+			given:     req,
+			wantMatch: true,
+		},
+		{
+			name:      "not match for different value",
+			matcher:   gomock.Field("User.ID", gomock.Eq(1)),
+			given:     fieldRequest{User: &fieldUser{ID: 5}},
+			wantMatch: false,
+		},
+		{
+			name:      "not match through nil pointer",
+			matcher:   gomock.Field("User.ID", gomock.Eq(1)),
+			given:     fieldRequest{},
+			wantMatch: false,
+		},
+		{
+			name:      "not match for nil pointer argument",
+			matcher:   gomock.Field("User.ID", gomock.Eq(1)),
+			given:     (*fieldRequest)(nil),
+			wantMatch: false,
+		},
+		{
+			name:      "match through embedded pointer",
+			matcher:   gomock.Field("ID", gomock.Eq(6)),
+			given:     fieldOwned{&fieldUser{ID: 6}},
+			wantMatch: true,
+		},
+		{
+			name:      "not match through nil embedded pointer",
+			matcher:   gomock.Field("ID", gomock.Any()),
+			given:     fieldOwned{},
+			wantMatch: false,
+		},
+		{
+			name:      "not match for missing field",
+			matcher:   gomock.Field("User.Email", gomock.Any()),
+			given:     req,
+			wantMatch: false,
+		},
+		{
+			name:      "not match for field of non-struct",
+			matcher:   gomock.Field("User.ID.Value", gomock.Any()),
+			given:     req,
+			wantMatch: false,
+		},
+		{
+			name:      "not match for unexported field",
+			matcher:   gomock.Field("User.name", gomock.Any()),
+			given:     req,
+			wantMatch: false,
+		},
+		{
+			name:      "not match for non-struct argument",
+			matcher:   gomock.Field("ID", gomock.Any()),
+			given:     1,
+			wantMatch: false,
+		},
+		{
+			name:      "not match for nil",
+			matcher:   gomock.Field("ID", gomock.Any()),
+			given:     nil,
+			wantMatch: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.matcher.Matches(tt.given); got != tt.wantMatch {
+				t.Errorf("got = %v, wantMatch %v", got, tt.wantMatch)
+			}
+		})
+	}
+
+	if got, want := gomock.Field("User.ID", gomock.Eq(1)).String(), `field "User.ID" matches is equal to 1 (int)`; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestOneOfString(t *testing.T) {
+	tests := []struct {
+		matcher gomock.Matcher
+		want    string
+	}{
+		{gomock.OneOf("a", "b", "c"), "is one of [a, b, c]"},
+		{gomock.OneOf(1, gomock.Nil()), "is one of [1, is nil]"},
+		{gomock.OneOf(), "is one of []"},
+	}
+	for _, tt := range tests {
+		if got := tt.matcher.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestTypeOfString(t *testing.T) {
+	var err error = &os.PathError{}
+	tests := []struct {
+		matcher gomock.Matcher
+		want    string
+	}{
+		{gomock.TypeOf(err), "has exact type *fs.PathError"},
+		{gomock.TypeOf(time.Second), "has exact type time.Duration"},
+		{gomock.TypeOf(nil), "has exact type <nil>"},
+	}
+	for _, tt := range tests {
+		if got := tt.matcher.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestNilOrString(t *testing.T) {
+	if got, want := gomock.NilOr(gomock.Eq(4)).String(), "is nil or is equal to 4 (int)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestXor(t *testing.T) {
+	matcher := func(matches bool) gomock.Matcher {
+		if matches {
+			return gomock.Any()
+		}
+		return gomock.Not(gomock.Any())
+	}
+	tests := []struct {
+		a, b, want bool
+	}{
+		{false, false, false},
+		{false, true, true},
+		{true, false, true},
+		{true, true, false},
+	}
+	for _, tt := range tests {
+		if got := gomock.Xor(matcher(tt.a), matcher(tt.b)).Matches(0); got != tt.want {
+			t.Errorf("Xor(%t, %t).Matches() = %t, want %t", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestXorString(t *testing.T) {
+	if got, want := gomock.Xor(gomock.Nil(), gomock.Eq(4)).String(), "exactly one of (is nil, is equal to 4 (int))"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	tests := []struct {
+		name      string
+		given     any
+		wantMatch bool
+	}{
+		{"match for target", os.ErrNotExist, true},
+		{"match for wrapped target", fmt.Errorf("open: %w", os.ErrNotExist), true},
+		{"not match for other error", os.ErrExist, false},
+		{"not match for error message", os.ErrNotExist.Error(), false},
+		{"not match for nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gomock.ErrorIs(os.ErrNotExist).Matches(tt.given); got != tt.wantMatch {
+				t.Errorf("got = %v, wantMatch %v", got, tt.wantMatch)
+			}
+		})
+	}
+
+	if got, want := gomock.ErrorIs(os.ErrNotExist).String(), `matches error target "file does not exist"`; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestErrorAs(t *testing.T) {
+	var pathErr *os.PathError
+	tests := []struct {
+		name      string
+		given     any
+		wantMatch bool
+	}{
+		{"match for target type", &os.PathError{Op: "open"}, true},
+		{"match for wrapped target type", fmt.Errorf("load: %w", &os.PathError{Op: "open"}), true},
+		{"not match for other error", errors.New("open"), false},
+		{"not match for non-error of target type", os.PathError{}, false},
+		{"not match for nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gomock.ErrorAs(&pathErr).Matches(tt.given); got != tt.wantMatch {
+				t.Errorf("got = %v, wantMatch %v", got, tt.wantMatch)
+			}
+		})
+	}
+	if pathErr != nil {
+		t.Errorf("matching set the target to %v", pathErr)
+	}
+
+	var iface interface{ Timeout() bool }
+	if !gomock.ErrorAs(&iface).Matches(fmt.Errorf("dial: %w", context.DeadlineExceeded)) {
+		t.Error("ErrorAs() of an interface target did not match an implementing error")
+	}
+
+	if got, want := gomock.ErrorAs(&pathErr).String(), "matches error target of type *fs.PathError"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	for _, target := range []any{nil, pathErr, new(int), (**os.PathError)(nil)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("ErrorAs(%#v) did not panic", target)
+				}
+			}()
+			gomock.ErrorAs(target)
+		}()
+	}
+}
+
+func TestImplements(t *testing.T) {
+	closer := gomock.Implements((*io.Closer)(nil))
+	tests := []struct {
+		name      string
+		given     any
+		wantMatch bool
+	}{
+		{"match for implementing pointer", &os.File{}, true},
+		{"match for implementing value", io.NopCloser(nil), true},
+		{"not match for other type", &bytes.Buffer{}, false},
+		{"not match for value of pointer receiver type", os.File{}, false},
+		{"not match for nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := closer.Matches(tt.given); got != tt.wantMatch {
+				t.Errorf("got = %v, wantMatch %v", got, tt.wantMatch)
+			}
+		})
+	}
+
+	if got, want := closer.String(), "implements io.Closer"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	for _, iface := range []any{nil, new(os.File), io.Closer(nil), (**io.Closer)(nil)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Implements(%#v) did not panic", iface)
+				}
+			}()
+			gomock.Implements(iface)
+		}()
+	}
+}
+
+func TestEach(t *testing.T) {
+	positive := gomock.Cond(func(x any) bool { i, ok := x.(int); return ok && i > 0 })
+	tests := []struct {
+		name      string
+		matcher   gomock.Matcher
+		given     any
+		wantMatch bool
+	}{
+		{"match when every element matches", gomock.Each(positive), []int{1, 2, 3}, true},
+		{"match for array", gomock.Each(positive), [2]int{1, 2}, true},
+		{"not match when an element does not match", gomock.Each(positive), []int{1, -2, 3}, false},
+		{"match for empty slice", gomock.Each(positive), []int{}, true},
+		{"match for nil slice", gomock.Each(gomock.Nil()), []*int(nil), true},
+		{"match for non-nil elements", gomock.Each(gomock.Not(gomock.Nil())), []*int{new(int), new(int)}, true},
+		{"not match for nil element", gomock.Each(gomock.Not(gomock.Nil())), []*int{new(int), nil}, false},
+		{"match for interface elements", gomock.Each(gomock.Regex("^a")), []any{"ab", []byte("ac")}, true},
+		{"not match for non-slice", gomock.Each(positive), 1, false},
+		{"not match for string", gomock.Each(gomock.Any()), "abc", false},
+		{"not match for nil", gomock.Each(gomock.Any()), nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.matcher.Matches(tt.given); got != tt.wantMatch {
+				t.Errorf("got = %v, wantMatch %v", got, tt.wantMatch)
+			}
+		})
+	}
+
+	if got, want := gomock.Each(gomock.Eq(4)).String(), "each element matches is equal to 4 (int)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,47 @@
+package gomock
+
+// randomMatch returns a random one of the calls of matches with the highest
+// matchRank, or nil if there are none. The caller must hold ctrl.mu.
+func (ctrl *Controller) randomMatch(matches []*Call) *Call {
+	var best []*Call
+	var bestRank matchRank
+	for _, c := range matches {
+		r := c.matchRank()
+		switch {
+		case len(best) == 0 || bestRank.less(r):
+			best, bestRank = []*Call{c}, r
+		case !r.less(bestRank):
+			best = append(best, c)
+		}
+	}
+	if len(best) == 0 {
+		return nil
+	}
+	return best[ctrl.random.Intn(len(best))]
+}
+
+// matchRank is the precedence of an expected call among those matching a
+// call with WithRandomMatching.
+type matchRank struct {
+	// ordered is whether the call must be made after other calls.
+	ordered bool
+	// specific is the number of arguments not matched by Any.
+	specific int
+}
+
+func (r matchRank) less(o matchRank) bool {
+	if r.ordered != o.ordered {
+		return o.ordered
+	}
+	return r.specific < o.specific
+}
+
+func (c *Call) matchRank() matchRank {
+	r := matchRank{ordered: len(c.preReqs) > 0}
+	for _, m := range c.args {
+		if _, ok := m.(anyMatcher); !ok {
+			r.specific++
+		}
+	}
+	return r
+}
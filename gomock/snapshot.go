@@ -0,0 +1,188 @@
+package gomock
+
+import "context"
+
+// A Snapshot is the state of the expected calls of a Controller, taken with
+// [Controller.Snapshot] and returned to with [Controller.Restore].
+//
+// The recorded calls are only ever appended to, so a Snapshot shares them with
+// the Controller instead of copying them: it keeps the slices with their
+// capacity clipped to their length, so that appending to them after Restore
+// allocates anew rather than overwriting what the Snapshot holds.
+type Snapshot struct {
+	ctrl                *Controller
+	expected, exhausted map[callSetKey][]*Call
+	calls               map[*Call]callState
+	history             map[mockMethod][][]any
+	lastContexts        map[mockMethod]context.Context
+	timelineEntries     []timelineEntry
+}
+
+// callState is the mutable state of a Call.
+type callState struct {
+	minCalls, maxCalls int
+	numCalls           int
+	preReqs            []*Call
+	actions            []func([]any) []any
+	calledArgs         [][]any
+	goroutines         []string
+	sequences          []*returnSequence
+	positions          []int // of sequences
+}
+
+// Snapshot returns the current state of the expected calls of the
+// controller: how many times each has been called, with which arguments, and
+// which are exhausted. Together with [Controller.Restore], it lets a test
+// that runs many iterations against the same expectations, such as a fuzz
+// test, register them once:
+//
+//	m.EXPECT().Get(gomock.Any()).Return(1, nil).AnyTimes()
+//	m.EXPECT().Close()
+//	s := ctrl.Snapshot()
+//	f.Fuzz(func(t *testing.T, key string) {
+//		defer ctrl.Restore(s)
+//		run(m, key)
+//		ctrl.Verify()
+//	})
+func (ctrl *Controller) Snapshot() Snapshot {
+	ctrl.mu.Lock()
+	defer ctrl.mu.Unlock()
+
+	s := Snapshot{
+		ctrl:            ctrl,
+		calls:           make(map[*Call]callState),
+		history:         make(map[mockMethod][][]any, len(ctrl.history)),
+		lastContexts:    make(map[mockMethod]context.Context, len(ctrl.lastContexts)),
+		timelineEntries: clip(ctrl.timelineEntries),
+	}
+	s.expected, s.exhausted = ctrl.expectedCalls.copyCalls()
+	for _, m := range []map[callSetKey][]*Call{s.expected, s.exhausted} {
+		for _, calls := range m {
+			for _, call := range calls {
+				s.calls[call] = call.state()
+			}
+		}
+	}
+	for key, calls := range ctrl.history {
+		s.history[key] = clip(calls)
+	}
+	for key, ctx := range ctrl.lastContexts {
+		s.lastContexts[key] = ctx
+	}
+	return s
+}
+
+// Restore returns the expected calls of the controller to the state of s,
+// which must have been taken from the controller with [Controller.Snapshot].
+// The expected calls registered when s was taken are kept, with the number
+// of times they have been called, their arguments, their actions, such as
+// Return, and their ReturnsInOrder positions reset to what they were then,
+// while expected calls registered after s was taken are dropped.
+// [Controller.CallsTo], [Controller.LastContext] and the timeline of
+// [WithTimeline] forget the calls made since. Restore does not undo
+// [Controller.Finish], and s can be restored any number of times.
+func (ctrl *Controller) Restore(s Snapshot) {
+	ctrl.T.Helper()
+	if s.ctrl != ctrl {
+		ctrl.T.Fatalf("gomock: Restore of a Snapshot that was not taken from this Controller")
+		return
+	}
+
+	ctrl.mu.Lock()
+	defer ctrl.mu.Unlock()
+
+	ctrl.expectedCalls.restoreCalls(s.expected, s.exhausted)
+	for call, state := range s.calls {
+		call.restore(state)
+	}
+	ctrl.history = make(map[mockMethod][][]any, len(s.history))
+	for key, calls := range s.history {
+		ctrl.history[key] = calls
+	}
+	ctrl.lastContexts = make(map[mockMethod]context.Context, len(s.lastContexts))
+	for key, ctx := range s.lastContexts {
+		ctrl.lastContexts[key] = ctx
+	}
+	ctrl.timelineEntries = s.timelineEntries
+}
+
+// copyCalls returns copies of the expected and exhausted calls.
+func (cs callSet) copyCalls() (expected, exhausted map[callSetKey][]*Call) {
+	cs.expectedMu.Lock()
+	defer cs.expectedMu.Unlock()
+	return copyCallMap(cs.expected), copyCallMap(cs.exhausted)
+}
+
+// restoreCalls replaces the expected and exhausted calls with copies of the
+// given ones.
+func (cs callSet) restoreCalls(expected, exhausted map[callSetKey][]*Call) {
+	cs.expectedMu.Lock()
+	defer cs.expectedMu.Unlock()
+	for _, m := range []map[callSetKey][]*Call{cs.expected, cs.exhausted} {
+		for key := range m {
+			delete(m, key)
+		}
+	}
+	for key, calls := range copyCallMap(expected) {
+		cs.expected[key] = calls
+	}
+	for key, calls := range copyCallMap(exhausted) {
+		cs.exhausted[key] = calls
+	}
+}
+
+func copyCallMap(m map[callSetKey][]*Call) map[callSetKey][]*Call {
+	c := make(map[callSetKey][]*Call, len(m))
+	for key, calls := range m {
+		c[key] = append([]*Call(nil), calls...)
+	}
+	return c
+}
+
+// state returns the mutable state of c. The caller must hold the lock of the
+// controller.
+func (c *Call) state() callState {
+	c.argsMu.Lock()
+	defer c.argsMu.Unlock()
+	s := callState{
+		minCalls:   c.minCalls,
+		maxCalls:   c.maxCalls,
+		numCalls:   c.numCalls,
+		preReqs:    append([]*Call(nil), c.preReqs...),
+		actions:    append([]func([]any) []any(nil), c.actions...),
+		calledArgs: clip(c.calledArgs),
+		goroutines: clip(c.goroutines),
+		sequences:  append([]*returnSequence(nil), c.sequences...),
+		positions:  make([]int, len(c.sequences)),
+	}
+	for i, seq := range c.sequences {
+		seq.mu.Lock()
+		s.positions[i] = seq.next
+		seq.mu.Unlock()
+	}
+	return s
+}
+
+// restore returns c to the state s. The caller must hold the lock of the
+// controller.
+func (c *Call) restore(s callState) {
+	c.argsMu.Lock()
+	defer c.argsMu.Unlock()
+	c.minCalls, c.maxCalls = s.minCalls, s.maxCalls
+	c.numCalls = s.numCalls
+	c.preReqs = append([]*Call(nil), s.preReqs...)
+	c.actions = append([]func([]any) []any(nil), s.actions...)
+	c.calledArgs = s.calledArgs
+	c.goroutines = s.goroutines
+	c.sequences = append([]*returnSequence(nil), s.sequences...)
+	for i, seq := range c.sequences {
+		seq.mu.Lock()
+		seq.next = s.positions[i]
+		seq.mu.Unlock()
+	}
+}
+
+// clip returns s with its capacity reduced to its length.
+func clip[S ~[]E, E any](s S) S {
+	return s[:len(s):len(s)]
+}
@@ -9,13 +9,18 @@ type mockedStringer interface {
 	fmt.Stringer
 	mockInstance
 }
+type mockedFormatter interface {
+	fmt.Formatter
+	mockInstance
+}
 
 // getString is a safe way to convert a value to a string for printing results
 // If the value is a a mock, getString avoids calling the mocked String() method,
-// which avoids potential deadlocks
+// which avoids potential deadlocks, or the Format method mockgen -formatter
+// generates, which locks the controller.
 func getString(x any) string {
 	switch v := x.(type) {
-	case mockedStringer:
+	case mockedStringer, mockedFormatter:
 		return fmt.Sprintf("%T", v)
 	case fmt.Stringer:
 		return v.String()
@@ -0,0 +1,40 @@
+package gomock
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timelineEntry is a call recorded for WithTimeline.
+type timelineEntry struct {
+	// at is the time of the call since the controller was created, which
+	// time.Since measures with the monotonic clock.
+	at time.Duration
+	// call describes the call and where it was made.
+	call string
+	// expected is where the expected call it matched was registered.
+	expected string
+}
+
+// recordTimeline adds a call that matched expected to the timeline. The
+// caller must hold ctrl.mu.
+func (ctrl *Controller) recordTimeline(receiver any, method string, args []any, origin string, expected *Call) {
+	ctrl.timelineEntries = append(ctrl.timelineEntries, timelineEntry{
+		at:       time.Since(ctrl.created),
+		call:     fmt.Sprintf("%s.%v(%v) at %s", describeReceiver(receiver, ctrl.mockNames[receiver]), method, formatArgs(args), origin),
+		expected: expected.origin,
+	})
+}
+
+// reportTimeline reports the timeline of the matched calls, in the order
+// they were made. The caller must hold ctrl.mu.
+func (ctrl *Controller) reportTimeline() {
+	ctrl.T.Helper()
+	var b strings.Builder
+	fmt.Fprintf(&b, "timeline of the %d matched call(s):", len(ctrl.timelineEntries))
+	for _, e := range ctrl.timelineEntries {
+		fmt.Fprintf(&b, "\n\t+%v %s, matching the call expected at %s", e.at, e.call, e.expected)
+	}
+	ctrl.T.Errorf("%s", b.String())
+}
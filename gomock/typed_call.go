@@ -0,0 +1,159 @@
+package gomock
+
+// The TypedCall types are the calls returned by the recorders of mocks
+// generated with -typed=generic. They wrap *Call for a method of type F with
+// the given results, so that Return, Do and DoAndReturn are type-checked
+// without a generated call type per method. The remaining methods are those
+// of *Call. Using them requires Go 1.18 or later.
+
+// TypedCall0 wraps *Call for a method of type F without results.
+type TypedCall0[F any] struct {
+	*Call
+}
+
+// Return rewrites *Call.Return.
+func (c *TypedCall0[F]) Return() *TypedCall0[F] {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// ReturnZero rewrites *Call.Return with the zero values of the results.
+func (c *TypedCall0[F]) ReturnZero() *TypedCall0[F] {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrites *Call.Do.
+func (c *TypedCall0[F]) Do(f F) *TypedCall0[F] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrites *Call.DoAndReturn.
+func (c *TypedCall0[F]) DoAndReturn(f F) *TypedCall0[F] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// TypedCall1 wraps *Call for a method of type F with a result of type R1.
+type TypedCall1[F, R1 any] struct {
+	*Call
+}
+
+// Return rewrites *Call.Return.
+func (c *TypedCall1[F, R1]) Return(r1 R1) *TypedCall1[F, R1] {
+	c.Call = c.Call.Return(r1)
+	return c
+}
+
+// ReturnsInOrder rewrites *Call.ReturnsInOrder.
+func (c *TypedCall1[F, R1]) ReturnsInOrder(rets ...R1) *TypedCall1[F, R1] {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// ReturnZero rewrites *Call.Return with the zero values of the results.
+func (c *TypedCall1[F, R1]) ReturnZero() *TypedCall1[F, R1] {
+	var r1 R1
+	c.Call = c.Call.Return(r1)
+	return c
+}
+
+// Do rewrites *Call.Do.
+func (c *TypedCall1[F, R1]) Do(f F) *TypedCall1[F, R1] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrites *Call.DoAndReturn.
+func (c *TypedCall1[F, R1]) DoAndReturn(f F) *TypedCall1[F, R1] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// TypedCall2 wraps *Call for a method of type F with results of types R1
+// and R2.
+type TypedCall2[F, R1, R2 any] struct {
+	*Call
+}
+
+// Return rewrites *Call.Return.
+func (c *TypedCall2[F, R1, R2]) Return(r1 R1, r2 R2) *TypedCall2[F, R1, R2] {
+	c.Call = c.Call.Return(r1, r2)
+	return c
+}
+
+// ReturnsInOrder rewrites *Call.ReturnsInOrder. Each of rets holds the
+// values of R1 and R2, which are checked when the call is recorded.
+func (c *TypedCall2[F, R1, R2]) ReturnsInOrder(rets ...[]any) *TypedCall2[F, R1, R2] {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// ReturnZero rewrites *Call.Return with the zero values of the results.
+func (c *TypedCall2[F, R1, R2]) ReturnZero() *TypedCall2[F, R1, R2] {
+	var (
+		r1 R1
+		r2 R2
+	)
+	c.Call = c.Call.Return(r1, r2)
+	return c
+}
+
+// Do rewrites *Call.Do.
+func (c *TypedCall2[F, R1, R2]) Do(f F) *TypedCall2[F, R1, R2] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrites *Call.DoAndReturn.
+func (c *TypedCall2[F, R1, R2]) DoAndReturn(f F) *TypedCall2[F, R1, R2] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// TypedCall3 wraps *Call for a method of type F with results of types R1,
+// R2 and R3.
+type TypedCall3[F, R1, R2, R3 any] struct {
+	*Call
+}
+
+// Return rewrites *Call.Return.
+func (c *TypedCall3[F, R1, R2, R3]) Return(r1 R1, r2 R2, r3 R3) *TypedCall3[F, R1, R2, R3] {
+	c.Call = c.Call.Return(r1, r2, r3)
+	return c
+}
+
+// ReturnsInOrder rewrites *Call.ReturnsInOrder. Each of rets holds the
+// values of R1, R2 and R3, which are checked when the call is recorded.
+func (c *TypedCall3[F, R1, R2, R3]) ReturnsInOrder(rets ...[]any) *TypedCall3[F, R1, R2, R3] {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// ReturnZero rewrites *Call.Return with the zero values of the results.
+func (c *TypedCall3[F, R1, R2, R3]) ReturnZero() *TypedCall3[F, R1, R2, R3] {
+	var (
+		r1 R1
+		r2 R2
+		r3 R3
+	)
+	c.Call = c.Call.Return(r1, r2, r3)
+	return c
+}
+
+// Do rewrites *Call.Do.
+func (c *TypedCall3[F, R1, R2, R3]) Do(f F) *TypedCall3[F, R1, R2, R3] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrites *Call.DoAndReturn.
+func (c *TypedCall3[F, R1, R2, R3]) DoAndReturn(f F) *TypedCall3[F, R1, R2, R3] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,117 @@
+package gomock_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+// typedSubject is a receiver for testing the TypedCall types.
+type typedSubject struct{ _ int }
+
+func (s *typedSubject) Get(key string) (int, error) { return 0, nil }
+func (s *typedSubject) Len() int                    { return 0 }
+func (s *typedSubject) Reset()                      {}
+func (s *typedSubject) Stat(path string) (int64, bool, error) {
+	return 0, false, nil
+}
+
+func TestTypedCall(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	s := new(typedSubject)
+	record := func(method string, args ...any) *gomock.Call {
+		return ctrl.RecordCallWithMethodType(s, method, reflect.TypeOf(reflect.ValueOf(s).MethodByName(method).Interface()), args...)
+	}
+
+	errNotFound := errors.New("not found")
+	(&gomock.TypedCall2[func(string) (int, error), int, error]{Call: record("Get", "a")}).Return(1, nil)
+	(&gomock.TypedCall2[func(string) (int, error), int, error]{Call: record("Get", "b")}).DoAndReturn(func(key string) (int, error) {
+		return 0, errNotFound
+	})
+	(&gomock.TypedCall1[func() int, int]{Call: record("Len")}).ReturnsInOrder(1, 2)
+	reset := false
+	(&gomock.TypedCall0[func()]{Call: record("Reset")}).Do(func() { reset = true })
+
+	if rets := ctrl.Call(s, "Get", "a"); rets[0] != 1 || rets[1] != nil {
+		t.Errorf(`Get("a") = %v, want [1 <nil>]`, rets)
+	}
+	if rets := ctrl.Call(s, "Get", "b"); rets[0] != 0 || rets[1] != errNotFound {
+		t.Errorf(`Get("b") = %v, want [0 %v]`, rets, errNotFound)
+	}
+	for _, want := range []int{1, 2} {
+		if rets := ctrl.Call(s, "Len"); rets[0] != want {
+			t.Errorf("Len() = %v, want [%d]", rets, want)
+		}
+	}
+	ctrl.Call(s, "Reset")
+	if !reset {
+		t.Error("Reset() did not run the Do action")
+	}
+	ctrl.Finish()
+	reporter.assertPass("all typed calls made")
+}
+
+func TestTypedCallReturnZero(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	s := new(typedSubject)
+	record := func(method string, args ...any) *gomock.Call {
+		return ctrl.RecordCallWithMethodType(s, method, reflect.TypeOf(reflect.ValueOf(s).MethodByName(method).Interface()), args...)
+	}
+
+	(&gomock.TypedCall2[func(string) (int, error), int, error]{Call: record("Get", "a")}).ReturnZero().Times(2)
+	(&gomock.TypedCall1[func() int, int]{Call: record("Len")}).ReturnZero()
+	(&gomock.TypedCall0[func()]{Call: record("Reset")}).ReturnZero()
+
+	for i := 0; i < 2; i++ {
+		if rets := ctrl.Call(s, "Get", "a"); rets[0] != 0 || rets[1] != nil {
+			t.Errorf(`Get("a") = %v, want [0 <nil>]`, rets)
+		}
+	}
+	if rets := ctrl.Call(s, "Len"); rets[0] != 0 {
+		t.Errorf("Len() = %v, want [0]", rets)
+	}
+	ctrl.Call(s, "Reset")
+	ctrl.Finish()
+	reporter.assertPass("all typed calls made")
+}
+
+func TestTypedCallReturnsInOrder(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	s := new(typedSubject)
+	record := func(method string, args ...any) *gomock.Call {
+		return ctrl.RecordCallWithMethodType(s, method, reflect.TypeOf(reflect.ValueOf(s).MethodByName(method).Interface()), args...)
+	}
+
+	errNotFound := errors.New("not found")
+	(&gomock.TypedCall2[func(string) (int, error), int, error]{Call: record("Get", "a")}).
+		ReturnsInOrder([]any{1, nil}, []any{0, errNotFound})
+	(&gomock.TypedCall3[func(string) (int64, bool, error), int64, bool, error]{Call: record("Stat", "a")}).
+		ReturnsInOrder([]any{int64(3), true, nil}, []any{int64(0), false, errNotFound})
+
+	if rets := ctrl.Call(s, "Get", "a"); rets[0] != 1 || rets[1] != nil {
+		t.Errorf(`Get("a") = %v, want [1 <nil>]`, rets)
+	}
+	if rets := ctrl.Call(s, "Get", "a"); rets[0] != 0 || rets[1] != errNotFound {
+		t.Errorf(`Get("a") = %v, want [0 %v]`, rets, errNotFound)
+	}
+	if rets := ctrl.Call(s, "Stat", "a"); rets[0] != int64(3) || rets[1] != true || rets[2] != nil {
+		t.Errorf(`Stat("a") = %v, want [3 true <nil>]`, rets)
+	}
+	if rets := ctrl.Call(s, "Stat", "a"); rets[0] != int64(0) || rets[1] != false || rets[2] != errNotFound {
+		t.Errorf(`Stat("a") = %v, want [0 false %v]`, rets, errNotFound)
+	}
+	ctrl.Finish()
+	reporter.assertPass("all typed calls made")
+}
+
+func TestTypedCallReturnsInOrderWrongType(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	s := new(typedSubject)
+	call := ctrl.RecordCallWithMethodType(s, "Get", reflect.TypeOf(s.Get), "a")
+
+	reporter.assertFatal(func() {
+		(&gomock.TypedCall2[func(string) (int, error), int, error]{Call: call}).ReturnsInOrder([]any{"1", nil})
+	}, "ReturnsInOrder at index 0")
+}
@@ -0,0 +1,152 @@
+package main
+
+// This file contains the batch generation driven by -config.
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// config is the -config file format. For example:
+//
+//	{
+//	  "targets": [
+//	    {"source": "foo.go", "destination": "mock_foo.go", "typed": true},
+//	    {"args": ["io", "Reader,Writer"], "destination": "mock_io.go"}
+//	  ]
+//	}
+type config struct {
+	Targets []configTarget `json:"targets"`
+}
+
+// configTarget is a single generation target of a config file. Every key
+// other than "name" and "args" is the name of a mockgen flag and is applied
+// on top of the flags given on the command line.
+type configTarget struct {
+	// Name identifies the target in error messages. It defaults to the
+	// target's source file or reflect mode arguments.
+	Name string
+	// Args are the positional reflect mode arguments: an import path and a
+	// comma-separated list of symbols.
+	Args []string
+	// Flags maps flag names to their values.
+	Flags map[string]string
+}
+
+func (t *configTarget) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	t.Flags = make(map[string]string, len(fields))
+	for k, v := range fields {
+		var err error
+		switch k {
+		case "name":
+			err = json.Unmarshal(v, &t.Name)
+		case "args":
+			err = json.Unmarshal(v, &t.Args)
+		default:
+			var s string
+			if json.Unmarshal(v, &s) != nil {
+				// Booleans and numbers are passed through verbatim.
+				s = string(v)
+			}
+			t.Flags[k] = s
+		}
+		if err != nil {
+			return fmt.Errorf("bad %q in target: %v", k, err)
+		}
+	}
+	return nil
+}
+
+func (t *configTarget) String() string {
+	switch {
+	case t.Name != "":
+		return t.Name
+	case t.Flags["source"] != "":
+		return t.Flags["source"]
+	default:
+		return strings.Join(t.Args, " ")
+	}
+}
+
+// configOnlyFlags are not allowed within targets.
+var configOnlyFlags = map[string]bool{"config": true, "fail_fast": true, "version": true}
+
+// runConfig generates every target of the config file at path. A failing
+// target is reported and the remaining targets are still generated, unless
+// -fail_fast is set.
+func runConfig(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed reading config file: %v", err)
+	}
+	var cfg config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("failed parsing config file %s: %v", path, err)
+	}
+
+	var errs []error
+	for i := range cfg.Targets {
+		target := &cfg.Targets[i]
+		if err := runConfigTarget(target); err != nil {
+			err = fmt.Errorf("target %d (%v): %v", i, target, err)
+			if *failFast {
+				return err
+			}
+			fmt.Fprintln(os.Stderr, err)
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%d of %d targets failed", len(errs), len(cfg.Targets))
+	}
+	return nil
+}
+
+// runConfigTarget generates target using the flags given on the command line
+// overridden by the target's flags. The command line flags are restored
+// afterwards.
+func runConfigTarget(target *configTarget) error {
+	for name, value := range target.Flags {
+		if configOnlyFlags[name] {
+			return fmt.Errorf("flag -%s is not allowed in a target", name)
+		}
+		f := flag.Lookup(name)
+		if f == nil {
+			return fmt.Errorf("unknown flag -%s", name)
+		}
+		restore, err := saveFlag(f)
+		if err != nil {
+			return err
+		}
+		defer restore()
+		if err := flag.Set(name, value); err != nil {
+			return fmt.Errorf("bad value %q for flag -%s: %v", value, name, err)
+		}
+	}
+	if *source == "" && *sourceDir == "" && len(target.Args) == 0 {
+		return errors.New("target needs either a source or reflect mode args")
+	}
+	return generateMock(target.Args)
+}
+
+// saveFlag returns a function that restores the current value of f. The value
+// is copied directly rather than through its String and Set methods, which
+// need not round-trip.
+func saveFlag(f *flag.Flag) (func(), error) {
+	v := reflect.ValueOf(f.Value)
+	if v.Kind() != reflect.Pointer {
+		return nil, fmt.Errorf("flag -%s cannot be set in a target", f.Name)
+	}
+	prev := reflect.New(v.Elem().Type()).Elem()
+	prev.Set(v.Elem())
+	return func() { v.Elem().Set(prev) }, nil
+}
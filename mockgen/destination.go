@@ -0,0 +1,69 @@
+package main
+
+// This file contains the -destination_pattern expansion.
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// destinationData is the data -destination_pattern is executed with.
+type destinationData struct {
+	// SourceDir is the directory of the -source file.
+	SourceDir string
+	// SourceFile is the base name of the -source file, such as foo.go.
+	SourceFile string
+	// SourceBase is SourceFile without its .go extension, such as foo.
+	SourceBase string
+}
+
+// resolveDestination returns the file the mock is written to: -destination,
+// or -destination_pattern expanded for the -source file, or "" for stdout.
+func resolveDestination() (string, error) {
+	if *destinationPattern == "" {
+		return *destination, nil
+	}
+	if *destination != "" {
+		return "", errors.New("-destination and -destination_pattern are mutually exclusive")
+	}
+	if *source == "" {
+		return "", errors.New("-destination_pattern requires -source")
+	}
+	return expandDestinationPattern(*destinationPattern, *source)
+}
+
+// expandDestinationPattern executes pattern for the source file src and
+// checks that the result is a file mockgen can write the mock to.
+func expandDestinationPattern(pattern, src string) (string, error) {
+	tmpl, err := template.New("destination_pattern").Option("missingkey=error").Parse(pattern)
+	if err != nil {
+		return "", fmt.Errorf("invalid -destination_pattern: %v", err)
+	}
+	file := filepath.Base(src)
+	data := destinationData{
+		SourceDir:  filepath.ToSlash(filepath.Dir(src)),
+		SourceFile: file,
+		SourceBase: strings.TrimSuffix(file, ".go"),
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("invalid -destination_pattern: %v", err)
+	}
+
+	dst := filepath.Clean(filepath.FromSlash(buf.String()))
+	switch {
+	case strings.TrimSpace(buf.String()) == "" || strings.ContainsAny(buf.String(), "\x00\n"):
+		return "", fmt.Errorf("-destination_pattern %q renders the invalid path %q", pattern, buf.String())
+	case dst == filepath.Clean(src):
+		return "", fmt.Errorf("-destination_pattern %q renders the -source file %s", pattern, src)
+	}
+	if info, err := os.Stat(dst); err == nil && info.IsDir() {
+		return "", fmt.Errorf("-destination_pattern %q renders the directory %s", pattern, dst)
+	}
+	return dst, nil
+}
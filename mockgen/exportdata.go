@@ -0,0 +1,295 @@
+package main
+
+// This file contains the -export_data alternative to the reflection program.
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"go.uber.org/mock/mockgen/model"
+)
+
+// exportDataMode loads the given symbols of the package at importPath from
+// the export data the compiler writes for it, which go list -export builds or
+// takes from the build cache, instead of building and running a reflection
+// program. The model is the one the reflection program would produce, so that
+// the mocks are the same either way; types the reflection program can't
+// handle are errors, for which reflectMode falls back to the program.
+func exportDataMode(importPath string, symbols []string) (*model.Package, error) {
+	export, err := exportDataFile(importPath)
+	if err != nil {
+		return nil, err
+	}
+	lookup := func(p string) (io.ReadCloser, error) {
+		if p != importPath {
+			return nil, fmt.Errorf("no export data for %s", p)
+		}
+		return os.Open(export)
+	}
+	tpkg, err := importer.ForCompiler(token.NewFileSet(), "gc", lookup).Import(importPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading export data of %s: %v", importPath, err)
+	}
+
+	pkg := &model.Package{
+		// Like the reflection program, which doesn't know the package name.
+		Name: path.Base(importPath),
+	}
+	for _, sym := range symbols {
+		obj, ok := tpkg.Scope().Lookup(sym).(*types.TypeName)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s is not a type", importPath, sym)
+		}
+		if named, ok := unalias(obj.Type()).(*types.Named); ok && named.TypeParams().Len() > 0 {
+			return nil, fmt.Errorf("%s.%s is generic", importPath, sym)
+		}
+		it, ok := obj.Type().Underlying().(*types.Interface)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s is not an interface", importPath, sym)
+		}
+		intf := &model.Interface{Name: sym}
+		for i := 0; i < it.NumMethods(); i++ {
+			// Like reflection, unexported methods are kept for mocks in
+			// the package of the interface.
+			m, err := methodFromTypes(it.Method(i), true)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", importPath, sym, err)
+			}
+			intf.AddMethod(m)
+		}
+		pkg.Interfaces = append(pkg.Interfaces, intf)
+	}
+	return pkg, nil
+}
+
+// exportDataFile returns the export data file of the package at importPath,
+// built for the platform and with the flags of the reflection program.
+func exportDataFile(importPath string) (string, error) {
+	args, err := goBuildArgs()
+	if err != nil {
+		return "", err
+	}
+	args[0] = "list"
+	args = append(args, "-export", "-json", "--", importPath)
+
+	var stderr strings.Builder
+	cmd := exec.Command("go", args...)
+	cmd.Dir = reflectWorkDir()
+	cmd.Env = goCommandEnv()
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("go list -export: %v\n%s", err, strings.TrimSpace(stderr.String()))
+	}
+	var p struct {
+		Export string
+		Error  *struct{ Err string }
+	}
+	if err := json.Unmarshal(out, &p); err != nil {
+		return "", fmt.Errorf("decoding go list -export output: %v", err)
+	}
+	if p.Error != nil {
+		return "", errors.New(p.Error.Err)
+	}
+	if p.Export == "" {
+		return "", fmt.Errorf("go list -export: no export data for %s", importPath)
+	}
+	return p.Export, nil
+}
+
+// methodFromTypes is model.InterfaceFromInterfaceType for a method of an
+// interface, which may be unexported if unexported is true.
+func methodFromTypes(f *types.Func, unexported bool) (*model.Method, error) {
+	if !f.Exported() && !unexported {
+		return nil, fmt.Errorf("unexported method %s", f.Name())
+	}
+	m := &model.Method{Name: f.Name()}
+	var err error
+	m.In, m.Variadic, m.Out, err = funcArgsFromTypes(f.Type().(*types.Signature))
+	return m, err
+}
+
+// funcArgsFromTypes returns the parameters of sig without their names, like
+// the reflection program does.
+func funcArgsFromTypes(sig *types.Signature) (in []*model.Parameter, variadic *model.Parameter, out []*model.Parameter, err error) {
+	params := sig.Params()
+	for i := 0; i < params.Len(); i++ {
+		t := params.At(i).Type()
+		if sig.Variadic() && i == params.Len()-1 {
+			t = t.(*types.Slice).Elem()
+		}
+		mt, err := typeFromTypes(t, false)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if sig.Variadic() && i == params.Len()-1 {
+			variadic = &model.Parameter{Type: mt}
+		} else {
+			in = append(in, &model.Parameter{Type: mt})
+		}
+	}
+	results := sig.Results()
+	for i := 0; i < results.Len(); i++ {
+		mt, err := typeFromTypes(results.At(i).Type(), false)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		out = append(out, &model.Parameter{Type: mt})
+	}
+	return in, variadic, out, nil
+}
+
+// typeFromTypes turns t into the model.Type the reflection program turns the
+// reflect.Type of t into. Reflection can't tell byte from uint8 or rune from
+// int32, and names byte and rune uint8 and int32 in type arguments, which
+// typeArg reports t is.
+func typeFromTypes(t types.Type, typeArg bool) (model.Type, error) {
+	t = unalias(t)
+	switch t := t.(type) {
+	case *types.Basic:
+		switch {
+		case t.Kind() == types.Uint8 && !typeArg:
+			return model.PredeclaredType("byte"), nil
+		case t.Kind() == types.Uint8:
+			return model.PredeclaredType("uint8"), nil
+		case t.Kind() == types.Int32:
+			return model.PredeclaredType("int32"), nil
+		case t.Kind() == types.UnsafePointer:
+			return &model.NamedType{Package: "unsafe", Type: "Pointer"}, nil
+		case t.Info()&types.IsUntyped != 0:
+			return nil, fmt.Errorf("can't yet turn %v into a model.Type", t)
+		}
+		return model.PredeclaredType(t.Name()), nil
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() == nil {
+			// error, the only predeclared named type of a parameter.
+			return model.PredeclaredType(obj.Name()), nil
+		}
+		nt := &model.NamedType{Package: vendorlessPath(obj.Pkg().Path()), Type: obj.Name()}
+		if args := t.TypeArgs(); args.Len() > 0 {
+			nt.TypeParams = &model.TypeParametersType{}
+			for i := 0; i < args.Len(); i++ {
+				arg, err := typeFromTypes(args.At(i), true)
+				if err != nil {
+					return nil, err
+				}
+				nt.TypeParams.TypeParameters = append(nt.TypeParams.TypeParameters, arg)
+			}
+		}
+		return nt, nil
+	case *types.Pointer:
+		elem, err := typeFromTypes(t.Elem(), typeArg)
+		if err != nil {
+			return nil, err
+		}
+		return &model.PointerType{Type: elem}, nil
+	case *types.Slice:
+		elem, err := typeFromTypes(t.Elem(), typeArg)
+		if err != nil {
+			return nil, err
+		}
+		return &model.ArrayType{Len: -1, Type: elem}, nil
+	case *types.Array:
+		elem, err := typeFromTypes(t.Elem(), typeArg)
+		if err != nil {
+			return nil, err
+		}
+		return &model.ArrayType{Len: int(t.Len()), Type: elem}, nil
+	case *types.Map:
+		key, err := typeFromTypes(t.Key(), typeArg)
+		if err != nil {
+			return nil, err
+		}
+		value, err := typeFromTypes(t.Elem(), typeArg)
+		if err != nil {
+			return nil, err
+		}
+		return &model.MapType{Key: key, Value: value}, nil
+	case *types.Chan:
+		elem, err := typeFromTypes(t.Elem(), typeArg)
+		if err != nil {
+			return nil, err
+		}
+		var dir model.ChanDir
+		switch t.Dir() {
+		case types.RecvOnly:
+			dir = model.RecvDir
+		case types.SendOnly:
+			dir = model.SendDir
+		}
+		return &model.ChanType{Dir: dir, Type: elem}, nil
+	case *types.Signature:
+		if typeArg {
+			return nil, fmt.Errorf("unsupported type argument %v", t)
+		}
+		in, variadic, out, err := funcArgsFromTypes(t)
+		if err != nil {
+			return nil, err
+		}
+		return &model.FuncType{In: in, Out: out, Variadic: variadic}, nil
+	case *types.Interface:
+		// Like reflection, embedded interfaces are flattened into their
+		// methods.
+		if t.NumMethods() == 0 && t.IsMethodSet() {
+			return model.PredeclaredType("any"), nil
+		}
+		if typeArg || !t.IsMethodSet() {
+			return nil, fmt.Errorf("can't yet turn %v into a model.Type", t)
+		}
+		it := &model.InterfaceType{}
+		for i := 0; i < t.NumMethods(); i++ {
+			m, err := methodFromTypes(t.Method(i), false)
+			if err != nil {
+				return nil, fmt.Errorf("can't yet turn %v into a model.Type: %v", t, err)
+			}
+			it.Methods = append(it.Methods, m)
+		}
+		return it, nil
+	case *types.Struct:
+		// The reflection program can't read unnamed structs in type
+		// arguments from the names reflect gives instantiated types.
+		if typeArg {
+			break
+		}
+		if t.NumFields() == 0 {
+			return model.PredeclaredType("struct{}"), nil
+		}
+		st := &model.StructType{}
+		for i := 0; i < t.NumFields(); i++ {
+			f := t.Field(i)
+			ft, err := typeFromTypes(f.Type(), false)
+			if err != nil {
+				return nil, err
+			}
+			name := f.Name()
+			if f.Embedded() {
+				name = ""
+			}
+			st.Fields = append(st.Fields, &model.Field{Name: name, Type: ft, Tag: t.Tag(i)})
+		}
+		return st, nil
+	}
+	return nil, fmt.Errorf("can't yet turn %v into a model.Type", t)
+}
+
+// vendorlessPath is the importable path of a package at p, which may be in a
+// vendor directory.
+func vendorlessPath(p string) string {
+	if strings.HasPrefix(p, "vendor/") {
+		p = "/" + p
+	}
+	if i := strings.LastIndex(p, "/vendor/"); i != -1 {
+		p = p[i+len("/vendor/"):]
+	}
+	return p
+}
@@ -0,0 +1,9 @@
+//go:build !go1.22
+
+package main
+
+import "go/types"
+
+// unalias returns t, since go/types does not represent aliases before Go
+// 1.22.
+func unalias(t types.Type) types.Type { return t }
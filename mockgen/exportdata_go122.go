@@ -0,0 +1,8 @@
+//go:build go1.22
+
+package main
+
+import "go/types"
+
+// unalias returns the type the alias t denotes, or t if it is not an alias.
+func unalias(t types.Type) types.Type { return types.Unalias(t) }
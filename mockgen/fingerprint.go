@@ -0,0 +1,117 @@
+package main
+
+// This file contains the -if_changed check for stale mocks.
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"go/build"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+)
+
+// fingerprintPrefix starts the header line of a mock generated with
+// -if_changed that records the fingerprint of its inputs.
+const fingerprintPrefix = "// Fingerprint: "
+
+// inputFingerprint hashes everything the mock described by the current flags
+// and args depends on: the mockgen version, the flags, the Go files of the
+// source package or of the reflected package, the aux files and the copyright
+// file. Mocks generated with -if_changed are left out, so that mocks next to
+// their source don't make each other stale. Changes to other packages, such as
+// those of embedded interfaces, are not detected.
+func inputFingerprint(args []string) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%q\n", mockgenVersion(), args)
+	flag.VisitAll(func(f *flag.Flag) {
+		fmt.Fprintf(h, "-%s=%s\n", f.Name, f.Value)
+	})
+
+	var dir string
+	if *source != "" {
+		dir = filepath.Dir(*source)
+	} else if *sourceDir != "" {
+		dir = *sourceDir
+	} else if len(args) > 0 {
+		p, err := build.Import(args[0], reflectWorkDir(), build.FindOnly)
+		if err != nil {
+			return "", err
+		}
+		dir = p.Dir
+	}
+	if dir != "" {
+		if err := hashGoFiles(h, dir, hasFingerprint); err != nil {
+			return "", err
+		}
+	}
+	if *auxFiles != "" {
+		for _, kv := range strings.Split(*auxFiles, ",") {
+			if _, fpath, ok := strings.Cut(kv, "="); ok {
+				if err := hashFile(h, fpath); err != nil {
+					return "", err
+				}
+			}
+		}
+	}
+	if *copyrightFile != "" {
+		if err := hashFile(h, *copyrightFile); err != nil {
+			return "", err
+		}
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// mockgenVersion identifies the running mockgen binary, so that upgrading
+// mockgen regenerates the mocks.
+func mockgenVersion() string {
+	if version != "" {
+		return version + " " + commit
+	}
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	v := bi.Main.Version
+	for _, s := range bi.Settings {
+		if strings.HasPrefix(s.Key, "vcs.") {
+			v += " " + s.Key + "=" + s.Value
+		}
+	}
+	return v
+}
+
+func hasFingerprint(path string) bool {
+	fp, _ := readFingerprint(path)
+	return fp != ""
+}
+
+// readFingerprint returns the fingerprint recorded in the header of the mock
+// at path, or "" if the file does not exist or has no fingerprint.
+func readFingerprint(path string) (string, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, fingerprintPrefix) {
+			return strings.TrimPrefix(line, fingerprintPrefix), nil
+		}
+		if strings.HasPrefix(line, "package ") {
+			break
+		}
+	}
+	return "", scanner.Err()
+}
@@ -0,0 +1,31 @@
+// Package anonymous_struct has interfaces with unnamed struct types in their
+// signatures.
+package anonymous_struct
+
+//go:generate mockgen -package anonymous_struct -destination source_mock.go -source input.go -mock_names Geometry=MockSourceGeometry
+//go:generate mockgen -package anonymous_struct -destination reflect_mock.go . Geometry
+//go:generate mockgen -destination mock_anonymous_struct/mock.go . Geometry
+
+import "io"
+
+type Point struct{ X, Y int }
+
+type Geometry interface {
+	Center() struct{ X, Y int }
+	Bounds(scale float64) struct {
+		Min, Max Point
+		Label    string `json:"label"`
+	}
+	Nested() struct {
+		Inner struct{ Depth int }
+		Items []struct{ Name string }
+	}
+	Embedded(struct {
+		Point
+		io.Reader
+	}) (struct {
+		*Point
+		N int
+	}, error)
+	Lookup(m map[string]struct{ V int }, keys ...struct{ K string }) struct{}
+}
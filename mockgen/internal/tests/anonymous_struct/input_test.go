@@ -0,0 +1,49 @@
+package anonymous_struct
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestAnonymousStruct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := NewMockGeometry(ctrl)
+	m.EXPECT().Center().Return(struct{ X, Y int }{1, 2})
+	m.EXPECT().Bounds(2.0).Return(struct {
+		Min, Max Point
+		Label    string `json:"label"`
+	}{Max: Point{2, 2}, Label: "box"})
+	m.EXPECT().Embedded(gomock.Any()).Return(struct {
+		*Point
+		N int
+	}{&Point{3, 4}, 5}, nil)
+	m.EXPECT().Lookup(map[string]struct{ V int }{"a": {1}}, struct{ K string }{"a"}).Return(struct{}{})
+
+	if c := m.Center(); c.X != 1 || c.Y != 2 {
+		t.Errorf("Center = %v, want {1 2}", c)
+	}
+	if b := m.Bounds(2.0); b.Max != (Point{2, 2}) || b.Label != "box" {
+		t.Errorf("Bounds = %v, want {{0 0} {2 2} box}", b)
+	}
+	e, err := m.Embedded(struct {
+		Point
+		io.Reader
+	}{Reader: strings.NewReader("")})
+	if err != nil || e.X != 3 || e.N != 5 {
+		t.Errorf("Embedded = %v, %v, want {3 4} 5", e, err)
+	}
+	m.Lookup(map[string]struct{ V int }{"a": {1}}, struct{ K string }{"a"})
+
+	source := NewMockSourceGeometry(ctrl)
+	source.EXPECT().Nested().Return(struct {
+		Inner struct{ Depth int }
+		Items []struct{ Name string }
+	}{Inner: struct{ Depth int }{1}})
+	var g Geometry = source
+	if n := g.Nested(); n.Inner.Depth != 1 {
+		t.Errorf("Nested = %v, want depth 1", n)
+	}
+}
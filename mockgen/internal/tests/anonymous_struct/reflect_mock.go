@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/anonymous_struct (interfaces: Geometry)
+//
+// Generated by this command:
+//
+//	mockgen -package anonymous_struct -destination reflect_mock.go . Geometry
+//
+
+// Package anonymous_struct is a generated GoMock package.
+package anonymous_struct
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGeometry is a mock of Geometry interface.
+type MockGeometry struct {
+	ctrl     *gomock.Controller
+	recorder *MockGeometryMockRecorder
+}
+
+// MockGeometryMockRecorder is the mock recorder for MockGeometry.
+type MockGeometryMockRecorder struct {
+	mock *MockGeometry
+}
+
+// NewMockGeometry creates a new mock instance.
+func NewMockGeometry(ctrl *gomock.Controller) *MockGeometry {
+	mock := &MockGeometry{ctrl: ctrl}
+	mock.recorder = &MockGeometryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGeometry) EXPECT() *MockGeometryMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockGeometry; create it with NewMockGeometry")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockGeometry) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Bounds mocks base method.
+func (m *MockGeometry) Bounds(arg0 float64) struct {
+	Min   Point
+	Max   Point
+	Label string "json:\"label\""
+} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockGeometry; create it with NewMockGeometry")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bounds", arg0)
+	ret0, _ := ret[0].(struct {
+		Min   Point
+		Max   Point
+		Label string "json:\"label\""
+	})
+	return ret0
+}
+
+// Bounds indicates an expected call of Bounds.
+func (mr *MockGeometryMockRecorder) Bounds(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bounds", reflect.TypeOf((*MockGeometry)(nil).Bounds), arg0)
+}
+
+// Center mocks base method.
+func (m *MockGeometry) Center() struct {
+	X int
+	Y int
+} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockGeometry; create it with NewMockGeometry")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Center")
+	ret0, _ := ret[0].(struct {
+		X int
+		Y int
+	})
+	return ret0
+}
+
+// Center indicates an expected call of Center.
+func (mr *MockGeometryMockRecorder) Center() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Center", reflect.TypeOf((*MockGeometry)(nil).Center))
+}
+
+// Embedded mocks base method.
+func (m *MockGeometry) Embedded(arg0 struct {
+	Point
+	io.Reader
+}) (struct {
+	*Point
+	N int
+}, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockGeometry; create it with NewMockGeometry")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Embedded", arg0)
+	ret0, _ := ret[0].(struct {
+		*Point
+		N int
+	})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Embedded indicates an expected call of Embedded.
+func (mr *MockGeometryMockRecorder) Embedded(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Embedded", reflect.TypeOf((*MockGeometry)(nil).Embedded), arg0)
+}
+
+// Lookup mocks base method.
+func (m *MockGeometry) Lookup(arg0 map[string]struct{ V int }, arg1 ...struct{ K string }) struct{} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockGeometry; create it with NewMockGeometry")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Lookup", varargs...)
+	ret0, _ := ret[0].(struct{})
+	return ret0
+}
+
+// Lookup indicates an expected call of Lookup.
+func (mr *MockGeometryMockRecorder) Lookup(arg0 any, arg1 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lookup", reflect.TypeOf((*MockGeometry)(nil).Lookup), varargs...)
+}
+
+// Nested mocks base method.
+func (m *MockGeometry) Nested() struct {
+	Inner struct{ Depth int }
+	Items []struct{ Name string }
+} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockGeometry; create it with NewMockGeometry")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nested")
+	ret0, _ := ret[0].(struct {
+		Inner struct{ Depth int }
+		Items []struct{ Name string }
+	})
+	return ret0
+}
+
+// Nested indicates an expected call of Nested.
+func (mr *MockGeometryMockRecorder) Nested() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nested", reflect.TypeOf((*MockGeometry)(nil).Nested))
+}
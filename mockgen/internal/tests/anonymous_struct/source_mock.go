@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package anonymous_struct -destination source_mock.go -source input.go -mock_names Geometry=MockSourceGeometry
+//
+
+// Package anonymous_struct is a generated GoMock package.
+package anonymous_struct
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSourceGeometry is a mock of Geometry interface.
+type MockSourceGeometry struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceGeometryMockRecorder
+}
+
+// MockSourceGeometryMockRecorder is the mock recorder for MockSourceGeometry.
+type MockSourceGeometryMockRecorder struct {
+	mock *MockSourceGeometry
+}
+
+// NewMockSourceGeometry creates a new mock instance.
+func NewMockSourceGeometry(ctrl *gomock.Controller) *MockSourceGeometry {
+	mock := &MockSourceGeometry{ctrl: ctrl}
+	mock.recorder = &MockSourceGeometryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceGeometry) EXPECT() *MockSourceGeometryMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceGeometry; create it with NewMockSourceGeometry")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceGeometry) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Bounds mocks base method.
+func (m *MockSourceGeometry) Bounds(scale float64) struct {
+	Min   Point
+	Max   Point
+	Label string "json:\"label\""
+} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceGeometry; create it with NewMockSourceGeometry")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bounds", scale)
+	ret0, _ := ret[0].(struct {
+		Min   Point
+		Max   Point
+		Label string "json:\"label\""
+	})
+	return ret0
+}
+
+// Bounds indicates an expected call of Bounds.
+func (mr *MockSourceGeometryMockRecorder) Bounds(scale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bounds", reflect.TypeOf((*MockSourceGeometry)(nil).Bounds), scale)
+}
+
+// Center mocks base method.
+func (m *MockSourceGeometry) Center() struct {
+	X int
+	Y int
+} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceGeometry; create it with NewMockSourceGeometry")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Center")
+	ret0, _ := ret[0].(struct {
+		X int
+		Y int
+	})
+	return ret0
+}
+
+// Center indicates an expected call of Center.
+func (mr *MockSourceGeometryMockRecorder) Center() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Center", reflect.TypeOf((*MockSourceGeometry)(nil).Center))
+}
+
+// Embedded mocks base method.
+func (m *MockSourceGeometry) Embedded(arg0 struct {
+	Point
+	io.Reader
+}) (struct {
+	*Point
+	N int
+}, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceGeometry; create it with NewMockSourceGeometry")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Embedded", arg0)
+	ret0, _ := ret[0].(struct {
+		*Point
+		N int
+	})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Embedded indicates an expected call of Embedded.
+func (mr *MockSourceGeometryMockRecorder) Embedded(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Embedded", reflect.TypeOf((*MockSourceGeometry)(nil).Embedded), arg0)
+}
+
+// Lookup mocks base method.
+func (m_2 *MockSourceGeometry) Lookup(m map[string]struct{ V int }, keys ...struct{ K string }) struct{} {
+	if m_2 == nil || m_2.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceGeometry; create it with NewMockSourceGeometry")
+	}
+	m_2.ctrl.T.Helper()
+	varargs := []any{m}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m_2.ctrl.Call(m_2, "Lookup", varargs...)
+	ret0, _ := ret[0].(struct{})
+	return ret0
+}
+
+// Lookup indicates an expected call of Lookup.
+func (mr *MockSourceGeometryMockRecorder) Lookup(m any, keys ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{m}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lookup", reflect.TypeOf((*MockSourceGeometry)(nil).Lookup), varargs...)
+}
+
+// Nested mocks base method.
+func (m *MockSourceGeometry) Nested() struct {
+	Inner struct{ Depth int }
+	Items []struct{ Name string }
+} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceGeometry; create it with NewMockSourceGeometry")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nested")
+	ret0, _ := ret[0].(struct {
+		Inner struct{ Depth int }
+		Items []struct{ Name string }
+	})
+	return ret0
+}
+
+// Nested indicates an expected call of Nested.
+func (mr *MockSourceGeometryMockRecorder) Nested() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nested", reflect.TypeOf((*MockSourceGeometry)(nil).Nested))
+}
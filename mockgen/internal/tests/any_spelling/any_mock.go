@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package any_spelling -destination any_mock.go -source input.go -use_any -mock_names Store=MockAnyStore
+//
+
+// Package any_spelling is a generated GoMock package.
+package any_spelling
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAnyStore is a mock of Store interface.
+type MockAnyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnyStoreMockRecorder
+}
+
+// MockAnyStoreMockRecorder is the mock recorder for MockAnyStore.
+type MockAnyStoreMockRecorder struct {
+	mock *MockAnyStore
+}
+
+// NewMockAnyStore creates a new mock instance.
+func NewMockAnyStore(ctrl *gomock.Controller) *MockAnyStore {
+	mock := &MockAnyStore{ctrl: ctrl}
+	mock.recorder = &MockAnyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnyStore) EXPECT() *MockAnyStoreMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockAnyStore; create it with NewMockAnyStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockAnyStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Each mocks base method.
+func (m *MockAnyStore) Each(arg0 func(string, any) bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockAnyStore; create it with NewMockAnyStore")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Each", arg0)
+}
+
+// Each indicates an expected call of Each.
+func (mr *MockAnyStoreMockRecorder) Each(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Each", reflect.TypeOf((*MockAnyStore)(nil).Each), arg0)
+}
+
+// Get mocks base method.
+func (m *MockAnyStore) Get(key string) any {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockAnyStore; create it with NewMockAnyStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].(any)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAnyStoreMockRecorder) Get(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAnyStore)(nil).Get), key)
+}
+
+// Put mocks base method.
+func (m *MockAnyStore) Put(key string, value any) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockAnyStore; create it with NewMockAnyStore")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Put", key, value)
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockAnyStoreMockRecorder) Put(key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockAnyStore)(nil).Put), key, value)
+}
+
+// Query mocks base method.
+func (m *MockAnyStore) Query(args ...any) (map[string]any, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockAnyStore; create it with NewMockAnyStore")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Query", varargs...)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockAnyStoreMockRecorder) Query(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockAnyStore)(nil).Query), args...)
+}
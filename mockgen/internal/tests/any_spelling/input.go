@@ -0,0 +1,16 @@
+// Package any_spelling has an interface spelling the empty interface both as
+// any and as interface{}, whose mocks keep or replace the spellings with
+// -use_any.
+package any_spelling
+
+//go:generate mockgen -package any_spelling -destination source_mock.go -source input.go -mock_names Store=MockSourceStore
+//go:generate mockgen -package any_spelling -destination any_mock.go -source input.go -use_any -mock_names Store=MockAnyStore
+//go:generate mockgen -package any_spelling -destination interface_mock.go -source input.go -use_any=false -mock_names Store=MockInterfaceStore
+//go:generate mockgen -package any_spelling -destination reflect_mock.go . Store
+
+type Store interface {
+	Get(key string) interface{}
+	Put(key string, value any)
+	Each(func(key string, value interface{}) bool)
+	Query(args ...interface{}) (map[string]any, error)
+}
@@ -0,0 +1,31 @@
+package any_spelling
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	_ Store = (*MockStore)(nil)
+	_ Store = (*MockSourceStore)(nil)
+	_ Store = (*MockAnyStore)(nil)
+	_ Store = (*MockInterfaceStore)(nil)
+)
+
+func TestAnySpelling(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	source := NewMockSourceStore(ctrl)
+	source.EXPECT().Get("a").Return(1)
+	anyStore := NewMockAnyStore(ctrl)
+	anyStore.EXPECT().Get("a").Return(1)
+	interfaceStore := NewMockInterfaceStore(ctrl)
+	interfaceStore.EXPECT().Get("a").Return(1)
+
+	for _, store := range []Store{source, anyStore, interfaceStore} {
+		if got := store.Get("a"); got != 1 {
+			t.Errorf("%T.Get(a) = %v, want 1", store, got)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package any_spelling -destination interface_mock.go -source input.go -use_any=false -mock_names Store=MockInterfaceStore
+//
+
+// Package any_spelling is a generated GoMock package.
+package any_spelling
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInterfaceStore is a mock of Store interface.
+type MockInterfaceStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterfaceStoreMockRecorder
+}
+
+// MockInterfaceStoreMockRecorder is the mock recorder for MockInterfaceStore.
+type MockInterfaceStoreMockRecorder struct {
+	mock *MockInterfaceStore
+}
+
+// NewMockInterfaceStore creates a new mock instance.
+func NewMockInterfaceStore(ctrl *gomock.Controller) *MockInterfaceStore {
+	mock := &MockInterfaceStore{ctrl: ctrl}
+	mock.recorder = &MockInterfaceStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInterfaceStore) EXPECT() *MockInterfaceStoreMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockInterfaceStore; create it with NewMockInterfaceStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockInterfaceStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Each mocks base method.
+func (m *MockInterfaceStore) Each(arg0 func(string, interface{}) bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockInterfaceStore; create it with NewMockInterfaceStore")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Each", arg0)
+}
+
+// Each indicates an expected call of Each.
+func (mr *MockInterfaceStoreMockRecorder) Each(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Each", reflect.TypeOf((*MockInterfaceStore)(nil).Each), arg0)
+}
+
+// Get mocks base method.
+func (m *MockInterfaceStore) Get(key string) interface{} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockInterfaceStore; create it with NewMockInterfaceStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].(interface{})
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInterfaceStoreMockRecorder) Get(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInterfaceStore)(nil).Get), key)
+}
+
+// Put mocks base method.
+func (m *MockInterfaceStore) Put(key string, value interface{}) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockInterfaceStore; create it with NewMockInterfaceStore")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Put", key, value)
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockInterfaceStoreMockRecorder) Put(key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockInterfaceStore)(nil).Put), key, value)
+}
+
+// Query mocks base method.
+func (m *MockInterfaceStore) Query(args ...interface{}) (map[string]interface{}, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockInterfaceStore; create it with NewMockInterfaceStore")
+	}
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Query", varargs...)
+	ret0, _ := ret[0].(map[string]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockInterfaceStoreMockRecorder) Query(args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockInterfaceStore)(nil).Query), args...)
+}
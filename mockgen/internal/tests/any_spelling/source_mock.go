@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package any_spelling -destination source_mock.go -source input.go -mock_names Store=MockSourceStore
+//
+
+// Package any_spelling is a generated GoMock package.
+package any_spelling
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSourceStore is a mock of Store interface.
+type MockSourceStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceStoreMockRecorder
+}
+
+// MockSourceStoreMockRecorder is the mock recorder for MockSourceStore.
+type MockSourceStoreMockRecorder struct {
+	mock *MockSourceStore
+}
+
+// NewMockSourceStore creates a new mock instance.
+func NewMockSourceStore(ctrl *gomock.Controller) *MockSourceStore {
+	mock := &MockSourceStore{ctrl: ctrl}
+	mock.recorder = &MockSourceStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceStore) EXPECT() *MockSourceStoreMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceStore; create it with NewMockSourceStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Each mocks base method.
+func (m *MockSourceStore) Each(arg0 func(string, interface{}) bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceStore; create it with NewMockSourceStore")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Each", arg0)
+}
+
+// Each indicates an expected call of Each.
+func (mr *MockSourceStoreMockRecorder) Each(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Each", reflect.TypeOf((*MockSourceStore)(nil).Each), arg0)
+}
+
+// Get mocks base method.
+func (m *MockSourceStore) Get(key string) interface{} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceStore; create it with NewMockSourceStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].(interface{})
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSourceStoreMockRecorder) Get(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSourceStore)(nil).Get), key)
+}
+
+// Put mocks base method.
+func (m *MockSourceStore) Put(key string, value any) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceStore; create it with NewMockSourceStore")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Put", key, value)
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockSourceStoreMockRecorder) Put(key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockSourceStore)(nil).Put), key, value)
+}
+
+// Query mocks base method.
+func (m *MockSourceStore) Query(args ...interface{}) (map[string]any, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceStore; create it with NewMockSourceStore")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Query", varargs...)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockSourceStoreMockRecorder) Query(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockSourceStore)(nil).Query), args...)
+}
@@ -0,0 +1,43 @@
+package array_params
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	_ Hasher = (*MockHasher)(nil)
+	_ Hasher = (*MockReflectHasher)(nil)
+)
+
+func TestArrayParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	m := NewMockHasher(ctrl)
+	key := [32]byte{1, 2, 3}
+	m.EXPECT().Sum(key).Return([32]byte{4, 5, 6})
+	if got := m.Sum(key); got != [32]byte{4, 5, 6} {
+		t.Errorf("Sum() = %v, want [4 5 6 ...]", got)
+	}
+
+	var identity [4][4]float64
+	for i := range identity {
+		identity[i][i] = 1
+	}
+	m.EXPECT().Matrix(identity).Return(identity)
+	if got := m.Matrix(identity); got != identity {
+		t.Errorf("Matrix() = %v, want %v", got, identity)
+	}
+
+	r := NewMockReflectHasher(ctrl)
+	digests := [N]Digest{7, 8, 9}
+	r.EXPECT().Digests(digests).Return(digests, nil)
+	if got, err := r.Digests(digests); err != nil || got != digests {
+		t.Errorf("Digests() = %v, %v, want %v, nil", got, err, digests)
+	}
+	r.EXPECT().Doubled().Return([M]Digest{1})
+	if got := r.Doubled(); len(got) != M {
+		t.Errorf("len(Doubled()) = %d, want %d", len(got), M)
+	}
+}
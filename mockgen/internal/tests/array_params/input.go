@@ -0,0 +1,20 @@
+package array_params
+
+//go:generate mockgen -package array_params -destination source_mock.go -source input.go
+//go:generate mockgen -package array_params -destination reflect_mock.go -mock_names Hasher=MockReflectHasher . Hasher
+
+// N is the size of the named-type array used by Hasher.
+const N = 3
+
+// M is derived from N to exercise constant expressions in array lengths.
+const M = N * 2
+
+// Digest is a named type used as a fixed-size array element.
+type Digest byte
+
+type Hasher interface {
+	Sum(key [32]byte) [32]byte
+	Matrix(m [4][4]float64) [4][4]float64
+	Digests(d [N]Digest) ([N]Digest, error)
+	Doubled() [M]Digest
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/array_params (interfaces: Hasher)
+//
+// Generated by this command:
+//
+//	mockgen -package array_params -destination reflect_mock.go -mock_names Hasher=MockReflectHasher . Hasher
+//
+
+// Package array_params is a generated GoMock package.
+package array_params
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReflectHasher is a mock of Hasher interface.
+type MockReflectHasher struct {
+	ctrl     *gomock.Controller
+	recorder *MockReflectHasherMockRecorder
+}
+
+// MockReflectHasherMockRecorder is the mock recorder for MockReflectHasher.
+type MockReflectHasherMockRecorder struct {
+	mock *MockReflectHasher
+}
+
+// NewMockReflectHasher creates a new mock instance.
+func NewMockReflectHasher(ctrl *gomock.Controller) *MockReflectHasher {
+	mock := &MockReflectHasher{ctrl: ctrl}
+	mock.recorder = &MockReflectHasherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReflectHasher) EXPECT() *MockReflectHasherMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectHasher; create it with NewMockReflectHasher")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockReflectHasher) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Digests mocks base method.
+func (m *MockReflectHasher) Digests(arg0 [3]Digest) ([3]Digest, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectHasher; create it with NewMockReflectHasher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Digests", arg0)
+	ret0, _ := ret[0].([3]Digest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Digests indicates an expected call of Digests.
+func (mr *MockReflectHasherMockRecorder) Digests(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Digests", reflect.TypeOf((*MockReflectHasher)(nil).Digests), arg0)
+}
+
+// Doubled mocks base method.
+func (m *MockReflectHasher) Doubled() [6]Digest {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectHasher; create it with NewMockReflectHasher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Doubled")
+	ret0, _ := ret[0].([6]Digest)
+	return ret0
+}
+
+// Doubled indicates an expected call of Doubled.
+func (mr *MockReflectHasherMockRecorder) Doubled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Doubled", reflect.TypeOf((*MockReflectHasher)(nil).Doubled))
+}
+
+// Matrix mocks base method.
+func (m *MockReflectHasher) Matrix(arg0 [4][4]float64) [4][4]float64 {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectHasher; create it with NewMockReflectHasher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Matrix", arg0)
+	ret0, _ := ret[0].([4][4]float64)
+	return ret0
+}
+
+// Matrix indicates an expected call of Matrix.
+func (mr *MockReflectHasherMockRecorder) Matrix(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Matrix", reflect.TypeOf((*MockReflectHasher)(nil).Matrix), arg0)
+}
+
+// Sum mocks base method.
+func (m *MockReflectHasher) Sum(arg0 [32]byte) [32]byte {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectHasher; create it with NewMockReflectHasher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sum", arg0)
+	ret0, _ := ret[0].([32]byte)
+	return ret0
+}
+
+// Sum indicates an expected call of Sum.
+func (mr *MockReflectHasherMockRecorder) Sum(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sum", reflect.TypeOf((*MockReflectHasher)(nil).Sum), arg0)
+}
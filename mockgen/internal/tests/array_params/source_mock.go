@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package array_params -destination source_mock.go -source input.go
+//
+
+// Package array_params is a generated GoMock package.
+package array_params
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHasher is a mock of Hasher interface.
+type MockHasher struct {
+	ctrl     *gomock.Controller
+	recorder *MockHasherMockRecorder
+}
+
+// MockHasherMockRecorder is the mock recorder for MockHasher.
+type MockHasherMockRecorder struct {
+	mock *MockHasher
+}
+
+// NewMockHasher creates a new mock instance.
+func NewMockHasher(ctrl *gomock.Controller) *MockHasher {
+	mock := &MockHasher{ctrl: ctrl}
+	mock.recorder = &MockHasherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHasher) EXPECT() *MockHasherMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHasher; create it with NewMockHasher")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockHasher) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Digests mocks base method.
+func (m *MockHasher) Digests(d [N]Digest) ([N]Digest, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHasher; create it with NewMockHasher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Digests", d)
+	ret0, _ := ret[0].([N]Digest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Digests indicates an expected call of Digests.
+func (mr *MockHasherMockRecorder) Digests(d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Digests", reflect.TypeOf((*MockHasher)(nil).Digests), d)
+}
+
+// Doubled mocks base method.
+func (m *MockHasher) Doubled() [M]Digest {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHasher; create it with NewMockHasher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Doubled")
+	ret0, _ := ret[0].([M]Digest)
+	return ret0
+}
+
+// Doubled indicates an expected call of Doubled.
+func (mr *MockHasherMockRecorder) Doubled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Doubled", reflect.TypeOf((*MockHasher)(nil).Doubled))
+}
+
+// Matrix mocks base method.
+func (m_2 *MockHasher) Matrix(m [4][4]float64) [4][4]float64 {
+	if m_2 == nil || m_2.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHasher; create it with NewMockHasher")
+	}
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Matrix", m)
+	ret0, _ := ret[0].([4][4]float64)
+	return ret0
+}
+
+// Matrix indicates an expected call of Matrix.
+func (mr *MockHasherMockRecorder) Matrix(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Matrix", reflect.TypeOf((*MockHasher)(nil).Matrix), m)
+}
+
+// Sum mocks base method.
+func (m *MockHasher) Sum(key [32]byte) [32]byte {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHasher; create it with NewMockHasher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sum", key)
+	ret0, _ := ret[0].([32]byte)
+	return ret0
+}
+
+// Sum indicates an expected call of Sum.
+func (mr *MockHasherMockRecorder) Sum(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sum", reflect.TypeOf((*MockHasher)(nil).Sum), key)
+}
@@ -0,0 +1,27 @@
+package aux_generics
+
+//go:generate mockgen -aux_files repo=repo/repo.go,user=user/user.go -destination mock.go -package aux_generics -source input.go
+
+import (
+	"go.uber.org/mock/mockgen/internal/tests/aux_generics/repo"
+	"go.uber.org/mock/mockgen/internal/tests/aux_generics/user"
+)
+
+// Users embeds a generic interface of an aux file instantiated with a type of
+// another aux file.
+type Users interface {
+	repo.Repo[user.User]
+	Count() int
+}
+
+// Accounts embeds an interface of an aux file that itself embeds such an
+// instantiation.
+type Accounts interface {
+	repo.UserRepo
+}
+
+// Index instantiates a generic interface of an aux file with its own type
+// parameter and a type of another aux file.
+type Index[K comparable] interface {
+	repo.Pair[K, *user.User]
+}
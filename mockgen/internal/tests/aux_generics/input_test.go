@@ -0,0 +1,31 @@
+package aux_generics
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/aux_generics/repo"
+	"go.uber.org/mock/mockgen/internal/tests/aux_generics/user"
+)
+
+var (
+	_ Users         = (*MockUsers)(nil)
+	_ Accounts      = (*MockAccounts)(nil)
+	_ Index[string] = (*MockIndex[string])(nil)
+)
+
+func TestCrossAuxInstantiation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	users := NewMockUsers(ctrl)
+	users.EXPECT().Get(repo.ID("a")).Return(user.User{Name: "Ann"}, nil)
+	if u, _ := users.Get("a"); u.Name != "Ann" {
+		t.Errorf("Get() = %v, want Ann", u)
+	}
+
+	index := NewMockIndex[string](ctrl)
+	index.EXPECT().Lookup("a").Return(&user.User{Name: "Ann"}, true)
+	if u, ok := index.Lookup("a"); !ok || u.Name != "Ann" {
+		t.Errorf("Lookup() = %v, %v, want Ann", u, ok)
+	}
+}
@@ -0,0 +1,22 @@
+package repo
+
+import "go.uber.org/mock/mockgen/internal/tests/aux_generics/user"
+
+// ID identifies a stored value.
+type ID string
+
+// Repo stores values of type T.
+type Repo[T any] interface {
+	Get(id ID) (T, error)
+	List() []T
+}
+
+// UserRepo is a Repo of users.
+type UserRepo interface {
+	Repo[user.User]
+}
+
+// Pair stores values of type V by keys of type K.
+type Pair[K comparable, V any] interface {
+	Lookup(K) (V, bool)
+}
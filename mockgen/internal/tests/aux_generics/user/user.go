@@ -0,0 +1,6 @@
+package user
+
+// User is stored in a repo.Repo.
+type User struct {
+	Name string
+}
@@ -0,0 +1,7 @@
+//go:build linux
+
+package build_constraint
+
+type Closer interface {
+	Close() error
+}
@@ -0,0 +1,11 @@
+//go:build unix
+
+package build_constraint
+
+//go:generate mockgen -package build_constraint -destination mock.go -source input.go
+
+// Watcher is only available on unix, and its embedded Closer only on Linux.
+type Watcher interface {
+	Watch(path string) error
+	Closer
+}
@@ -0,0 +1,25 @@
+//go:build unix && linux
+
+package build_constraint
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+var _ Watcher = (*MockWatcher)(nil)
+
+func TestMockWatcher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	m := NewMockWatcher(ctrl)
+	m.EXPECT().Watch("/tmp").Return(nil)
+	m.EXPECT().Close().Return(nil)
+	if err := m.Watch("/tmp"); err != nil {
+		t.Errorf("Watch() = %v, want nil", err)
+	}
+	if err := m.Close(); err != nil {
+		t.Errorf("Close() = %v, want nil", err)
+	}
+}
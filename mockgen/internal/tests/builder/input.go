@@ -0,0 +1,16 @@
+package builder
+
+//go:generate mockgen -builder -destination mock.go -package builder -source input.go
+//go:generate mockgen -builder -typed -destination typed/mock.go -package typed -source input.go
+
+// Store is built with expected calls registered up front.
+type Store interface {
+	Get(key string) (string, error)
+	Put(key, value string) error
+	Delete(keys ...string) int
+}
+
+// Cache is a generic interface.
+type Cache[K comparable, V any] interface {
+	Load(K) (V, bool)
+}
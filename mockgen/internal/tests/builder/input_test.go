@@ -0,0 +1,52 @@
+package builder
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/builder/typed"
+)
+
+func TestBuilder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	b := NewMockStoreBuilder(ctrl)
+	b.ExpectGet("a").Return("1", nil)
+	b.ExpectPut("a", "2").Return(nil)
+	b.ExpectDelete("a", "b").Return(2)
+	store := b.Build()
+
+	if v, _ := store.Get("a"); v != "1" {
+		t.Errorf("Get() = %q, want 1", v)
+	}
+	if err := store.Put("a", "2"); err != nil {
+		t.Errorf("Put() = %v, want nil", err)
+	}
+	if n := store.Delete("a", "b"); n != 2 {
+		t.Errorf("Delete() = %d, want 2", n)
+	}
+
+	cb := NewMockCacheBuilder[string, int](ctrl)
+	cb.ExpectLoad("a").Return(1, true)
+	if v, ok := cb.Build().Load("a"); !ok || v != 1 {
+		t.Errorf("Load() = %d, %v, want 1, true", v, ok)
+	}
+}
+
+func TestTypedBuilder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	b := typed.NewMockStoreBuilder(ctrl)
+	b.ExpectGet("a").Return("1", nil)
+	b.ExpectDelete(gomock.Any()).DoAndReturn(func(keys ...string) int {
+		return len(keys)
+	})
+	store := b.Build()
+
+	if v, _ := store.Get("a"); v != "1" {
+		t.Errorf("Get() = %q, want 1", v)
+	}
+	if n := store.Delete("a", "b", "c"); n != 3 {
+		t.Errorf("Delete() = %d, want 3", n)
+	}
+}
@@ -0,0 +1,206 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -builder -destination mock.go -package builder -source input.go
+//
+
+// Package builder is a generated GoMock package.
+package builder
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Delete mocks base method.
+func (m *MockStore) Delete(keys ...string) int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStoreMockRecorder) Delete(keys ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStore)(nil).Delete), keys...)
+}
+
+// Get mocks base method.
+func (m *MockStore) Get(key string) (string, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStoreMockRecorder) Get(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), key)
+}
+
+// Put mocks base method.
+func (m *MockStore) Put(key, value string) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockStoreMockRecorder) Put(key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), key, value)
+}
+
+// MockStoreBuilder registers expected calls of a MockStore before building it.
+type MockStoreBuilder struct {
+	mock *MockStore
+}
+
+// NewMockStoreBuilder creates a builder of a new mock instance.
+func NewMockStoreBuilder(ctrl *gomock.Controller) *MockStoreBuilder {
+	return &MockStoreBuilder{mock: NewMockStore(ctrl)}
+}
+
+// Build returns the mock with the expected calls registered by the builder.
+func (b *MockStoreBuilder) Build() *MockStore {
+	return b.mock
+}
+
+// ExpectDelete indicates an expected call of Delete.
+func (b *MockStoreBuilder) ExpectDelete(keys ...any) *gomock.Call {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Delete(keys...)
+}
+
+// ExpectGet indicates an expected call of Get.
+func (b *MockStoreBuilder) ExpectGet(key any) *gomock.Call {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Get(key)
+}
+
+// ExpectPut indicates an expected call of Put.
+func (b *MockStoreBuilder) ExpectPut(key, value any) *gomock.Call {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Put(key, value)
+}
+
+// MockCache is a mock of Cache interface.
+type MockCache[K comparable, V any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMockRecorder[K, V]
+}
+
+// MockCacheMockRecorder is the mock recorder for MockCache.
+type MockCacheMockRecorder[K comparable, V any] struct {
+	mock *MockCache[K, V]
+}
+
+// NewMockCache creates a new mock instance.
+func NewMockCache[K comparable, V any](ctrl *gomock.Controller) *MockCache[K, V] {
+	mock := &MockCache[K, V]{ctrl: ctrl}
+	mock.recorder = &MockCacheMockRecorder[K, V]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCache[K, V]) EXPECT() *MockCacheMockRecorder[K, V] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockCache[K, V]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Load mocks base method.
+func (m *MockCache[K, V]) Load(arg0 K) (V, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", arg0)
+	ret0, _ := ret[0].(V)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockCacheMockRecorder[K, V]) Load(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockCache[K, V])(nil).Load), arg0)
+}
+
+// MockCacheBuilder registers expected calls of a MockCache before building it.
+type MockCacheBuilder[K comparable, V any] struct {
+	mock *MockCache[K, V]
+}
+
+// NewMockCacheBuilder creates a builder of a new mock instance.
+func NewMockCacheBuilder[K comparable, V any](ctrl *gomock.Controller) *MockCacheBuilder[K, V] {
+	return &MockCacheBuilder[K, V]{mock: NewMockCache[K, V](ctrl)}
+}
+
+// Build returns the mock with the expected calls registered by the builder.
+func (b *MockCacheBuilder[K, V]) Build() *MockCache[K, V] {
+	return b.mock
+}
+
+// ExpectLoad indicates an expected call of Load.
+func (b *MockCacheBuilder[K, V]) ExpectLoad(arg0 any) *gomock.Call {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Load(arg0)
+}
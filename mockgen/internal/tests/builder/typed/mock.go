@@ -0,0 +1,334 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -builder -typed -destination typed/mock.go -package typed -source input.go
+//
+
+// Package typed is a generated GoMock package.
+package typed
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Delete mocks base method.
+func (m *MockStore) Delete(keys ...string) int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStoreMockRecorder) Delete(keys ...any) *MockStoreDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStore)(nil).Delete), keys...)
+	return &MockStoreDeleteCall{Call: call}
+}
+
+// MockStoreDeleteCall wrap *gomock.Call
+type MockStoreDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreDeleteCall) Return(arg0 int) *MockStoreDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreDeleteCall) Do(f func(...string) int) *MockStoreDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreDeleteCall) DoAndReturn(f func(...string) int) *MockStoreDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStoreDeleteCall) ReturnsInOrder(rets ...int) *MockStoreDeleteCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Get mocks base method.
+func (m *MockStore) Get(key string) (string, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStoreMockRecorder) Get(key any) *MockStoreGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), key)
+	return &MockStoreGetCall{Call: call}
+}
+
+// MockStoreGetCall wrap *gomock.Call
+type MockStoreGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreGetCall) Return(arg0 string, arg1 error) *MockStoreGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreGetCall) Do(f func(string) (string, error)) *MockStoreGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreGetCall) DoAndReturn(f func(string) (string, error)) *MockStoreGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStoreGetCall) ReturnsInOrder(rets ...[]any) *MockStoreGetCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// Put mocks base method.
+func (m *MockStore) Put(key, value string) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockStoreMockRecorder) Put(key, value any) *MockStorePutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), key, value)
+	return &MockStorePutCall{Call: call}
+}
+
+// MockStorePutCall wrap *gomock.Call
+type MockStorePutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorePutCall) Return(arg0 error) *MockStorePutCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorePutCall) Do(f func(string, string) error) *MockStorePutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorePutCall) DoAndReturn(f func(string, string) error) *MockStorePutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStorePutCall) ReturnsInOrder(rets ...error) *MockStorePutCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// MockStoreBuilder registers expected calls of a MockStore before building it.
+type MockStoreBuilder struct {
+	mock *MockStore
+}
+
+// NewMockStoreBuilder creates a builder of a new mock instance.
+func NewMockStoreBuilder(ctrl *gomock.Controller) *MockStoreBuilder {
+	return &MockStoreBuilder{mock: NewMockStore(ctrl)}
+}
+
+// Build returns the mock with the expected calls registered by the builder.
+func (b *MockStoreBuilder) Build() *MockStore {
+	return b.mock
+}
+
+// ExpectDelete indicates an expected call of Delete.
+func (b *MockStoreBuilder) ExpectDelete(keys ...any) *MockStoreDeleteCall {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Delete(keys...)
+}
+
+// ExpectGet indicates an expected call of Get.
+func (b *MockStoreBuilder) ExpectGet(key any) *MockStoreGetCall {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Get(key)
+}
+
+// ExpectPut indicates an expected call of Put.
+func (b *MockStoreBuilder) ExpectPut(key, value any) *MockStorePutCall {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Put(key, value)
+}
+
+// MockCache is a mock of Cache interface.
+type MockCache[K comparable, V any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMockRecorder[K, V]
+}
+
+// MockCacheMockRecorder is the mock recorder for MockCache.
+type MockCacheMockRecorder[K comparable, V any] struct {
+	mock *MockCache[K, V]
+}
+
+// NewMockCache creates a new mock instance.
+func NewMockCache[K comparable, V any](ctrl *gomock.Controller) *MockCache[K, V] {
+	mock := &MockCache[K, V]{ctrl: ctrl}
+	mock.recorder = &MockCacheMockRecorder[K, V]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCache[K, V]) EXPECT() *MockCacheMockRecorder[K, V] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockCache[K, V]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Load mocks base method.
+func (m *MockCache[K, V]) Load(arg0 K) (V, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", arg0)
+	ret0, _ := ret[0].(V)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockCacheMockRecorder[K, V]) Load(arg0 any) *MockCacheLoadCall[K, V] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockCache[K, V])(nil).Load), arg0)
+	return &MockCacheLoadCall[K, V]{Call: call}
+}
+
+// MockCacheLoadCall wrap *gomock.Call
+type MockCacheLoadCall[K comparable, V any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCacheLoadCall[K, V]) Return(arg0 V, arg1 bool) *MockCacheLoadCall[K, V] {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCacheLoadCall[K, V]) Do(f func(K) (V, bool)) *MockCacheLoadCall[K, V] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCacheLoadCall[K, V]) DoAndReturn(f func(K) (V, bool)) *MockCacheLoadCall[K, V] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockCacheLoadCall[K, V]) ReturnsInOrder(rets ...[]any) *MockCacheLoadCall[K, V] {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// MockCacheBuilder registers expected calls of a MockCache before building it.
+type MockCacheBuilder[K comparable, V any] struct {
+	mock *MockCache[K, V]
+}
+
+// NewMockCacheBuilder creates a builder of a new mock instance.
+func NewMockCacheBuilder[K comparable, V any](ctrl *gomock.Controller) *MockCacheBuilder[K, V] {
+	return &MockCacheBuilder[K, V]{mock: NewMockCache[K, V](ctrl)}
+}
+
+// Build returns the mock with the expected calls registered by the builder.
+func (b *MockCacheBuilder[K, V]) Build() *MockCache[K, V] {
+	return b.mock
+}
+
+// ExpectLoad indicates an expected call of Load.
+func (b *MockCacheBuilder[K, V]) ExpectLoad(arg0 any) *MockCacheLoadCall[K, V] {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Load(arg0)
+}
@@ -0,0 +1,20 @@
+// Package builtin_names has an interface whose methods and parameters are
+// named after Go's predeclared identifiers.
+package builtin_names
+
+//go:generate mockgen -package builtin_names -destination source_mock.go -source input.go -mock_names Buffer=MockSourceBuffer
+//go:generate mockgen -package builtin_names -destination typed_mock.go -source input.go -typed -mock_names Buffer=MockTypedBuffer
+//go:generate mockgen -package builtin_names -destination reflect_mock.go . Buffer
+
+// Buffer is an error, so that its mocks are errors too.
+type Buffer interface {
+	Len() int
+	Cap() int
+	New(len, cap int) Buffer
+	Error() string
+	Close() error
+	Copy(make []byte, new func() error) (int, error)
+	Append(string string, error ...error) (bool, string)
+	Print(any any, append, nil int, true, false bool) (len int, ok bool)
+	Do(panic, make, new string, recover func()) error
+}
@@ -0,0 +1,52 @@
+package builtin_names
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+// The mocks implement error through their Error method.
+var (
+	_ error = (*MockSourceBuffer)(nil)
+	_ error = (*MockTypedBuffer)(nil)
+	_ error = (*MockBuffer)(nil)
+)
+
+func TestBuiltinNames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	m := NewMockSourceBuffer(ctrl)
+	m.EXPECT().Error().Return("broken")
+	m.EXPECT().Append("s", errors.New("e")).Return(true, "se")
+	m.EXPECT().Print("x", 1, 2, true, false).Return(3, true)
+	var err error = m
+	if got := err.Error(); got != "broken" {
+		t.Errorf("Error() = %q, want %q", got, "broken")
+	}
+	if ok, s := m.Append("s", errors.New("e")); !ok || s != "se" {
+		t.Errorf("Append = %v, %q, want true, %q", ok, s, "se")
+	}
+	if n, ok := m.Print("x", 1, 2, true, false); n != 3 || !ok {
+		t.Errorf("Print = %v, %v, want 3, true", n, ok)
+	}
+
+	typed := NewMockTypedBuffer(ctrl)
+	typed.EXPECT().New(1, 2).DoAndReturn(func(len, cap int) Buffer { return typed })
+	typed.EXPECT().Len().Return(7)
+	if n := typed.New(1, 2).Len(); n != 7 {
+		t.Errorf("Len() = %d, want 7", n)
+	}
+
+	m.EXPECT().Do("p", "m", "n", gomock.Nil()).Return(nil)
+	if err := m.Do("p", "m", "n", nil); err != nil {
+		t.Errorf("Do = %v, want nil", err)
+	}
+
+	reflected := NewMockBuffer(ctrl)
+	reflected.EXPECT().Copy(gomock.Len(1), gomock.Nil()).Return(1, nil)
+	if n, err := reflected.Copy([]byte{0}, nil); n != 1 || err != nil {
+		t.Errorf("Copy = %d, %v, want 1, nil", n, err)
+	}
+}
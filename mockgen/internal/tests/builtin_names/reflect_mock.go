@@ -0,0 +1,208 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/builtin_names (interfaces: Buffer)
+//
+// Generated by this command:
+//
+//	mockgen -package builtin_names -destination reflect_mock.go . Buffer
+//
+
+// Package builtin_names is a generated GoMock package.
+package builtin_names
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBuffer is a mock of Buffer interface.
+type MockBuffer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBufferMockRecorder
+}
+
+// MockBufferMockRecorder is the mock recorder for MockBuffer.
+type MockBufferMockRecorder struct {
+	mock *MockBuffer
+}
+
+// NewMockBuffer creates a new mock instance.
+func NewMockBuffer(ctrl *gomock.Controller) *MockBuffer {
+	mock := &MockBuffer{ctrl: ctrl}
+	mock.recorder = &MockBufferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBuffer) EXPECT() *MockBufferMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBuffer; create it with NewMockBuffer")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockBuffer) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Append mocks base method.
+func (m *MockBuffer) Append(arg0 string, arg1 ...error) (bool, string) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBuffer; create it with NewMockBuffer")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Append", varargs...)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockBufferMockRecorder) Append(arg0 any, arg1 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockBuffer)(nil).Append), varargs...)
+}
+
+// Cap mocks base method.
+func (m *MockBuffer) Cap() int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBuffer; create it with NewMockBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cap")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Cap indicates an expected call of Cap.
+func (mr *MockBufferMockRecorder) Cap() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cap", reflect.TypeOf((*MockBuffer)(nil).Cap))
+}
+
+// Close mocks base method.
+func (m *MockBuffer) Close() error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBuffer; create it with NewMockBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockBufferMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockBuffer)(nil).Close))
+}
+
+// Copy mocks base method.
+func (m *MockBuffer) Copy(arg0 []byte, arg1 func() error) (int, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBuffer; create it with NewMockBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockBufferMockRecorder) Copy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockBuffer)(nil).Copy), arg0, arg1)
+}
+
+// Do mocks base method.
+func (m *MockBuffer) Do(arg0, arg1, arg2 string, arg3 func()) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBuffer; create it with NewMockBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockBufferMockRecorder) Do(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockBuffer)(nil).Do), arg0, arg1, arg2, arg3)
+}
+
+// Error mocks base method.
+func (m *MockBuffer) Error() string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBuffer; create it with NewMockBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockBufferMockRecorder) Error() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockBuffer)(nil).Error))
+}
+
+// Len mocks base method.
+func (m *MockBuffer) Len() int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBuffer; create it with NewMockBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Len")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Len indicates an expected call of Len.
+func (mr *MockBufferMockRecorder) Len() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Len", reflect.TypeOf((*MockBuffer)(nil).Len))
+}
+
+// New mocks base method.
+func (m *MockBuffer) New(arg0, arg1 int) Buffer {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBuffer; create it with NewMockBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "New", arg0, arg1)
+	ret0, _ := ret[0].(Buffer)
+	return ret0
+}
+
+// New indicates an expected call of New.
+func (mr *MockBufferMockRecorder) New(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "New", reflect.TypeOf((*MockBuffer)(nil).New), arg0, arg1)
+}
+
+// Print mocks base method.
+func (m *MockBuffer) Print(arg0 any, arg1, arg2 int, arg3, arg4 bool) (int, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBuffer; create it with NewMockBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Print", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Print indicates an expected call of Print.
+func (mr *MockBufferMockRecorder) Print(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockBuffer)(nil).Print), arg0, arg1, arg2, arg3, arg4)
+}
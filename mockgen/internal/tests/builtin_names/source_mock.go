@@ -0,0 +1,208 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package builtin_names -destination source_mock.go -source input.go -mock_names Buffer=MockSourceBuffer
+//
+
+// Package builtin_names is a generated GoMock package.
+package builtin_names
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSourceBuffer is a mock of Buffer interface.
+type MockSourceBuffer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceBufferMockRecorder
+}
+
+// MockSourceBufferMockRecorder is the mock recorder for MockSourceBuffer.
+type MockSourceBufferMockRecorder struct {
+	mock *MockSourceBuffer
+}
+
+// NewMockSourceBuffer creates a new mock instance.
+func NewMockSourceBuffer(ctrl *gomock.Controller) *MockSourceBuffer {
+	mock := &MockSourceBuffer{ctrl: ctrl}
+	mock.recorder = &MockSourceBufferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceBuffer) EXPECT() *MockSourceBufferMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBuffer; create it with NewMockSourceBuffer")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceBuffer) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Append mocks base method.
+func (m *MockSourceBuffer) Append(string_2 string, error_2 ...error) (bool, string) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBuffer; create it with NewMockSourceBuffer")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{string_2}
+	for _, a := range error_2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Append", varargs...)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockSourceBufferMockRecorder) Append(string_2 any, error_2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{string_2}, error_2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockSourceBuffer)(nil).Append), varargs...)
+}
+
+// Cap mocks base method.
+func (m *MockSourceBuffer) Cap() int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBuffer; create it with NewMockSourceBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cap")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Cap indicates an expected call of Cap.
+func (mr *MockSourceBufferMockRecorder) Cap() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cap", reflect.TypeOf((*MockSourceBuffer)(nil).Cap))
+}
+
+// Close mocks base method.
+func (m *MockSourceBuffer) Close() error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBuffer; create it with NewMockSourceBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSourceBufferMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSourceBuffer)(nil).Close))
+}
+
+// Copy mocks base method.
+func (m *MockSourceBuffer) Copy(make []byte, new func() error) (int, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBuffer; create it with NewMockSourceBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", make, new)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockSourceBufferMockRecorder) Copy(make, new any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockSourceBuffer)(nil).Copy), make, new)
+}
+
+// Do mocks base method.
+func (m *MockSourceBuffer) Do(panic_2, make, new string, recover func()) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBuffer; create it with NewMockSourceBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", panic_2, make, new, recover)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSourceBufferMockRecorder) Do(panic_2, make, new, recover any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSourceBuffer)(nil).Do), panic_2, make, new, recover)
+}
+
+// Error mocks base method.
+func (m *MockSourceBuffer) Error() string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBuffer; create it with NewMockSourceBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockSourceBufferMockRecorder) Error() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockSourceBuffer)(nil).Error))
+}
+
+// Len mocks base method.
+func (m *MockSourceBuffer) Len() int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBuffer; create it with NewMockSourceBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Len")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Len indicates an expected call of Len.
+func (mr *MockSourceBufferMockRecorder) Len() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Len", reflect.TypeOf((*MockSourceBuffer)(nil).Len))
+}
+
+// New mocks base method.
+func (m *MockSourceBuffer) New(len, cap int) Buffer {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBuffer; create it with NewMockSourceBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "New", len, cap)
+	ret0, _ := ret[0].(Buffer)
+	return ret0
+}
+
+// New indicates an expected call of New.
+func (mr *MockSourceBufferMockRecorder) New(len, cap any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "New", reflect.TypeOf((*MockSourceBuffer)(nil).New), len, cap)
+}
+
+// Print mocks base method.
+func (m *MockSourceBuffer) Print(any_2 any, append_2, nil_2 int, true, false bool) (int, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBuffer; create it with NewMockSourceBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Print", any_2, append_2, nil_2, true, false)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Print indicates an expected call of Print.
+func (mr *MockSourceBufferMockRecorder) Print(any_2, append_2, nil_2, true, false any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockSourceBuffer)(nil).Print), any_2, append_2, nil_2, true, false)
+}
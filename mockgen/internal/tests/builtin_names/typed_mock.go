@@ -0,0 +1,502 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package builtin_names -destination typed_mock.go -source input.go -typed -mock_names Buffer=MockTypedBuffer
+//
+
+// Package builtin_names is a generated GoMock package.
+package builtin_names
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTypedBuffer is a mock of Buffer interface.
+type MockTypedBuffer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTypedBufferMockRecorder
+}
+
+// MockTypedBufferMockRecorder is the mock recorder for MockTypedBuffer.
+type MockTypedBufferMockRecorder struct {
+	mock *MockTypedBuffer
+}
+
+// NewMockTypedBuffer creates a new mock instance.
+func NewMockTypedBuffer(ctrl *gomock.Controller) *MockTypedBuffer {
+	mock := &MockTypedBuffer{ctrl: ctrl}
+	mock.recorder = &MockTypedBufferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTypedBuffer) EXPECT() *MockTypedBufferMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedBuffer; create it with NewMockTypedBuffer")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockTypedBuffer) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Append mocks base method.
+func (m *MockTypedBuffer) Append(string_2 string, error_2 ...error) (bool, string) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedBuffer; create it with NewMockTypedBuffer")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{string_2}
+	for _, a := range error_2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Append", varargs...)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockTypedBufferMockRecorder) Append(string_2 any, error_2 ...any) *MockTypedBufferAppendCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{string_2}, error_2...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockTypedBuffer)(nil).Append), varargs...)
+	return &MockTypedBufferAppendCall{Call: call}
+}
+
+// MockTypedBufferAppendCall wrap *gomock.Call
+type MockTypedBufferAppendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedBufferAppendCall) Return(arg0 bool, arg1 string) *MockTypedBufferAppendCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedBufferAppendCall) Do(f func(string, ...error) (bool, string)) *MockTypedBufferAppendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedBufferAppendCall) DoAndReturn(f func(string, ...error) (bool, string)) *MockTypedBufferAppendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedBufferAppendCall) ReturnsInOrder(rets ...[]any) *MockTypedBufferAppendCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// Cap mocks base method.
+func (m *MockTypedBuffer) Cap() int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedBuffer; create it with NewMockTypedBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cap")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Cap indicates an expected call of Cap.
+func (mr *MockTypedBufferMockRecorder) Cap() *MockTypedBufferCapCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cap", reflect.TypeOf((*MockTypedBuffer)(nil).Cap))
+	return &MockTypedBufferCapCall{Call: call}
+}
+
+// MockTypedBufferCapCall wrap *gomock.Call
+type MockTypedBufferCapCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedBufferCapCall) Return(arg0 int) *MockTypedBufferCapCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedBufferCapCall) Do(f func() int) *MockTypedBufferCapCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedBufferCapCall) DoAndReturn(f func() int) *MockTypedBufferCapCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedBufferCapCall) ReturnsInOrder(rets ...int) *MockTypedBufferCapCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Close mocks base method.
+func (m *MockTypedBuffer) Close() error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedBuffer; create it with NewMockTypedBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockTypedBufferMockRecorder) Close() *MockTypedBufferCloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockTypedBuffer)(nil).Close))
+	return &MockTypedBufferCloseCall{Call: call}
+}
+
+// MockTypedBufferCloseCall wrap *gomock.Call
+type MockTypedBufferCloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedBufferCloseCall) Return(arg0 error) *MockTypedBufferCloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedBufferCloseCall) Do(f func() error) *MockTypedBufferCloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedBufferCloseCall) DoAndReturn(f func() error) *MockTypedBufferCloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedBufferCloseCall) ReturnsInOrder(rets ...error) *MockTypedBufferCloseCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Copy mocks base method.
+func (m *MockTypedBuffer) Copy(make []byte, new func() error) (int, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedBuffer; create it with NewMockTypedBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", make, new)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockTypedBufferMockRecorder) Copy(make, new any) *MockTypedBufferCopyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockTypedBuffer)(nil).Copy), make, new)
+	return &MockTypedBufferCopyCall{Call: call}
+}
+
+// MockTypedBufferCopyCall wrap *gomock.Call
+type MockTypedBufferCopyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedBufferCopyCall) Return(arg0 int, arg1 error) *MockTypedBufferCopyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedBufferCopyCall) Do(f func([]byte, func() error) (int, error)) *MockTypedBufferCopyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedBufferCopyCall) DoAndReturn(f func([]byte, func() error) (int, error)) *MockTypedBufferCopyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedBufferCopyCall) ReturnsInOrder(rets ...[]any) *MockTypedBufferCopyCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// Do mocks base method.
+func (m *MockTypedBuffer) Do(panic_2, make, new string, recover func()) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedBuffer; create it with NewMockTypedBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", panic_2, make, new, recover)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTypedBufferMockRecorder) Do(panic_2, make, new, recover any) *MockTypedBufferDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTypedBuffer)(nil).Do), panic_2, make, new, recover)
+	return &MockTypedBufferDoCall{Call: call}
+}
+
+// MockTypedBufferDoCall wrap *gomock.Call
+type MockTypedBufferDoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedBufferDoCall) Return(arg0 error) *MockTypedBufferDoCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedBufferDoCall) Do(f func(string, string, string, func()) error) *MockTypedBufferDoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedBufferDoCall) DoAndReturn(f func(string, string, string, func()) error) *MockTypedBufferDoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedBufferDoCall) ReturnsInOrder(rets ...error) *MockTypedBufferDoCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Error mocks base method.
+func (m *MockTypedBuffer) Error() string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedBuffer; create it with NewMockTypedBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockTypedBufferMockRecorder) Error() *MockTypedBufferErrorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockTypedBuffer)(nil).Error))
+	return &MockTypedBufferErrorCall{Call: call}
+}
+
+// MockTypedBufferErrorCall wrap *gomock.Call
+type MockTypedBufferErrorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedBufferErrorCall) Return(arg0 string) *MockTypedBufferErrorCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedBufferErrorCall) Do(f func() string) *MockTypedBufferErrorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedBufferErrorCall) DoAndReturn(f func() string) *MockTypedBufferErrorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedBufferErrorCall) ReturnsInOrder(rets ...string) *MockTypedBufferErrorCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Len mocks base method.
+func (m *MockTypedBuffer) Len() int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedBuffer; create it with NewMockTypedBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Len")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Len indicates an expected call of Len.
+func (mr *MockTypedBufferMockRecorder) Len() *MockTypedBufferLenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Len", reflect.TypeOf((*MockTypedBuffer)(nil).Len))
+	return &MockTypedBufferLenCall{Call: call}
+}
+
+// MockTypedBufferLenCall wrap *gomock.Call
+type MockTypedBufferLenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedBufferLenCall) Return(arg0 int) *MockTypedBufferLenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedBufferLenCall) Do(f func() int) *MockTypedBufferLenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedBufferLenCall) DoAndReturn(f func() int) *MockTypedBufferLenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedBufferLenCall) ReturnsInOrder(rets ...int) *MockTypedBufferLenCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// New mocks base method.
+func (m *MockTypedBuffer) New(len, cap int) Buffer {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedBuffer; create it with NewMockTypedBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "New", len, cap)
+	ret0, _ := ret[0].(Buffer)
+	return ret0
+}
+
+// New indicates an expected call of New.
+func (mr *MockTypedBufferMockRecorder) New(len, cap any) *MockTypedBufferNewCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "New", reflect.TypeOf((*MockTypedBuffer)(nil).New), len, cap)
+	return &MockTypedBufferNewCall{Call: call}
+}
+
+// MockTypedBufferNewCall wrap *gomock.Call
+type MockTypedBufferNewCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedBufferNewCall) Return(arg0 Buffer) *MockTypedBufferNewCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedBufferNewCall) Do(f func(int, int) Buffer) *MockTypedBufferNewCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedBufferNewCall) DoAndReturn(f func(int, int) Buffer) *MockTypedBufferNewCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedBufferNewCall) ReturnsInOrder(rets ...Buffer) *MockTypedBufferNewCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Print mocks base method.
+func (m *MockTypedBuffer) Print(any_2 any, append_2, nil_2 int, true, false bool) (int, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedBuffer; create it with NewMockTypedBuffer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Print", any_2, append_2, nil_2, true, false)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Print indicates an expected call of Print.
+func (mr *MockTypedBufferMockRecorder) Print(any_2, append_2, nil_2, true, false any) *MockTypedBufferPrintCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockTypedBuffer)(nil).Print), any_2, append_2, nil_2, true, false)
+	return &MockTypedBufferPrintCall{Call: call}
+}
+
+// MockTypedBufferPrintCall wrap *gomock.Call
+type MockTypedBufferPrintCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedBufferPrintCall) Return(len int, ok bool) *MockTypedBufferPrintCall {
+	c.Call = c.Call.Return(len, ok)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedBufferPrintCall) Do(f func(any, int, int, bool, bool) (int, bool)) *MockTypedBufferPrintCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedBufferPrintCall) DoAndReturn(f func(any, int, int, bool, bool) (int, bool)) *MockTypedBufferPrintCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedBufferPrintCall) ReturnsInOrder(rets ...[]any) *MockTypedBufferPrintCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
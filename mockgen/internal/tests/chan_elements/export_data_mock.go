@@ -0,0 +1,381 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/chan_elements (interfaces: Bus)
+//
+// Generated by this command:
+//
+//	mockgen -package chan_elements -destination export_data_mock.go -export_data -typed -mock_names Bus=MockExportDataBus . Bus
+//
+
+// Package chan_elements is a generated GoMock package.
+package chan_elements
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExportDataBus is a mock of Bus interface.
+type MockExportDataBus struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportDataBusMockRecorder
+}
+
+// MockExportDataBusMockRecorder is the mock recorder for MockExportDataBus.
+type MockExportDataBusMockRecorder struct {
+	mock *MockExportDataBus
+}
+
+// NewMockExportDataBus creates a new mock instance.
+func NewMockExportDataBus(ctrl *gomock.Controller) *MockExportDataBus {
+	mock := &MockExportDataBus{ctrl: ctrl}
+	mock.recorder = &MockExportDataBusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportDataBus) EXPECT() *MockExportDataBusMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataBus; create it with NewMockExportDataBus")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockExportDataBus) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Batches mocks base method.
+func (m *MockExportDataBus) Batches() <-chan [2]*Event {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataBus; create it with NewMockExportDataBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Batches")
+	ret0, _ := ret[0].(<-chan [2]*Event)
+	return ret0
+}
+
+// Batches indicates an expected call of Batches.
+func (mr *MockExportDataBusMockRecorder) Batches() *MockExportDataBusBatchesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Batches", reflect.TypeOf((*MockExportDataBus)(nil).Batches))
+	return &MockExportDataBusBatchesCall{Call: call}
+}
+
+// MockExportDataBusBatchesCall wrap *gomock.Call
+type MockExportDataBusBatchesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExportDataBusBatchesCall) Return(arg0 <-chan [2]*Event) *MockExportDataBusBatchesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExportDataBusBatchesCall) Do(f func() <-chan [2]*Event) *MockExportDataBusBatchesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExportDataBusBatchesCall) DoAndReturn(f func() <-chan [2]*Event) *MockExportDataBusBatchesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockExportDataBusBatchesCall) ReturnsInOrder(rets ...<-chan [2]*Event) *MockExportDataBusBatchesCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Nested mocks base method.
+func (m *MockExportDataBus) Nested(arg0 <-chan chan<- *Event) chan (<-chan []time.Duration) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataBus; create it with NewMockExportDataBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nested", arg0)
+	ret0, _ := ret[0].(chan (<-chan []time.Duration))
+	return ret0
+}
+
+// Nested indicates an expected call of Nested.
+func (mr *MockExportDataBusMockRecorder) Nested(arg0 any) *MockExportDataBusNestedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nested", reflect.TypeOf((*MockExportDataBus)(nil).Nested), arg0)
+	return &MockExportDataBusNestedCall{Call: call}
+}
+
+// MockExportDataBusNestedCall wrap *gomock.Call
+type MockExportDataBusNestedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExportDataBusNestedCall) Return(arg0 chan (<-chan []time.Duration)) *MockExportDataBusNestedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExportDataBusNestedCall) Do(f func(<-chan chan<- *Event) chan (<-chan []time.Duration)) *MockExportDataBusNestedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExportDataBusNestedCall) DoAndReturn(f func(<-chan chan<- *Event) chan (<-chan []time.Duration)) *MockExportDataBusNestedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockExportDataBusNestedCall) ReturnsInOrder(rets ...chan (<-chan []time.Duration)) *MockExportDataBusNestedCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Publish mocks base method.
+func (m *MockExportDataBus) Publish(arg0 chan<- map[string]int) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataBus; create it with NewMockExportDataBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockExportDataBusMockRecorder) Publish(arg0 any) *MockExportDataBusPublishCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockExportDataBus)(nil).Publish), arg0)
+	return &MockExportDataBusPublishCall{Call: call}
+}
+
+// MockExportDataBusPublishCall wrap *gomock.Call
+type MockExportDataBusPublishCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExportDataBusPublishCall) Return(arg0 error) *MockExportDataBusPublishCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExportDataBusPublishCall) Do(f func(chan<- map[string]int) error) *MockExportDataBusPublishCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExportDataBusPublishCall) DoAndReturn(f func(chan<- map[string]int) error) *MockExportDataBusPublishCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockExportDataBusPublishCall) ReturnsInOrder(rets ...error) *MockExportDataBusPublishCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Signal mocks base method.
+func (m *MockExportDataBus) Signal() chan<- struct{} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataBus; create it with NewMockExportDataBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Signal")
+	ret0, _ := ret[0].(chan<- struct{})
+	return ret0
+}
+
+// Signal indicates an expected call of Signal.
+func (mr *MockExportDataBusMockRecorder) Signal() *MockExportDataBusSignalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signal", reflect.TypeOf((*MockExportDataBus)(nil).Signal))
+	return &MockExportDataBusSignalCall{Call: call}
+}
+
+// MockExportDataBusSignalCall wrap *gomock.Call
+type MockExportDataBusSignalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExportDataBusSignalCall) Return(arg0 chan<- struct{}) *MockExportDataBusSignalCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExportDataBusSignalCall) Do(f func() chan<- struct{}) *MockExportDataBusSignalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExportDataBusSignalCall) DoAndReturn(f func() chan<- struct{}) *MockExportDataBusSignalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockExportDataBusSignalCall) ReturnsInOrder(rets ...chan<- struct{}) *MockExportDataBusSignalCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Subscribe mocks base method.
+func (m *MockExportDataBus) Subscribe(arg0 string) <-chan *Event {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataBus; create it with NewMockExportDataBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0)
+	ret0, _ := ret[0].(<-chan *Event)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockExportDataBusMockRecorder) Subscribe(arg0 any) *MockExportDataBusSubscribeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockExportDataBus)(nil).Subscribe), arg0)
+	return &MockExportDataBusSubscribeCall{Call: call}
+}
+
+// MockExportDataBusSubscribeCall wrap *gomock.Call
+type MockExportDataBusSubscribeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExportDataBusSubscribeCall) Return(arg0 <-chan *Event) *MockExportDataBusSubscribeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExportDataBusSubscribeCall) Do(f func(string) <-chan *Event) *MockExportDataBusSubscribeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExportDataBusSubscribeCall) DoAndReturn(f func(string) <-chan *Event) *MockExportDataBusSubscribeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockExportDataBusSubscribeCall) ReturnsInOrder(rets ...<-chan *Event) *MockExportDataBusSubscribeCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Ticks mocks base method.
+func (m *MockExportDataBus) Ticks(arg0 chan struct {
+	At    time.Time
+	Count int
+}) chan struct {
+	At    time.Time
+	Count int
+} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataBus; create it with NewMockExportDataBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ticks", arg0)
+	ret0, _ := ret[0].(chan struct {
+		At    time.Time
+		Count int
+	})
+	return ret0
+}
+
+// Ticks indicates an expected call of Ticks.
+func (mr *MockExportDataBusMockRecorder) Ticks(arg0 any) *MockExportDataBusTicksCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ticks", reflect.TypeOf((*MockExportDataBus)(nil).Ticks), arg0)
+	return &MockExportDataBusTicksCall{Call: call}
+}
+
+// MockExportDataBusTicksCall wrap *gomock.Call
+type MockExportDataBusTicksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExportDataBusTicksCall) Return(arg0 chan struct {
+	At    time.Time
+	Count int
+}) *MockExportDataBusTicksCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExportDataBusTicksCall) Do(f func(chan struct {
+	At    time.Time
+	Count int
+}) chan struct {
+	At    time.Time
+	Count int
+}) *MockExportDataBusTicksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExportDataBusTicksCall) DoAndReturn(f func(chan struct {
+	At    time.Time
+	Count int
+}) chan struct {
+	At    time.Time
+	Count int
+}) *MockExportDataBusTicksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockExportDataBusTicksCall) ReturnsInOrder(rets ...chan struct {
+	At    time.Time
+	Count int
+}) *MockExportDataBusTicksCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
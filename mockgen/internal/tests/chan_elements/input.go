@@ -0,0 +1,31 @@
+// Package chan_elements has an interface whose methods take and return
+// channels of each direction with composite and imported element types.
+package chan_elements
+
+//go:generate mockgen -package chan_elements -destination source_mock.go -source input.go -typed -mock_names Bus=MockSourceBus
+//go:generate mockgen -package chan_elements -destination reflect_mock.go -typed -mock_names Bus=MockReflectBus . Bus
+//go:generate mockgen -package chan_elements -destination export_data_mock.go -export_data -typed -mock_names Bus=MockExportDataBus . Bus
+//go:generate mockgen -destination mock_chan_elements/mock.go . Bus
+
+import "time"
+
+// Event is something that happened.
+type Event struct {
+	Name string
+	At   time.Time
+}
+
+type Bus interface {
+	Ticks(done chan struct {
+		At    time.Time
+		Count int
+	}) chan struct {
+		At    time.Time
+		Count int
+	}
+	Subscribe(topic string) <-chan *Event
+	Publish(counts chan<- map[string]int) error
+	Nested(in <-chan chan<- *Event) chan (<-chan []time.Duration)
+	Batches() <-chan [2]*Event
+	Signal() chan<- struct{}
+}
@@ -0,0 +1,75 @@
+package chan_elements_test
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/chan_elements"
+	"go.uber.org/mock/mockgen/internal/tests/chan_elements/mock_chan_elements"
+)
+
+var (
+	_ chan_elements.Bus = (*chan_elements.MockSourceBus)(nil)
+	_ chan_elements.Bus = (*chan_elements.MockReflectBus)(nil)
+	_ chan_elements.Bus = (*chan_elements.MockExportDataBus)(nil)
+	_ chan_elements.Bus = (*mock_chan_elements.MockBus)(nil)
+)
+
+type tick = struct {
+	At    time.Time
+	Count int
+}
+
+func TestChanElements(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	events := make(chan *chan_elements.Event)
+	ticks := make(chan tick)
+	counts := make(chan map[string]int)
+	nested := make(chan (<-chan []time.Duration))
+	signal := make(chan struct{})
+
+	// The typed Return of each mock takes the channels with their directions.
+	source := chan_elements.NewMockSourceBus(ctrl)
+	source.EXPECT().Subscribe("t").Return(events)
+	source.EXPECT().Ticks(ticks).Return(ticks)
+	source.EXPECT().Publish(gomock.Any()).Return(nil)
+	source.EXPECT().Nested(gomock.Nil()).Return(nested)
+	source.EXPECT().Signal().Return(signal)
+	reflected := chan_elements.NewMockReflectBus(ctrl)
+	reflected.EXPECT().Subscribe("t").Return(events)
+	reflected.EXPECT().Ticks(ticks).Return(ticks)
+	reflected.EXPECT().Publish(gomock.Any()).Return(nil)
+	reflected.EXPECT().Nested(gomock.Nil()).Return(nested)
+	reflected.EXPECT().Signal().Return(signal)
+	exported := chan_elements.NewMockExportDataBus(ctrl)
+	exported.EXPECT().Subscribe("t").Return(events)
+	exported.EXPECT().Ticks(ticks).Return(ticks)
+	exported.EXPECT().Publish(gomock.Any()).Return(nil)
+	exported.EXPECT().Nested(gomock.Nil()).Return(nested)
+	exported.EXPECT().Signal().Return(signal)
+	other := mock_chan_elements.NewMockBus(ctrl)
+	other.EXPECT().Subscribe("t").Return((<-chan *chan_elements.Event)(events))
+	other.EXPECT().Ticks(ticks).Return(ticks)
+	other.EXPECT().Publish(gomock.Any()).Return(nil)
+	other.EXPECT().Nested(gomock.Nil()).Return(nested)
+	other.EXPECT().Signal().Return((chan<- struct{})(signal))
+
+	for _, b := range []chan_elements.Bus{source, reflected, exported, other} {
+		if got := b.Subscribe("t"); got != (<-chan *chan_elements.Event)(events) {
+			t.Errorf("%T.Subscribe(t) = %v, want %v", b, got, events)
+		}
+		if got := b.Ticks(ticks); got != ticks {
+			t.Errorf("%T.Ticks() = %v, want %v", b, got, ticks)
+		}
+		if err := b.Publish(counts); err != nil {
+			t.Errorf("%T.Publish() = %v, want nil", b, err)
+		}
+		if got := b.Nested(nil); got != nested {
+			t.Errorf("%T.Nested(nil) = %v, want %v", b, got, nested)
+		}
+		if got := b.Signal(); got != (chan<- struct{})(signal) {
+			t.Errorf("%T.Signal() = %v, want %v", b, got, signal)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/chan_elements (interfaces: Bus)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_chan_elements/mock.go . Bus
+//
+
+// Package mock_chan_elements is a generated GoMock package.
+package mock_chan_elements
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+	chan_elements "go.uber.org/mock/mockgen/internal/tests/chan_elements"
+)
+
+// MockBus is a mock of Bus interface.
+type MockBus struct {
+	ctrl     *gomock.Controller
+	recorder *MockBusMockRecorder
+}
+
+// MockBusMockRecorder is the mock recorder for MockBus.
+type MockBusMockRecorder struct {
+	mock *MockBus
+}
+
+// NewMockBus creates a new mock instance.
+func NewMockBus(ctrl *gomock.Controller) *MockBus {
+	mock := &MockBus{ctrl: ctrl}
+	mock.recorder = &MockBusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBus) EXPECT() *MockBusMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBus; create it with NewMockBus")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockBus) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Batches mocks base method.
+func (m *MockBus) Batches() <-chan [2]*chan_elements.Event {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBus; create it with NewMockBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Batches")
+	ret0, _ := ret[0].(<-chan [2]*chan_elements.Event)
+	return ret0
+}
+
+// Batches indicates an expected call of Batches.
+func (mr *MockBusMockRecorder) Batches() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Batches", reflect.TypeOf((*MockBus)(nil).Batches))
+}
+
+// Nested mocks base method.
+func (m *MockBus) Nested(arg0 <-chan chan<- *chan_elements.Event) chan (<-chan []time.Duration) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBus; create it with NewMockBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nested", arg0)
+	ret0, _ := ret[0].(chan (<-chan []time.Duration))
+	return ret0
+}
+
+// Nested indicates an expected call of Nested.
+func (mr *MockBusMockRecorder) Nested(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nested", reflect.TypeOf((*MockBus)(nil).Nested), arg0)
+}
+
+// Publish mocks base method.
+func (m *MockBus) Publish(arg0 chan<- map[string]int) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBus; create it with NewMockBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockBusMockRecorder) Publish(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockBus)(nil).Publish), arg0)
+}
+
+// Signal mocks base method.
+func (m *MockBus) Signal() chan<- struct{} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBus; create it with NewMockBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Signal")
+	ret0, _ := ret[0].(chan<- struct{})
+	return ret0
+}
+
+// Signal indicates an expected call of Signal.
+func (mr *MockBusMockRecorder) Signal() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signal", reflect.TypeOf((*MockBus)(nil).Signal))
+}
+
+// Subscribe mocks base method.
+func (m *MockBus) Subscribe(arg0 string) <-chan *chan_elements.Event {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBus; create it with NewMockBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0)
+	ret0, _ := ret[0].(<-chan *chan_elements.Event)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockBusMockRecorder) Subscribe(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockBus)(nil).Subscribe), arg0)
+}
+
+// Ticks mocks base method.
+func (m *MockBus) Ticks(arg0 chan struct {
+	At    time.Time
+	Count int
+}) chan struct {
+	At    time.Time
+	Count int
+} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBus; create it with NewMockBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ticks", arg0)
+	ret0, _ := ret[0].(chan struct {
+		At    time.Time
+		Count int
+	})
+	return ret0
+}
+
+// Ticks indicates an expected call of Ticks.
+func (mr *MockBusMockRecorder) Ticks(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ticks", reflect.TypeOf((*MockBus)(nil).Ticks), arg0)
+}
@@ -0,0 +1,381 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/chan_elements (interfaces: Bus)
+//
+// Generated by this command:
+//
+//	mockgen -package chan_elements -destination reflect_mock.go -typed -mock_names Bus=MockReflectBus . Bus
+//
+
+// Package chan_elements is a generated GoMock package.
+package chan_elements
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReflectBus is a mock of Bus interface.
+type MockReflectBus struct {
+	ctrl     *gomock.Controller
+	recorder *MockReflectBusMockRecorder
+}
+
+// MockReflectBusMockRecorder is the mock recorder for MockReflectBus.
+type MockReflectBusMockRecorder struct {
+	mock *MockReflectBus
+}
+
+// NewMockReflectBus creates a new mock instance.
+func NewMockReflectBus(ctrl *gomock.Controller) *MockReflectBus {
+	mock := &MockReflectBus{ctrl: ctrl}
+	mock.recorder = &MockReflectBusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReflectBus) EXPECT() *MockReflectBusMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectBus; create it with NewMockReflectBus")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockReflectBus) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Batches mocks base method.
+func (m *MockReflectBus) Batches() <-chan [2]*Event {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectBus; create it with NewMockReflectBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Batches")
+	ret0, _ := ret[0].(<-chan [2]*Event)
+	return ret0
+}
+
+// Batches indicates an expected call of Batches.
+func (mr *MockReflectBusMockRecorder) Batches() *MockReflectBusBatchesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Batches", reflect.TypeOf((*MockReflectBus)(nil).Batches))
+	return &MockReflectBusBatchesCall{Call: call}
+}
+
+// MockReflectBusBatchesCall wrap *gomock.Call
+type MockReflectBusBatchesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReflectBusBatchesCall) Return(arg0 <-chan [2]*Event) *MockReflectBusBatchesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReflectBusBatchesCall) Do(f func() <-chan [2]*Event) *MockReflectBusBatchesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReflectBusBatchesCall) DoAndReturn(f func() <-chan [2]*Event) *MockReflectBusBatchesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockReflectBusBatchesCall) ReturnsInOrder(rets ...<-chan [2]*Event) *MockReflectBusBatchesCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Nested mocks base method.
+func (m *MockReflectBus) Nested(arg0 <-chan chan<- *Event) chan (<-chan []time.Duration) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectBus; create it with NewMockReflectBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nested", arg0)
+	ret0, _ := ret[0].(chan (<-chan []time.Duration))
+	return ret0
+}
+
+// Nested indicates an expected call of Nested.
+func (mr *MockReflectBusMockRecorder) Nested(arg0 any) *MockReflectBusNestedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nested", reflect.TypeOf((*MockReflectBus)(nil).Nested), arg0)
+	return &MockReflectBusNestedCall{Call: call}
+}
+
+// MockReflectBusNestedCall wrap *gomock.Call
+type MockReflectBusNestedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReflectBusNestedCall) Return(arg0 chan (<-chan []time.Duration)) *MockReflectBusNestedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReflectBusNestedCall) Do(f func(<-chan chan<- *Event) chan (<-chan []time.Duration)) *MockReflectBusNestedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReflectBusNestedCall) DoAndReturn(f func(<-chan chan<- *Event) chan (<-chan []time.Duration)) *MockReflectBusNestedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockReflectBusNestedCall) ReturnsInOrder(rets ...chan (<-chan []time.Duration)) *MockReflectBusNestedCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Publish mocks base method.
+func (m *MockReflectBus) Publish(arg0 chan<- map[string]int) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectBus; create it with NewMockReflectBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockReflectBusMockRecorder) Publish(arg0 any) *MockReflectBusPublishCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockReflectBus)(nil).Publish), arg0)
+	return &MockReflectBusPublishCall{Call: call}
+}
+
+// MockReflectBusPublishCall wrap *gomock.Call
+type MockReflectBusPublishCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReflectBusPublishCall) Return(arg0 error) *MockReflectBusPublishCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReflectBusPublishCall) Do(f func(chan<- map[string]int) error) *MockReflectBusPublishCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReflectBusPublishCall) DoAndReturn(f func(chan<- map[string]int) error) *MockReflectBusPublishCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockReflectBusPublishCall) ReturnsInOrder(rets ...error) *MockReflectBusPublishCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Signal mocks base method.
+func (m *MockReflectBus) Signal() chan<- struct{} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectBus; create it with NewMockReflectBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Signal")
+	ret0, _ := ret[0].(chan<- struct{})
+	return ret0
+}
+
+// Signal indicates an expected call of Signal.
+func (mr *MockReflectBusMockRecorder) Signal() *MockReflectBusSignalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signal", reflect.TypeOf((*MockReflectBus)(nil).Signal))
+	return &MockReflectBusSignalCall{Call: call}
+}
+
+// MockReflectBusSignalCall wrap *gomock.Call
+type MockReflectBusSignalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReflectBusSignalCall) Return(arg0 chan<- struct{}) *MockReflectBusSignalCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReflectBusSignalCall) Do(f func() chan<- struct{}) *MockReflectBusSignalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReflectBusSignalCall) DoAndReturn(f func() chan<- struct{}) *MockReflectBusSignalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockReflectBusSignalCall) ReturnsInOrder(rets ...chan<- struct{}) *MockReflectBusSignalCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Subscribe mocks base method.
+func (m *MockReflectBus) Subscribe(arg0 string) <-chan *Event {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectBus; create it with NewMockReflectBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0)
+	ret0, _ := ret[0].(<-chan *Event)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockReflectBusMockRecorder) Subscribe(arg0 any) *MockReflectBusSubscribeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockReflectBus)(nil).Subscribe), arg0)
+	return &MockReflectBusSubscribeCall{Call: call}
+}
+
+// MockReflectBusSubscribeCall wrap *gomock.Call
+type MockReflectBusSubscribeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReflectBusSubscribeCall) Return(arg0 <-chan *Event) *MockReflectBusSubscribeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReflectBusSubscribeCall) Do(f func(string) <-chan *Event) *MockReflectBusSubscribeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReflectBusSubscribeCall) DoAndReturn(f func(string) <-chan *Event) *MockReflectBusSubscribeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockReflectBusSubscribeCall) ReturnsInOrder(rets ...<-chan *Event) *MockReflectBusSubscribeCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Ticks mocks base method.
+func (m *MockReflectBus) Ticks(arg0 chan struct {
+	At    time.Time
+	Count int
+}) chan struct {
+	At    time.Time
+	Count int
+} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectBus; create it with NewMockReflectBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ticks", arg0)
+	ret0, _ := ret[0].(chan struct {
+		At    time.Time
+		Count int
+	})
+	return ret0
+}
+
+// Ticks indicates an expected call of Ticks.
+func (mr *MockReflectBusMockRecorder) Ticks(arg0 any) *MockReflectBusTicksCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ticks", reflect.TypeOf((*MockReflectBus)(nil).Ticks), arg0)
+	return &MockReflectBusTicksCall{Call: call}
+}
+
+// MockReflectBusTicksCall wrap *gomock.Call
+type MockReflectBusTicksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReflectBusTicksCall) Return(arg0 chan struct {
+	At    time.Time
+	Count int
+}) *MockReflectBusTicksCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReflectBusTicksCall) Do(f func(chan struct {
+	At    time.Time
+	Count int
+}) chan struct {
+	At    time.Time
+	Count int
+}) *MockReflectBusTicksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReflectBusTicksCall) DoAndReturn(f func(chan struct {
+	At    time.Time
+	Count int
+}) chan struct {
+	At    time.Time
+	Count int
+}) *MockReflectBusTicksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockReflectBusTicksCall) ReturnsInOrder(rets ...chan struct {
+	At    time.Time
+	Count int
+}) *MockReflectBusTicksCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
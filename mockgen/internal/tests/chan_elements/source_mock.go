@@ -0,0 +1,381 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package chan_elements -destination source_mock.go -source input.go -typed -mock_names Bus=MockSourceBus
+//
+
+// Package chan_elements is a generated GoMock package.
+package chan_elements
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSourceBus is a mock of Bus interface.
+type MockSourceBus struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceBusMockRecorder
+}
+
+// MockSourceBusMockRecorder is the mock recorder for MockSourceBus.
+type MockSourceBusMockRecorder struct {
+	mock *MockSourceBus
+}
+
+// NewMockSourceBus creates a new mock instance.
+func NewMockSourceBus(ctrl *gomock.Controller) *MockSourceBus {
+	mock := &MockSourceBus{ctrl: ctrl}
+	mock.recorder = &MockSourceBusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceBus) EXPECT() *MockSourceBusMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBus; create it with NewMockSourceBus")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceBus) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Batches mocks base method.
+func (m *MockSourceBus) Batches() <-chan [2]*Event {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBus; create it with NewMockSourceBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Batches")
+	ret0, _ := ret[0].(<-chan [2]*Event)
+	return ret0
+}
+
+// Batches indicates an expected call of Batches.
+func (mr *MockSourceBusMockRecorder) Batches() *MockSourceBusBatchesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Batches", reflect.TypeOf((*MockSourceBus)(nil).Batches))
+	return &MockSourceBusBatchesCall{Call: call}
+}
+
+// MockSourceBusBatchesCall wrap *gomock.Call
+type MockSourceBusBatchesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceBusBatchesCall) Return(arg0 <-chan [2]*Event) *MockSourceBusBatchesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceBusBatchesCall) Do(f func() <-chan [2]*Event) *MockSourceBusBatchesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceBusBatchesCall) DoAndReturn(f func() <-chan [2]*Event) *MockSourceBusBatchesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceBusBatchesCall) ReturnsInOrder(rets ...<-chan [2]*Event) *MockSourceBusBatchesCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Nested mocks base method.
+func (m *MockSourceBus) Nested(in <-chan chan<- *Event) chan (<-chan []time.Duration) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBus; create it with NewMockSourceBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nested", in)
+	ret0, _ := ret[0].(chan (<-chan []time.Duration))
+	return ret0
+}
+
+// Nested indicates an expected call of Nested.
+func (mr *MockSourceBusMockRecorder) Nested(in any) *MockSourceBusNestedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nested", reflect.TypeOf((*MockSourceBus)(nil).Nested), in)
+	return &MockSourceBusNestedCall{Call: call}
+}
+
+// MockSourceBusNestedCall wrap *gomock.Call
+type MockSourceBusNestedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceBusNestedCall) Return(arg0 chan (<-chan []time.Duration)) *MockSourceBusNestedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceBusNestedCall) Do(f func(<-chan chan<- *Event) chan (<-chan []time.Duration)) *MockSourceBusNestedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceBusNestedCall) DoAndReturn(f func(<-chan chan<- *Event) chan (<-chan []time.Duration)) *MockSourceBusNestedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceBusNestedCall) ReturnsInOrder(rets ...chan (<-chan []time.Duration)) *MockSourceBusNestedCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Publish mocks base method.
+func (m *MockSourceBus) Publish(counts chan<- map[string]int) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBus; create it with NewMockSourceBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", counts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockSourceBusMockRecorder) Publish(counts any) *MockSourceBusPublishCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockSourceBus)(nil).Publish), counts)
+	return &MockSourceBusPublishCall{Call: call}
+}
+
+// MockSourceBusPublishCall wrap *gomock.Call
+type MockSourceBusPublishCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceBusPublishCall) Return(arg0 error) *MockSourceBusPublishCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceBusPublishCall) Do(f func(chan<- map[string]int) error) *MockSourceBusPublishCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceBusPublishCall) DoAndReturn(f func(chan<- map[string]int) error) *MockSourceBusPublishCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceBusPublishCall) ReturnsInOrder(rets ...error) *MockSourceBusPublishCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Signal mocks base method.
+func (m *MockSourceBus) Signal() chan<- struct{} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBus; create it with NewMockSourceBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Signal")
+	ret0, _ := ret[0].(chan<- struct{})
+	return ret0
+}
+
+// Signal indicates an expected call of Signal.
+func (mr *MockSourceBusMockRecorder) Signal() *MockSourceBusSignalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signal", reflect.TypeOf((*MockSourceBus)(nil).Signal))
+	return &MockSourceBusSignalCall{Call: call}
+}
+
+// MockSourceBusSignalCall wrap *gomock.Call
+type MockSourceBusSignalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceBusSignalCall) Return(arg0 chan<- struct{}) *MockSourceBusSignalCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceBusSignalCall) Do(f func() chan<- struct{}) *MockSourceBusSignalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceBusSignalCall) DoAndReturn(f func() chan<- struct{}) *MockSourceBusSignalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceBusSignalCall) ReturnsInOrder(rets ...chan<- struct{}) *MockSourceBusSignalCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Subscribe mocks base method.
+func (m *MockSourceBus) Subscribe(topic string) <-chan *Event {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBus; create it with NewMockSourceBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", topic)
+	ret0, _ := ret[0].(<-chan *Event)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockSourceBusMockRecorder) Subscribe(topic any) *MockSourceBusSubscribeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSourceBus)(nil).Subscribe), topic)
+	return &MockSourceBusSubscribeCall{Call: call}
+}
+
+// MockSourceBusSubscribeCall wrap *gomock.Call
+type MockSourceBusSubscribeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceBusSubscribeCall) Return(arg0 <-chan *Event) *MockSourceBusSubscribeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceBusSubscribeCall) Do(f func(string) <-chan *Event) *MockSourceBusSubscribeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceBusSubscribeCall) DoAndReturn(f func(string) <-chan *Event) *MockSourceBusSubscribeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceBusSubscribeCall) ReturnsInOrder(rets ...<-chan *Event) *MockSourceBusSubscribeCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Ticks mocks base method.
+func (m *MockSourceBus) Ticks(done chan struct {
+	At    time.Time
+	Count int
+}) chan struct {
+	At    time.Time
+	Count int
+} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceBus; create it with NewMockSourceBus")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ticks", done)
+	ret0, _ := ret[0].(chan struct {
+		At    time.Time
+		Count int
+	})
+	return ret0
+}
+
+// Ticks indicates an expected call of Ticks.
+func (mr *MockSourceBusMockRecorder) Ticks(done any) *MockSourceBusTicksCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ticks", reflect.TypeOf((*MockSourceBus)(nil).Ticks), done)
+	return &MockSourceBusTicksCall{Call: call}
+}
+
+// MockSourceBusTicksCall wrap *gomock.Call
+type MockSourceBusTicksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceBusTicksCall) Return(arg0 chan struct {
+	At    time.Time
+	Count int
+}) *MockSourceBusTicksCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceBusTicksCall) Do(f func(chan struct {
+	At    time.Time
+	Count int
+}) chan struct {
+	At    time.Time
+	Count int
+}) *MockSourceBusTicksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceBusTicksCall) DoAndReturn(f func(chan struct {
+	At    time.Time
+	Count int
+}) chan struct {
+	At    time.Time
+	Count int
+}) *MockSourceBusTicksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceBusTicksCall) ReturnsInOrder(rets ...chan struct {
+	At    time.Time
+	Count int
+}) *MockSourceBusTicksCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
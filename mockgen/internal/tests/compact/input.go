@@ -0,0 +1,18 @@
+// Package compact has an interface with many methods of the same signature,
+// whose mock -compact makes smaller.
+package compact
+
+//go:generate mockgen -package compact -destination mock.go -source input.go -compact
+
+type Store interface {
+	GetUser(id string) (string, error)
+	GetGroup(id string) (string, error)
+	GetRole(id string) (string, error)
+	Count() int
+	Len() int
+	Delete(id string) error
+	Close()
+	Tags(ids ...string) map[string]func(int, string) error
+	Labels(prefix string, ids ...string) map[string]func(int, string) error
+	checkMock() bool
+}
@@ -0,0 +1,79 @@
+package compact
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+// fatalReporter records the first fatal error instead of failing the test.
+type fatalReporter struct {
+	fatal string
+}
+
+func (r *fatalReporter) Errorf(format string, args ...any) {}
+
+func (r *fatalReporter) Fatalf(format string, args ...any) {
+	if r.fatal == "" {
+		r.fatal = fmt.Sprintf(format, args...)
+	}
+	panic(r)
+}
+
+func TestCompactMock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errNotFound := errors.New("not found")
+
+	m := NewMockStore(ctrl)
+	m.EXPECT().GetUser("u").Return("alice", nil)
+	m.EXPECT().GetGroup("g").Return("", errNotFound)
+	m.EXPECT().Count().Return(2)
+	m.EXPECT().Len().Return(3)
+	m.EXPECT().Tags("a", "b").Return(nil)
+	m.EXPECT().Close()
+
+	if got, err := m.GetUser("u"); got != "alice" || err != nil {
+		t.Errorf("GetUser(u) = %q, %v, want alice, nil", got, err)
+	}
+	if got, err := m.GetGroup("g"); got != "" || err != errNotFound {
+		t.Errorf("GetGroup(g) = %q, %v, want \"\", %v", got, err, errNotFound)
+	}
+	if got := m.Count(); got != 2 {
+		t.Errorf("Count() = %d, want 2", got)
+	}
+	if got := m.Len(); got != 3 {
+		t.Errorf("Len() = %d, want 3", got)
+	}
+	if got := m.Tags("a", "b"); got != nil {
+		t.Errorf("Tags(a, b) = %v, want nil", got)
+	}
+	m.Close()
+}
+
+func TestCompactMockReportsCaller(t *testing.T) {
+	reporter := &fatalReporter{}
+	m := NewMockStore(gomock.NewController(reporter))
+	func() {
+		defer func() { _ = recover() }()
+		m.GetRole("r")
+	}()
+	// The shared helpers must not add a frame between the mock method and
+	// the controller, which reports the caller of the mock method.
+	if !strings.Contains(reporter.fatal, "input_test.go:") {
+		t.Errorf("unexpected call reported %q, want it at input_test.go", reporter.fatal)
+	}
+}
+
+func TestCompactNilMock(t *testing.T) {
+	defer func() {
+		want := "gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore"
+		if r := recover(); r != want {
+			t.Errorf("calling a nil mock panicked with %v, want %q", r, want)
+		}
+	}()
+	var m *MockStore
+	m.GetUser("u")
+}
@@ -0,0 +1,214 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package compact -destination mock.go -source input.go -compact
+//
+
+// Package compact is a generated GoMock package.
+package compact
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	m.checkMock_()
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// checkMock_ panics if the mock is nil or was not created with NewMockStore.
+func (m *MockStore) checkMock_() {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+}
+
+// results0 asserts the types of the results of a call returning string, error.
+func (m *MockStore) results0(ret []any) (string, error) {
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// results1 asserts the types of the results of a call returning int.
+func (m *MockStore) results1(ret []any) int {
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// results2 asserts the types of the results of a call returning map[string]func(int, string) error.
+func (m *MockStore) results2(ret []any) map[string]func(int, string) error {
+	ret0, _ := ret[0].(map[string]func(int, string) error)
+	return ret0
+}
+
+// Close mocks base method.
+func (m *MockStore) Close() {
+	m.checkMock_()
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockStoreMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStore)(nil).Close))
+}
+
+// Count mocks base method.
+func (m *MockStore) Count() int {
+	m.checkMock_()
+	m.ctrl.T.Helper()
+	return m.results1(m.ctrl.Call(m, "Count"))
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockStoreMockRecorder) Count() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockStore)(nil).Count))
+}
+
+// Delete mocks base method.
+func (m *MockStore) Delete(id string) error {
+	m.checkMock_()
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStoreMockRecorder) Delete(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStore)(nil).Delete), id)
+}
+
+// GetGroup mocks base method.
+func (m *MockStore) GetGroup(id string) (string, error) {
+	m.checkMock_()
+	m.ctrl.T.Helper()
+	return m.results0(m.ctrl.Call(m, "GetGroup", id))
+}
+
+// GetGroup indicates an expected call of GetGroup.
+func (mr *MockStoreMockRecorder) GetGroup(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockStore)(nil).GetGroup), id)
+}
+
+// GetRole mocks base method.
+func (m *MockStore) GetRole(id string) (string, error) {
+	m.checkMock_()
+	m.ctrl.T.Helper()
+	return m.results0(m.ctrl.Call(m, "GetRole", id))
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockStoreMockRecorder) GetRole(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockStore)(nil).GetRole), id)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(id string) (string, error) {
+	m.checkMock_()
+	m.ctrl.T.Helper()
+	return m.results0(m.ctrl.Call(m, "GetUser", id))
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), id)
+}
+
+// Labels mocks base method.
+func (m *MockStore) Labels(prefix string, ids ...string) map[string]func(int, string) error {
+	m.checkMock_()
+	m.ctrl.T.Helper()
+	varargs := []any{prefix}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	return m.results2(m.ctrl.Call(m, "Labels", varargs...))
+}
+
+// Labels indicates an expected call of Labels.
+func (mr *MockStoreMockRecorder) Labels(prefix any, ids ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{prefix}, ids...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Labels", reflect.TypeOf((*MockStore)(nil).Labels), varargs...)
+}
+
+// Len mocks base method.
+func (m *MockStore) Len() int {
+	m.checkMock_()
+	m.ctrl.T.Helper()
+	return m.results1(m.ctrl.Call(m, "Len"))
+}
+
+// Len indicates an expected call of Len.
+func (mr *MockStoreMockRecorder) Len() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Len", reflect.TypeOf((*MockStore)(nil).Len))
+}
+
+// Tags mocks base method.
+func (m *MockStore) Tags(ids ...string) map[string]func(int, string) error {
+	m.checkMock_()
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	return m.results2(m.ctrl.Call(m, "Tags", varargs...))
+}
+
+// Tags indicates an expected call of Tags.
+func (mr *MockStoreMockRecorder) Tags(ids ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tags", reflect.TypeOf((*MockStore)(nil).Tags), ids...)
+}
+
+// checkMock mocks base method.
+func (m *MockStore) checkMock() bool {
+	m.checkMock_()
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "checkMock")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// checkMock indicates an expected call of checkMock.
+func (mr *MockStoreMockRecorder) checkMock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "checkMock", reflect.TypeOf((*MockStore)(nil).checkMock))
+}
@@ -0,0 +1,4 @@
+package const_length
+
+// DigestSize is declared outside of the source file of the mocks.
+const DigestSize = 16
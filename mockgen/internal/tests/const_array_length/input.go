@@ -1,8 +1,13 @@
 package const_length
 
-import "math"
+import (
+	"crypto/sha256"
+	"math"
+)
 
 //go:generate mockgen -package const_length -destination mock.go -source input.go
+//go:generate mockgen -destination mock/mock.go -source input.go
+//go:generate mockgen -destination mock_reflect/mock.go . I,Hasher
 
 const C = 2
 
@@ -14,3 +19,22 @@ type I interface {
 	Quux() [(1 + 2)]int
 	Corge() [math.MaxInt8 - 120]int
 }
+
+const Size = 8
+
+const (
+	Small = iota + 2
+	Large
+)
+
+const blockSize = 4
+
+// Hasher has array parameters and results whose lengths are constants.
+type Hasher interface {
+	Write(p [Size]byte) error
+	Sum() [sha256.Size]byte
+	Large() [Large]int
+	Digest() [DigestSize]byte
+	Block(b [blockSize]byte)
+	Double() [2 * Size]byte
+}
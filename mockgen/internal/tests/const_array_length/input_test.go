@@ -0,0 +1,32 @@
+package const_length_test
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	const_length "go.uber.org/mock/mockgen/internal/tests/const_array_length"
+	"go.uber.org/mock/mockgen/internal/tests/const_array_length/mock"
+	mock_reflect "go.uber.org/mock/mockgen/internal/tests/const_array_length/mock_reflect"
+)
+
+var (
+	_ const_length.Hasher = (*const_length.MockHasher)(nil)
+	_ const_length.Hasher = (*mock_const_length.MockHasher)(nil)
+	_ const_length.Hasher = (*mock_reflect.MockHasher)(nil)
+	_ const_length.I      = (*mock_reflect.MockI)(nil)
+)
+
+func TestConstArrayLength(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	m := mock_const_length.NewMockHasher(ctrl)
+	m.EXPECT().Write([const_length.Size]byte{1}).Return(nil)
+	m.EXPECT().Large().Return([const_length.Large]int{1, 2, 3})
+
+	if err := m.Write([const_length.Size]byte{1}); err != nil {
+		t.Errorf("Write() = %v, want nil", err)
+	}
+	if got := m.Large(); got != [3]int{1, 2, 3} {
+		t.Errorf("Large() = %v, want [1 2 3]", got)
+	}
+}
@@ -0,0 +1,283 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock.go -source input.go
+//
+
+// Package mock_const_length is a generated GoMock package.
+package mock_const_length
+
+import (
+	sha256 "crypto/sha256"
+	math "math"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	const_length "go.uber.org/mock/mockgen/internal/tests/const_array_length"
+)
+
+// MockI is a mock of I interface.
+type MockI struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMockRecorder
+}
+
+// MockIMockRecorder is the mock recorder for MockI.
+type MockIMockRecorder struct {
+	mock *MockI
+}
+
+// NewMockI creates a new mock instance.
+func NewMockI(ctrl *gomock.Controller) *MockI {
+	mock := &MockI{ctrl: ctrl}
+	mock.recorder = &MockIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockI) EXPECT() *MockIMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockI; create it with NewMockI")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockI) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Bar mocks base method.
+func (m *MockI) Bar() [2]int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockI; create it with NewMockI")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bar")
+	ret0, _ := ret[0].([2]int)
+	return ret0
+}
+
+// Bar indicates an expected call of Bar.
+func (mr *MockIMockRecorder) Bar() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bar", reflect.TypeOf((*MockI)(nil).Bar))
+}
+
+// Baz mocks base method.
+func (m *MockI) Baz() [math.MaxInt8]int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockI; create it with NewMockI")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Baz")
+	ret0, _ := ret[0].([math.MaxInt8]int)
+	return ret0
+}
+
+// Baz indicates an expected call of Baz.
+func (mr *MockIMockRecorder) Baz() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Baz", reflect.TypeOf((*MockI)(nil).Baz))
+}
+
+// Corge mocks base method.
+func (m *MockI) Corge() [7]int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockI; create it with NewMockI")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Corge")
+	ret0, _ := ret[0].([7]int)
+	return ret0
+}
+
+// Corge indicates an expected call of Corge.
+func (mr *MockIMockRecorder) Corge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Corge", reflect.TypeOf((*MockI)(nil).Corge))
+}
+
+// Foo mocks base method.
+func (m *MockI) Foo() [const_length.C]int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockI; create it with NewMockI")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Foo")
+	ret0, _ := ret[0].([const_length.C]int)
+	return ret0
+}
+
+// Foo indicates an expected call of Foo.
+func (mr *MockIMockRecorder) Foo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Foo", reflect.TypeOf((*MockI)(nil).Foo))
+}
+
+// Quux mocks base method.
+func (m *MockI) Quux() [3]int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockI; create it with NewMockI")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quux")
+	ret0, _ := ret[0].([3]int)
+	return ret0
+}
+
+// Quux indicates an expected call of Quux.
+func (mr *MockIMockRecorder) Quux() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quux", reflect.TypeOf((*MockI)(nil).Quux))
+}
+
+// Qux mocks base method.
+func (m *MockI) Qux() [3]int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockI; create it with NewMockI")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Qux")
+	ret0, _ := ret[0].([3]int)
+	return ret0
+}
+
+// Qux indicates an expected call of Qux.
+func (mr *MockIMockRecorder) Qux() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Qux", reflect.TypeOf((*MockI)(nil).Qux))
+}
+
+// MockHasher is a mock of Hasher interface.
+type MockHasher struct {
+	ctrl     *gomock.Controller
+	recorder *MockHasherMockRecorder
+}
+
+// MockHasherMockRecorder is the mock recorder for MockHasher.
+type MockHasherMockRecorder struct {
+	mock *MockHasher
+}
+
+// NewMockHasher creates a new mock instance.
+func NewMockHasher(ctrl *gomock.Controller) *MockHasher {
+	mock := &MockHasher{ctrl: ctrl}
+	mock.recorder = &MockHasherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHasher) EXPECT() *MockHasherMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHasher; create it with NewMockHasher")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockHasher) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Block mocks base method.
+func (m *MockHasher) Block(b [4]byte) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHasher; create it with NewMockHasher")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Block", b)
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockHasherMockRecorder) Block(b any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockHasher)(nil).Block), b)
+}
+
+// Digest mocks base method.
+func (m *MockHasher) Digest() [const_length.DigestSize]byte {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHasher; create it with NewMockHasher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Digest")
+	ret0, _ := ret[0].([const_length.DigestSize]byte)
+	return ret0
+}
+
+// Digest indicates an expected call of Digest.
+func (mr *MockHasherMockRecorder) Digest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Digest", reflect.TypeOf((*MockHasher)(nil).Digest))
+}
+
+// Double mocks base method.
+func (m *MockHasher) Double() [16]byte {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHasher; create it with NewMockHasher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Double")
+	ret0, _ := ret[0].([16]byte)
+	return ret0
+}
+
+// Double indicates an expected call of Double.
+func (mr *MockHasherMockRecorder) Double() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Double", reflect.TypeOf((*MockHasher)(nil).Double))
+}
+
+// Large mocks base method.
+func (m *MockHasher) Large() [const_length.Large]int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHasher; create it with NewMockHasher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Large")
+	ret0, _ := ret[0].([const_length.Large]int)
+	return ret0
+}
+
+// Large indicates an expected call of Large.
+func (mr *MockHasherMockRecorder) Large() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Large", reflect.TypeOf((*MockHasher)(nil).Large))
+}
+
+// Sum mocks base method.
+func (m *MockHasher) Sum() [sha256.Size]byte {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHasher; create it with NewMockHasher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sum")
+	ret0, _ := ret[0].([sha256.Size]byte)
+	return ret0
+}
+
+// Sum indicates an expected call of Sum.
+func (mr *MockHasherMockRecorder) Sum() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sum", reflect.TypeOf((*MockHasher)(nil).Sum))
+}
+
+// Write mocks base method.
+func (m *MockHasher) Write(p [const_length.Size]byte) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHasher; create it with NewMockHasher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockHasherMockRecorder) Write(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockHasher)(nil).Write), p)
+}
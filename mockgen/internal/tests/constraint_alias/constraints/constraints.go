@@ -0,0 +1,15 @@
+// Package constraints has type constraints for the constraint_alias fixture.
+package constraints
+
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+type Float interface {
+	~float32 | ~float64
+}
+
+// Real is an alias of the union of Integer and Float.
+type Real = interface {
+	Integer | Float
+}
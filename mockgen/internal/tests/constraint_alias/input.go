@@ -0,0 +1,30 @@
+// Package constraint_alias has generic interfaces constrained by aliases of
+// union constraints.
+package constraint_alias
+
+//go:generate mockgen -package constraint_alias -destination mock.go -source input.go
+//go:generate mockgen -destination mock_constraint_alias/mock.go -source input.go
+
+import "go.uber.org/mock/mockgen/internal/tests/constraint_alias/constraints"
+
+// Number is an alias of a union of constraints of another package.
+type Number = interface {
+	constraints.Integer | constraints.Float
+}
+
+// Ordered is a named constraint embedding the Number alias.
+type Ordered interface {
+	Number | ~string
+}
+
+type Summer[T Number] interface {
+	Sum(values ...T) T
+}
+
+type Sorter[K Ordered, V interface{ Number | ~bool }] interface {
+	Sort(m map[K]V) []K
+}
+
+type Averager[T constraints.Real, U constraints.Integer | constraints.Float] interface {
+	Average(values []T) U
+}
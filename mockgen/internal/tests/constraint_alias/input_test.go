@@ -0,0 +1,31 @@
+package constraint_alias
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+type celsius float64
+
+func TestConstraintAlias(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	summer := NewMockSummer[celsius](ctrl)
+	summer.EXPECT().Sum(celsius(1), celsius(2)).Return(celsius(3))
+	if got := summer.Sum(1, 2); got != 3 {
+		t.Errorf("Sum = %v, want 3", got)
+	}
+
+	sorter := NewMockSorter[string, bool](ctrl)
+	sorter.EXPECT().Sort(map[string]bool{"a": true}).Return([]string{"a"})
+	if got := sorter.Sort(map[string]bool{"a": true}); len(got) != 1 {
+		t.Errorf("Sort = %v, want [a]", got)
+	}
+
+	averager := NewMockAverager[int8, float32](ctrl)
+	averager.EXPECT().Average([]int8{1, 2}).Return(float32(1.5))
+	if got := averager.Average([]int8{1, 2}); got != 1.5 {
+		t.Errorf("Average = %v, want 1.5", got)
+	}
+}
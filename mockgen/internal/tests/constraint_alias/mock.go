@@ -0,0 +1,165 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package constraint_alias -destination mock.go -source input.go
+//
+
+// Package constraint_alias is a generated GoMock package.
+package constraint_alias
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	constraints "go.uber.org/mock/mockgen/internal/tests/constraint_alias/constraints"
+)
+
+// MockSummer is a mock of Summer interface.
+type MockSummer[T Number] struct {
+	ctrl     *gomock.Controller
+	recorder *MockSummerMockRecorder[T]
+}
+
+// MockSummerMockRecorder is the mock recorder for MockSummer.
+type MockSummerMockRecorder[T Number] struct {
+	mock *MockSummer[T]
+}
+
+// NewMockSummer creates a new mock instance.
+func NewMockSummer[T Number](ctrl *gomock.Controller) *MockSummer[T] {
+	mock := &MockSummer[T]{ctrl: ctrl}
+	mock.recorder = &MockSummerMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSummer[T]) EXPECT() *MockSummerMockRecorder[T] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSummer; create it with NewMockSummer")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSummer[T]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Sum mocks base method.
+func (m *MockSummer[T]) Sum(values ...T) T {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSummer; create it with NewMockSummer")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range values {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Sum", varargs...)
+	ret0, _ := ret[0].(T)
+	return ret0
+}
+
+// Sum indicates an expected call of Sum.
+func (mr *MockSummerMockRecorder[T]) Sum(values ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sum", reflect.TypeOf((*MockSummer[T])(nil).Sum), values...)
+}
+
+// MockSorter is a mock of Sorter interface.
+type MockSorter[K Ordered, V interface{ Number | ~bool }] struct {
+	ctrl     *gomock.Controller
+	recorder *MockSorterMockRecorder[K, V]
+}
+
+// MockSorterMockRecorder is the mock recorder for MockSorter.
+type MockSorterMockRecorder[K Ordered, V interface{ Number | ~bool }] struct {
+	mock *MockSorter[K, V]
+}
+
+// NewMockSorter creates a new mock instance.
+func NewMockSorter[K Ordered, V interface{ Number | ~bool }](ctrl *gomock.Controller) *MockSorter[K, V] {
+	mock := &MockSorter[K, V]{ctrl: ctrl}
+	mock.recorder = &MockSorterMockRecorder[K, V]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSorter[K, V]) EXPECT() *MockSorterMockRecorder[K, V] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSorter; create it with NewMockSorter")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSorter[K, V]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Sort mocks base method.
+func (m_2 *MockSorter[K, V]) Sort(m map[K]V) []K {
+	if m_2 == nil || m_2.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSorter; create it with NewMockSorter")
+	}
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Sort", m)
+	ret0, _ := ret[0].([]K)
+	return ret0
+}
+
+// Sort indicates an expected call of Sort.
+func (mr *MockSorterMockRecorder[K, V]) Sort(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sort", reflect.TypeOf((*MockSorter[K, V])(nil).Sort), m)
+}
+
+// MockAverager is a mock of Averager interface.
+type MockAverager[T constraints.Real, U constraints.Integer | constraints.Float] struct {
+	ctrl     *gomock.Controller
+	recorder *MockAveragerMockRecorder[T, U]
+}
+
+// MockAveragerMockRecorder is the mock recorder for MockAverager.
+type MockAveragerMockRecorder[T constraints.Real, U constraints.Integer | constraints.Float] struct {
+	mock *MockAverager[T, U]
+}
+
+// NewMockAverager creates a new mock instance.
+func NewMockAverager[T constraints.Real, U constraints.Integer | constraints.Float](ctrl *gomock.Controller) *MockAverager[T, U] {
+	mock := &MockAverager[T, U]{ctrl: ctrl}
+	mock.recorder = &MockAveragerMockRecorder[T, U]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAverager[T, U]) EXPECT() *MockAveragerMockRecorder[T, U] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockAverager; create it with NewMockAverager")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockAverager[T, U]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Average mocks base method.
+func (m *MockAverager[T, U]) Average(values []T) U {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockAverager; create it with NewMockAverager")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Average", values)
+	ret0, _ := ret[0].(U)
+	return ret0
+}
+
+// Average indicates an expected call of Average.
+func (mr *MockAveragerMockRecorder[T, U]) Average(values any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Average", reflect.TypeOf((*MockAverager[T, U])(nil).Average), values)
+}
@@ -40,5 +40,13 @@ func NewMockEmpty(ctrl *gomock.Controller) *MockEmpty {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockEmpty) EXPECT() *MockEmptyMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockEmpty; create it with NewMockEmpty")
+	}
 	return m.recorder
 }
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockEmpty) ISGOMOCK() struct{} {
+	return struct{}{}
+}
@@ -36,11 +36,22 @@ func NewMockInputMaker(ctrl *gomock.Controller) *MockInputMaker {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockInputMaker) EXPECT() *MockInputMakerMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockInputMaker; create it with NewMockInputMaker")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockInputMaker) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // MakeInput mocks base method.
 func (m *MockInputMaker) MakeInput() client.GreetInput {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockInputMaker; create it with NewMockInputMaker")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "MakeInput")
 	ret0, _ := ret[0].(client.GreetInput)
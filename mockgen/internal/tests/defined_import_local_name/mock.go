@@ -37,11 +37,22 @@ func NewMockWithImports(ctrl *gomock.Controller) *MockWithImports {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockWithImports) EXPECT() *MockWithImportsMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockWithImports; create it with NewMockWithImports")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockWithImports) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Method1 mocks base method.
 func (m *MockWithImports) Method1() b_mock.Buffer {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockWithImports; create it with NewMockWithImports")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Method1")
 	ret0, _ := ret[0].(b_mock.Buffer)
@@ -56,6 +67,9 @@ func (mr *MockWithImportsMockRecorder) Method1() *gomock.Call {
 
 // Method2 mocks base method.
 func (m *MockWithImports) Method2() c_mock.Context {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockWithImports; create it with NewMockWithImports")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Method2")
 	ret0, _ := ret[0].(c_mock.Context)
@@ -0,0 +1,19 @@
+package doc_links
+
+//go:generate mockgen -package doc_links -destination mock.go -source input.go -doc_links
+//go:generate mockgen -package mock_doc_links -destination mock/mock.go -source input.go -doc_links
+//go:generate mockgen -destination mock_stub/stub.go -source input.go -stub -doc_links
+
+// Key identifies a value of a Store.
+type Key string
+
+// Store is mocked with -doc_links, so the mocks link to it.
+type Store interface {
+	Get(k Key) (string, bool)
+	Put(k Key, v string)
+}
+
+// Pinger does not use any type of this package.
+type Pinger interface {
+	Ping() error
+}
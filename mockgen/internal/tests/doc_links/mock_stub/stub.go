@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_stub/stub.go -source input.go -stub -doc_links
+//
+
+// Package mock_doc_links is a generated GoMock package.
+package mock_doc_links
+
+import (
+	doc_links "go.uber.org/mock/mockgen/internal/tests/doc_links"
+)
+
+// StubStore is a stub of [doc_links.Store] interface.
+type StubStore struct {
+	GetFunc func(doc_links.Key) (string, bool)
+	PutFunc func(doc_links.Key, string)
+}
+
+// Get calls GetFunc if it is set and otherwise returns zero values.
+func (s *StubStore) Get(k doc_links.Key) (string, bool) {
+	if s.GetFunc != nil {
+		return s.GetFunc(k)
+	}
+	var ret0 string
+	var ret1 bool
+	return ret0, ret1
+}
+
+// Put calls PutFunc if it is set.
+func (s *StubStore) Put(k doc_links.Key, v string) {
+	if s.PutFunc != nil {
+		s.PutFunc(k, v)
+		return
+	}
+}
+
+// StubPinger is a stub of [doc_links.Pinger] interface.
+type StubPinger struct {
+	PingFunc func() error
+}
+
+// Ping calls PingFunc if it is set and otherwise returns zero values.
+func (s *StubPinger) Ping() error {
+	if s.PingFunc != nil {
+		return s.PingFunc()
+	}
+	var ret0 error
+	return ret0
+}
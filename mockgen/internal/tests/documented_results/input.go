@@ -0,0 +1,22 @@
+// Package documented_results has an interface whose documented methods have
+// named and blank results.
+package documented_results
+
+//go:generate mockgen -package documented_results -destination mock.go -source input.go
+//go:generate mockgen -package documented_results -destination typed_mock.go -source input.go -typed -return_zero -mock_names Reader=MockTypedReader
+
+type Reader interface {
+	// Read reads into p, returning the number of bytes read.
+	Read(p []byte) (n int, err error)
+
+	// Peek returns the next n bytes, of which the first is blank.
+	Peek(n int) (_ byte, rest []byte, _ error)
+
+	// Skip skips n bytes. Its results are all blank.
+	Skip(n int) (_, _ int)
+
+	/*
+		Reset resets the reader, with a block comment.
+	*/
+	Reset() (ok bool)
+}
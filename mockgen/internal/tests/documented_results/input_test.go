@@ -0,0 +1,41 @@
+package documented_results
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDocumentedResults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errShort := errors.New("short")
+
+	m := NewMockReader(ctrl)
+	m.EXPECT().Peek(2).Return(byte('a'), []byte("b"), errShort)
+	m.EXPECT().Skip(1).Return(1, 2)
+	if b, rest, err := m.Peek(2); b != 'a' || string(rest) != "b" || err != errShort {
+		t.Errorf("Peek = %q, %q, %v, want 'a', \"b\", %v", b, rest, err, errShort)
+	}
+	if n, m := m.Skip(1); n != 1 || m != 2 {
+		t.Errorf("Skip = %d, %d, want 1, 2", n, m)
+	}
+
+	typed := NewMockTypedReader(ctrl)
+	typed.EXPECT().Read(gomock.Len(3)).Return(3, nil)
+	typed.EXPECT().Peek(1).ReturnZero()
+	typed.EXPECT().Skip(4).DoAndReturn(func(n int) (int, int) { return n, 0 })
+	typed.EXPECT().Reset().Return(true)
+	if n, err := typed.Read(make([]byte, 3)); n != 3 || err != nil {
+		t.Errorf("Read = %d, %v, want 3, nil", n, err)
+	}
+	if b, rest, err := typed.Peek(1); b != 0 || rest != nil || err != nil {
+		t.Errorf("Peek = %q, %q, %v, want zero values", b, rest, err)
+	}
+	if n, _ := typed.Skip(4); n != 4 {
+		t.Errorf("Skip = %d, want 4", n)
+	}
+	if !typed.Reset() {
+		t.Error("Reset = false, want true")
+	}
+}
@@ -0,0 +1,283 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package documented_results -destination typed_mock.go -source input.go -typed -return_zero -mock_names Reader=MockTypedReader
+//
+
+// Package documented_results is a generated GoMock package.
+package documented_results
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTypedReader is a mock of Reader interface.
+type MockTypedReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockTypedReaderMockRecorder
+}
+
+// MockTypedReaderMockRecorder is the mock recorder for MockTypedReader.
+type MockTypedReaderMockRecorder struct {
+	mock *MockTypedReader
+}
+
+// NewMockTypedReader creates a new mock instance.
+func NewMockTypedReader(ctrl *gomock.Controller) *MockTypedReader {
+	mock := &MockTypedReader{ctrl: ctrl}
+	mock.recorder = &MockTypedReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTypedReader) EXPECT() *MockTypedReaderMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedReader; create it with NewMockTypedReader")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockTypedReader) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Peek mocks base method.
+func (m *MockTypedReader) Peek(n int) (byte, []byte, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedReader; create it with NewMockTypedReader")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Peek", n)
+	ret0, _ := ret[0].(byte)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Peek indicates an expected call of Peek.
+func (mr *MockTypedReaderMockRecorder) Peek(n any) *MockTypedReaderPeekCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Peek", reflect.TypeOf((*MockTypedReader)(nil).Peek), n)
+	return &MockTypedReaderPeekCall{Call: call}
+}
+
+// MockTypedReaderPeekCall wrap *gomock.Call
+type MockTypedReaderPeekCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedReaderPeekCall) Return(arg0 byte, rest []byte, arg2 error) *MockTypedReaderPeekCall {
+	c.Call = c.Call.Return(arg0, rest, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedReaderPeekCall) Do(f func(int) (byte, []byte, error)) *MockTypedReaderPeekCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedReaderPeekCall) DoAndReturn(f func(int) (byte, []byte, error)) *MockTypedReaderPeekCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnZero rewrite *gomock.Call.Return with the zero values of the results
+func (c *MockTypedReaderPeekCall) ReturnZero() *MockTypedReaderPeekCall {
+	var (
+		arg0 byte
+		rest []byte
+		arg2 error
+	)
+	c.Call = c.Call.Return(arg0, rest, arg2)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedReaderPeekCall) ReturnsInOrder(rets ...[]any) *MockTypedReaderPeekCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// Read mocks base method.
+func (m *MockTypedReader) Read(p []byte) (int, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedReader; create it with NewMockTypedReader")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", p)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockTypedReaderMockRecorder) Read(p any) *MockTypedReaderReadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockTypedReader)(nil).Read), p)
+	return &MockTypedReaderReadCall{Call: call}
+}
+
+// MockTypedReaderReadCall wrap *gomock.Call
+type MockTypedReaderReadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedReaderReadCall) Return(n int, err error) *MockTypedReaderReadCall {
+	c.Call = c.Call.Return(n, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedReaderReadCall) Do(f func([]byte) (int, error)) *MockTypedReaderReadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedReaderReadCall) DoAndReturn(f func([]byte) (int, error)) *MockTypedReaderReadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnZero rewrite *gomock.Call.Return with the zero values of the results
+func (c *MockTypedReaderReadCall) ReturnZero() *MockTypedReaderReadCall {
+	var (
+		n   int
+		err error
+	)
+	c.Call = c.Call.Return(n, err)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedReaderReadCall) ReturnsInOrder(rets ...[]any) *MockTypedReaderReadCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// Reset mocks base method.
+func (m *MockTypedReader) Reset() bool {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedReader; create it with NewMockTypedReader")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockTypedReaderMockRecorder) Reset() *MockTypedReaderResetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockTypedReader)(nil).Reset))
+	return &MockTypedReaderResetCall{Call: call}
+}
+
+// MockTypedReaderResetCall wrap *gomock.Call
+type MockTypedReaderResetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedReaderResetCall) Return(ok bool) *MockTypedReaderResetCall {
+	c.Call = c.Call.Return(ok)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedReaderResetCall) Do(f func() bool) *MockTypedReaderResetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedReaderResetCall) DoAndReturn(f func() bool) *MockTypedReaderResetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnZero rewrite *gomock.Call.Return with the zero values of the results
+func (c *MockTypedReaderResetCall) ReturnZero() *MockTypedReaderResetCall {
+	var (
+		ok bool
+	)
+	c.Call = c.Call.Return(ok)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedReaderResetCall) ReturnsInOrder(rets ...bool) *MockTypedReaderResetCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Skip mocks base method.
+func (m *MockTypedReader) Skip(n int) (int, int) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedReader; create it with NewMockTypedReader")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Skip", n)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	return ret0, ret1
+}
+
+// Skip indicates an expected call of Skip.
+func (mr *MockTypedReaderMockRecorder) Skip(n any) *MockTypedReaderSkipCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Skip", reflect.TypeOf((*MockTypedReader)(nil).Skip), n)
+	return &MockTypedReaderSkipCall{Call: call}
+}
+
+// MockTypedReaderSkipCall wrap *gomock.Call
+type MockTypedReaderSkipCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedReaderSkipCall) Return(arg0, arg1 int) *MockTypedReaderSkipCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedReaderSkipCall) Do(f func(int) (int, int)) *MockTypedReaderSkipCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedReaderSkipCall) DoAndReturn(f func(int) (int, int)) *MockTypedReaderSkipCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnZero rewrite *gomock.Call.Return with the zero values of the results
+func (c *MockTypedReaderSkipCall) ReturnZero() *MockTypedReaderSkipCall {
+	var (
+		arg0 int
+		arg1 int
+	)
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedReaderSkipCall) ReturnsInOrder(rets ...[]any) *MockTypedReaderSkipCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
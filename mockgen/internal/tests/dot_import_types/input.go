@@ -0,0 +1,12 @@
+package dot_import_types
+
+//go:generate mockgen -destination mock/mock.go -source input.go
+
+import . "time"
+
+// Sleeper refers to dot-imported types, which the mock in another package
+// qualifies.
+type Sleeper interface {
+	Sleep(d Duration)
+	Until(t Time) Duration
+}
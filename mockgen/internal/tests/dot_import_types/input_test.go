@@ -0,0 +1,23 @@
+package dot_import_types
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+	mock_dot_import_types "go.uber.org/mock/mockgen/internal/tests/dot_import_types/mock"
+)
+
+var _ Sleeper = (*mock_dot_import_types.MockSleeper)(nil)
+
+func TestDotImportedTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := mock_dot_import_types.NewMockSleeper(ctrl)
+
+	m.EXPECT().Sleep(time.Second)
+	m.EXPECT().Until(gomock.Any()).Return(time.Minute)
+	m.Sleep(time.Second)
+	if got := m.Until(time.Now()); got != time.Minute {
+		t.Errorf("Until() = %v, want %v", got, time.Minute)
+	}
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock.go -source input.go
+//
+
+// Package mock_dot_import_types is a generated GoMock package.
+package mock_dot_import_types
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSleeper is a mock of Sleeper interface.
+type MockSleeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSleeperMockRecorder
+}
+
+// MockSleeperMockRecorder is the mock recorder for MockSleeper.
+type MockSleeperMockRecorder struct {
+	mock *MockSleeper
+}
+
+// NewMockSleeper creates a new mock instance.
+func NewMockSleeper(ctrl *gomock.Controller) *MockSleeper {
+	mock := &MockSleeper{ctrl: ctrl}
+	mock.recorder = &MockSleeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSleeper) EXPECT() *MockSleeperMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSleeper; create it with NewMockSleeper")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSleeper) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Sleep mocks base method.
+func (m *MockSleeper) Sleep(d time.Duration) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSleeper; create it with NewMockSleeper")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Sleep", d)
+}
+
+// Sleep indicates an expected call of Sleep.
+func (mr *MockSleeperMockRecorder) Sleep(d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sleep", reflect.TypeOf((*MockSleeper)(nil).Sleep), d)
+}
+
+// Until mocks base method.
+func (m *MockSleeper) Until(t time.Time) time.Duration {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSleeper; create it with NewMockSleeper")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Until", t)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// Until indicates an expected call of Until.
+func (mr *MockSleeperMockRecorder) Until(t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Until", reflect.TypeOf((*MockSleeper)(nil).Until), t)
+}
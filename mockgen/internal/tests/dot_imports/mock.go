@@ -11,8 +11,8 @@ package dot_imports
 
 import (
 	bytes "bytes"
-	. "context"
-	. "net/http"
+	context "context"
+	http "net/http"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -38,14 +38,25 @@ func NewMockWithDotImports(ctrl *gomock.Controller) *MockWithDotImports {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockWithDotImports) EXPECT() *MockWithDotImportsMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockWithDotImports; create it with NewMockWithDotImports")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockWithDotImports) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Method1 mocks base method.
-func (m *MockWithDotImports) Method1() Request {
+func (m *MockWithDotImports) Method1() http.Request {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockWithDotImports; create it with NewMockWithDotImports")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Method1")
-	ret0, _ := ret[0].(Request)
+	ret0, _ := ret[0].(http.Request)
 	return ret0
 }
 
@@ -57,6 +68,9 @@ func (mr *MockWithDotImportsMockRecorder) Method1() *gomock.Call {
 
 // Method2 mocks base method.
 func (m *MockWithDotImports) Method2() *bytes.Buffer {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockWithDotImports; create it with NewMockWithDotImports")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Method2")
 	ret0, _ := ret[0].(*bytes.Buffer)
@@ -70,10 +84,13 @@ func (mr *MockWithDotImportsMockRecorder) Method2() *gomock.Call {
 }
 
 // Method3 mocks base method.
-func (m *MockWithDotImports) Method3() Context {
+func (m *MockWithDotImports) Method3() context.Context {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockWithDotImports; create it with NewMockWithDotImports")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Method3")
-	ret0, _ := ret[0].(Context)
+	ret0, _ := ret[0].(context.Context)
 	return ret0
 }
 
@@ -0,0 +1,21 @@
+package embedded_duplicates
+
+//go:generate mockgen -package embedded_duplicates -destination mock.go -source input.go
+
+import "io"
+
+type Reader interface {
+	Read(p []byte) (n int, err error)
+	Close() error
+}
+
+type Closer interface {
+	Close() error
+}
+
+// ReadCloser embeds two interfaces which both declare an identical Close.
+type ReadCloser interface {
+	Reader
+	Closer
+	io.Closer
+}
@@ -0,0 +1,19 @@
+package embedded_duplicates
+
+import (
+	"io"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+var _ io.ReadCloser = (*MockReadCloser)(nil)
+
+func TestDeduplicatedClose(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := NewMockReadCloser(ctrl)
+	m.EXPECT().Close().Return(nil)
+	if err := m.Close(); err != nil {
+		t.Errorf("Close() = %v, want nil", err)
+	}
+}
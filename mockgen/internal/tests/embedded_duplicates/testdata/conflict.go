@@ -0,0 +1,17 @@
+package embedded_duplicates
+
+// This file is deliberately invalid Go: Conflicting embeds two interfaces
+// declaring Close with different signatures, which mockgen must reject.
+
+type Closer interface {
+	Close() error
+}
+
+type ForceCloser interface {
+	Close(force bool) error
+}
+
+type Conflicting interface {
+	Closer
+	ForceCloser
+}
@@ -35,11 +35,22 @@ func NewMockGenerateMockForMe(ctrl *gomock.Controller) *MockGenerateMockForMe {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockGenerateMockForMe) EXPECT() *MockGenerateMockForMeMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockGenerateMockForMe; create it with NewMockGenerateMockForMe")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockGenerateMockForMe) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // B mocks base method.
 func (m *MockGenerateMockForMe) B() int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockGenerateMockForMe; create it with NewMockGenerateMockForMe")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "B")
 	ret0, _ := ret[0].(int)
@@ -0,0 +1,11 @@
+package expose_ctrl
+
+//go:generate mockgen -package expose_ctrl -destination mock.go -source input.go -expose_ctrl
+
+type Reader interface {
+	Read(key string) string
+}
+
+type Writer interface {
+	Write(key, value string)
+}
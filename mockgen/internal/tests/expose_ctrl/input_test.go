@@ -0,0 +1,30 @@
+package expose_ctrl
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+// expectCopy only gets the mocks, and orders the expected calls through
+// their controller.
+func expectCopy(r *MockReader, w *MockWriter, key string) {
+	if r.Ctrl() != w.Ctrl() {
+		panic("mocks have different controllers")
+	}
+	gomock.InOrder(
+		r.EXPECT().Read(key).Return("value"),
+		w.EXPECT().Write(key, "value"),
+	)
+}
+
+func TestCtrl(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	r, w := NewMockReader(ctrl), NewMockWriter(ctrl)
+	if r.Ctrl() != ctrl {
+		t.Fatal("Ctrl() did not return the controller of the mock")
+	}
+	expectCopy(r, w, "a")
+	w.Write("a", r.Read("a"))
+}
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package expose_ctrl -destination mock.go -source input.go -expose_ctrl
+//
+
+// Package expose_ctrl is a generated GoMock package.
+package expose_ctrl
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReader is a mock of Reader interface.
+type MockReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockReaderMockRecorder
+}
+
+// MockReaderMockRecorder is the mock recorder for MockReader.
+type MockReaderMockRecorder struct {
+	mock *MockReader
+}
+
+// NewMockReader creates a new mock instance.
+func NewMockReader(ctrl *gomock.Controller) *MockReader {
+	mock := &MockReader{ctrl: ctrl}
+	mock.recorder = &MockReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReader) EXPECT() *MockReaderMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReader; create it with NewMockReader")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockReader) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Ctrl returns the controller of the mock.
+func (m *MockReader) Ctrl() *gomock.Controller {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReader; create it with NewMockReader")
+	}
+	return m.ctrl
+}
+
+// Read mocks base method.
+func (m *MockReader) Read(key string) string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReader; create it with NewMockReader")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", key)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockReaderMockRecorder) Read(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReader)(nil).Read), key)
+}
+
+// MockWriter is a mock of Writer interface.
+type MockWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockWriterMockRecorder
+}
+
+// MockWriterMockRecorder is the mock recorder for MockWriter.
+type MockWriterMockRecorder struct {
+	mock *MockWriter
+}
+
+// NewMockWriter creates a new mock instance.
+func NewMockWriter(ctrl *gomock.Controller) *MockWriter {
+	mock := &MockWriter{ctrl: ctrl}
+	mock.recorder = &MockWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriter) EXPECT() *MockWriterMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockWriter; create it with NewMockWriter")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockWriter) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Ctrl returns the controller of the mock.
+func (m *MockWriter) Ctrl() *gomock.Controller {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockWriter; create it with NewMockWriter")
+	}
+	return m.ctrl
+}
+
+// Write mocks base method.
+func (m *MockWriter) Write(key, value string) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockWriter; create it with NewMockWriter")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Write", key, value)
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockWriterMockRecorder) Write(key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockWriter)(nil).Write), key, value)
+}
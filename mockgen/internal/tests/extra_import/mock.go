@@ -35,11 +35,22 @@ func NewMockFoo(ctrl *gomock.Controller) *MockFoo {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockFoo) EXPECT() *MockFooMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockFoo; create it with NewMockFoo")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockFoo) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Bar mocks base method.
 func (m *MockFoo) Bar(arg0 []string, arg1 chan<- Message) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockFoo; create it with NewMockFoo")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Bar", arg0, arg1)
 }
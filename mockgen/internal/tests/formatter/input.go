@@ -0,0 +1,22 @@
+package formatter
+
+//go:generate mockgen -package formatter -destination mock.go -source input.go -formatter
+
+type Store interface {
+	Get(key string) (string, error)
+	Put(key, value string) error
+}
+
+type Cache[V any] interface {
+	Load(key string) (V, bool)
+}
+
+// Value has a Format method, which its mock mocks.
+type Value interface {
+	Format(f State, verb rune)
+}
+
+// State stands in for fmt.State.
+type State interface {
+	Write(b []byte) (int, error)
+}
@@ -0,0 +1,42 @@
+package formatter
+
+import (
+	"fmt"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestFormat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := NewMockStore(ctrl)
+	store.EXPECT().Get("a").Return("1", nil)
+	store.EXPECT().Put("a", "2").Return(nil).Times(2)
+	store.EXPECT().Get("b").AnyTimes()
+
+	if got, want := fmt.Sprintf("%v", store), "MockStore(expectations: 2 pending)"; got != want {
+		t.Errorf("%%v of the mock = %q, want %q", got, want)
+	}
+	store.Get("a")
+	store.Put("a", "2")
+	if got, want := fmt.Sprintf("%+v", []any{store}), "[MockStore(expectations: 1 pending)]"; got != want {
+		t.Errorf("%%+v of the mock in a slice = %q, want %q", got, want)
+	}
+	store.Put("a", "2")
+	if got, want := fmt.Sprint(store), "MockStore(expectations: 0 pending)"; got != want {
+		t.Errorf("fmt.Sprint of the mock = %q, want %q", got, want)
+	}
+
+	cache := NewMockCache[int](ctrl)
+	if got, want := fmt.Sprintf("%v", cache), "MockCache(expectations: 0 pending)"; got != want {
+		t.Errorf("%%v of the generic mock = %q, want %q", got, want)
+	}
+}
+
+func TestFormatMocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	value := NewMockValue(ctrl)
+	state := NewMockState(ctrl)
+	value.EXPECT().Format(state, 'v')
+	value.Format(state, 'v')
+}
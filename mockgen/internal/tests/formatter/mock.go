@@ -0,0 +1,242 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package formatter -destination mock.go -source input.go -formatter
+//
+
+// Package formatter is a generated GoMock package.
+package formatter
+
+import (
+	fmt "fmt"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Format formats the mock concisely, with its number of pending expectations.
+func (m *MockStore) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, "MockStore(expectations: %d pending)", m.ctrl.PendingCalls(m))
+}
+
+// Get mocks base method.
+func (m *MockStore) Get(key string) (string, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStoreMockRecorder) Get(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), key)
+}
+
+// Put mocks base method.
+func (m *MockStore) Put(key, value string) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockStoreMockRecorder) Put(key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), key, value)
+}
+
+// MockCache is a mock of Cache interface.
+type MockCache[V any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMockRecorder[V]
+}
+
+// MockCacheMockRecorder is the mock recorder for MockCache.
+type MockCacheMockRecorder[V any] struct {
+	mock *MockCache[V]
+}
+
+// NewMockCache creates a new mock instance.
+func NewMockCache[V any](ctrl *gomock.Controller) *MockCache[V] {
+	mock := &MockCache[V]{ctrl: ctrl}
+	mock.recorder = &MockCacheMockRecorder[V]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCache[V]) EXPECT() *MockCacheMockRecorder[V] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockCache[V]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Format formats the mock concisely, with its number of pending expectations.
+func (m *MockCache[V]) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, "MockCache(expectations: %d pending)", m.ctrl.PendingCalls(m))
+}
+
+// Load mocks base method.
+func (m *MockCache[V]) Load(key string) (V, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", key)
+	ret0, _ := ret[0].(V)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockCacheMockRecorder[V]) Load(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockCache[V])(nil).Load), key)
+}
+
+// MockValue is a mock of Value interface.
+type MockValue struct {
+	ctrl     *gomock.Controller
+	recorder *MockValueMockRecorder
+}
+
+// MockValueMockRecorder is the mock recorder for MockValue.
+type MockValueMockRecorder struct {
+	mock *MockValue
+}
+
+// NewMockValue creates a new mock instance.
+func NewMockValue(ctrl *gomock.Controller) *MockValue {
+	mock := &MockValue{ctrl: ctrl}
+	mock.recorder = &MockValueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValue) EXPECT() *MockValueMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockValue; create it with NewMockValue")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockValue) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Format mocks base method.
+func (m *MockValue) Format(f State, verb rune) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockValue; create it with NewMockValue")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Format", f, verb)
+}
+
+// Format indicates an expected call of Format.
+func (mr *MockValueMockRecorder) Format(f, verb any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Format", reflect.TypeOf((*MockValue)(nil).Format), f, verb)
+}
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock *MockState
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockState; create it with NewMockState")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockState) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Format formats the mock concisely, with its number of pending expectations.
+func (m *MockState) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, "MockState(expectations: %d pending)", m.ctrl.PendingCalls(m))
+}
+
+// Write mocks base method.
+func (m *MockState) Write(b []byte) (int, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockState; create it with NewMockState")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", b)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockStateMockRecorder) Write(b any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockState)(nil).Write), b)
+}
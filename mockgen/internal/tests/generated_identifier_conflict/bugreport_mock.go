@@ -35,11 +35,22 @@ func NewMockExample(ctrl *gomock.Controller) *MockExample {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockExample) EXPECT() *MockExampleMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExample; create it with NewMockExample")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockExample) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Method mocks base method.
 func (m_2 *MockExample) Method(_m, _mr, m, mr int) {
+	if m_2 == nil || m_2.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExample; create it with NewMockExample")
+	}
 	m_2.ctrl.T.Helper()
 	m_2.ctrl.Call(m_2, "Method", _m, _mr, m, mr)
 }
@@ -52,6 +63,9 @@ func (mr_2 *MockExampleMockRecorder) Method(_m, _mr, m, mr any) *gomock.Call {
 
 // VarargMethod mocks base method.
 func (m *MockExample) VarargMethod(_s, _x, a, ret int, varargs ...int) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExample; create it with NewMockExample")
+	}
 	m.ctrl.T.Helper()
 	varargs_2 := []any{_s, _x, a, ret}
 	for _, a_2 := range varargs {
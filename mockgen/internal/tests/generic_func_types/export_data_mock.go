@@ -0,0 +1,135 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/generic_func_types (interfaces: Router)
+//
+// Generated by this command:
+//
+//	mockgen -package generic_func_types -destination export_data_mock.go -export_data -mock_names Router=MockExportDataRouter . Router
+//
+
+// Package generic_func_types is a generated GoMock package.
+package generic_func_types
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	handler "go.uber.org/mock/mockgen/internal/tests/generic_func_types/handler"
+)
+
+// MockExportDataRouter is a mock of Router interface.
+type MockExportDataRouter struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportDataRouterMockRecorder
+}
+
+// MockExportDataRouterMockRecorder is the mock recorder for MockExportDataRouter.
+type MockExportDataRouterMockRecorder struct {
+	mock *MockExportDataRouter
+}
+
+// NewMockExportDataRouter creates a new mock instance.
+func NewMockExportDataRouter(ctrl *gomock.Controller) *MockExportDataRouter {
+	mock := &MockExportDataRouter{ctrl: ctrl}
+	mock.recorder = &MockExportDataRouterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportDataRouter) EXPECT() *MockExportDataRouterMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataRouter; create it with NewMockExportDataRouter")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockExportDataRouter) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Chain mocks base method.
+func (m *MockExportDataRouter) Chain(arg0 ...handler.Middleware[*Request]) handler.Func[*Request] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataRouter; create it with NewMockExportDataRouter")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Chain", varargs...)
+	ret0, _ := ret[0].(handler.Func[*Request])
+	return ret0
+}
+
+// Chain indicates an expected call of Chain.
+func (mr *MockExportDataRouterMockRecorder) Chain(arg0 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chain", reflect.TypeOf((*MockExportDataRouter)(nil).Chain), arg0...)
+}
+
+// Handler mocks base method.
+func (m *MockExportDataRouter) Handler(arg0 string) handler.Func[Request] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataRouter; create it with NewMockExportDataRouter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handler", arg0)
+	ret0, _ := ret[0].(handler.Func[Request])
+	return ret0
+}
+
+// Handler indicates an expected call of Handler.
+func (mr *MockExportDataRouterMockRecorder) Handler(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handler", reflect.TypeOf((*MockExportDataRouter)(nil).Handler), arg0)
+}
+
+// Respond mocks base method.
+func (m *MockExportDataRouter) Respond() Transform[Request, *Response] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataRouter; create it with NewMockExportDataRouter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Respond")
+	ret0, _ := ret[0].(Transform[Request, *Response])
+	return ret0
+}
+
+// Respond indicates an expected call of Respond.
+func (mr *MockExportDataRouterMockRecorder) Respond() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Respond", reflect.TypeOf((*MockExportDataRouter)(nil).Respond))
+}
+
+// Routes mocks base method.
+func (m *MockExportDataRouter) Routes(arg0 map[string]handler.Func[Request]) []Transform[string, handler.Func[Request]] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataRouter; create it with NewMockExportDataRouter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Routes", arg0)
+	ret0, _ := ret[0].([]Transform[string, handler.Func[Request]])
+	return ret0
+}
+
+// Routes indicates an expected call of Routes.
+func (mr *MockExportDataRouterMockRecorder) Routes(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Routes", reflect.TypeOf((*MockExportDataRouter)(nil).Routes), arg0)
+}
+
+// Use mocks base method.
+func (m *MockExportDataRouter) Use(arg0 handler.Middleware[Request]) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataRouter; create it with NewMockExportDataRouter")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Use", arg0)
+}
+
+// Use indicates an expected call of Use.
+func (mr *MockExportDataRouterMockRecorder) Use(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Use", reflect.TypeOf((*MockExportDataRouter)(nil).Use), arg0)
+}
@@ -0,0 +1,10 @@
+// Package handler has generic function types for generic_func_types.
+package handler
+
+import "context"
+
+// Func handles a request.
+type Func[Req any] func(ctx context.Context, req Req) error
+
+// Middleware wraps a Func.
+type Middleware[Req any] func(next Func[Req]) Func[Req]
@@ -0,0 +1,35 @@
+// Package generic_func_types has interfaces whose methods take and return
+// instantiations of generic function types, local and from other packages.
+package generic_func_types
+
+import "go.uber.org/mock/mockgen/internal/tests/generic_func_types/handler"
+
+//go:generate mockgen -package generic_func_types -destination source_mock.go -source input.go -mock_names Router=MockSourceRouter,Server=MockSourceServer
+//go:generate mockgen -package generic_func_types -destination reflect_mock.go -typed . Router
+//go:generate mockgen -package generic_func_types -destination export_data_mock.go -export_data -mock_names Router=MockExportDataRouter . Router
+//go:generate mockgen -destination mock_generic_func_types/mock.go . Router
+
+// Request is a request to a Router.
+type Request struct {
+	Path string
+}
+
+// Response is a response from a Router.
+type Response struct {
+	Status int
+}
+
+// Transform converts an In into an Out.
+type Transform[In, Out any] func(In) (Out, error)
+
+type Router interface {
+	Handler(path string) handler.Func[Request]
+	Use(mw handler.Middleware[Request])
+	Chain(mws ...handler.Middleware[*Request]) handler.Func[*Request]
+	Respond() Transform[Request, *Response]
+	Routes(map[string]handler.Func[Request]) []Transform[string, handler.Func[Request]]
+}
+
+type Server[Req any] interface {
+	Serve(h handler.Func[Req]) Transform[Req, handler.Middleware[Req]]
+}
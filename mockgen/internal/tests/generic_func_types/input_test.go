@@ -0,0 +1,65 @@
+package generic_func_types_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/generic_func_types"
+	"go.uber.org/mock/mockgen/internal/tests/generic_func_types/handler"
+	"go.uber.org/mock/mockgen/internal/tests/generic_func_types/mock_generic_func_types"
+)
+
+var (
+	_ generic_func_types.Router                             = (*generic_func_types.MockRouter)(nil)
+	_ generic_func_types.Router                             = (*generic_func_types.MockSourceRouter)(nil)
+	_ generic_func_types.Router                             = (*generic_func_types.MockExportDataRouter)(nil)
+	_ generic_func_types.Router                             = (*mock_generic_func_types.MockRouter)(nil)
+	_ generic_func_types.Server[generic_func_types.Request] = (*generic_func_types.MockSourceServer[generic_func_types.Request])(nil)
+)
+
+var errNotFound = errors.New("not found")
+
+func TestGenericFuncTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	router := generic_func_types.NewMockRouter(ctrl)
+	var notFound handler.Func[generic_func_types.Request] = func(context.Context, generic_func_types.Request) error {
+		return errNotFound
+	}
+	router.EXPECT().Handler("/missing").Return(notFound)
+	h := router.Handler("/missing")
+	if err := h(context.Background(), generic_func_types.Request{Path: "/missing"}); err != errNotFound {
+		t.Errorf("Handler(\"/missing\")() = %v, want %v", err, errNotFound)
+	}
+
+	router.EXPECT().Respond().Return(func(req generic_func_types.Request) (*generic_func_types.Response, error) {
+		return &generic_func_types.Response{Status: len(req.Path)}, nil
+	})
+	if resp, err := router.Respond()(generic_func_types.Request{Path: "/abc"}); err != nil || resp.Status != 4 {
+		t.Errorf("Respond()() = %v, %v, want status 4", resp, err)
+	}
+
+	var wrapped bool
+	router.EXPECT().Use(gomock.Any()).Do(func(mw handler.Middleware[generic_func_types.Request]) {
+		mw(notFound)(context.Background(), generic_func_types.Request{})
+	})
+	router.Use(func(next handler.Func[generic_func_types.Request]) handler.Func[generic_func_types.Request] {
+		return func(ctx context.Context, req generic_func_types.Request) error {
+			wrapped = true
+			return next(ctx, req)
+		}
+	})
+	if !wrapped {
+		t.Error("middleware passed to Use was not called")
+	}
+
+	server := generic_func_types.NewMockSourceServer[string](ctrl)
+	server.EXPECT().Serve(gomock.Any()).Return(func(s string) (handler.Middleware[string], error) {
+		return nil, errors.New(s)
+	})
+	if _, err := server.Serve(nil)("boom"); err == nil || err.Error() != "boom" {
+		t.Errorf("Serve(nil)(\"boom\") = %v, want boom", err)
+	}
+}
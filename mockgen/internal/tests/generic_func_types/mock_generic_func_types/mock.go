@@ -0,0 +1,136 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/generic_func_types (interfaces: Router)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_generic_func_types/mock.go . Router
+//
+
+// Package mock_generic_func_types is a generated GoMock package.
+package mock_generic_func_types
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	generic_func_types "go.uber.org/mock/mockgen/internal/tests/generic_func_types"
+	handler "go.uber.org/mock/mockgen/internal/tests/generic_func_types/handler"
+)
+
+// MockRouter is a mock of Router interface.
+type MockRouter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRouterMockRecorder
+}
+
+// MockRouterMockRecorder is the mock recorder for MockRouter.
+type MockRouterMockRecorder struct {
+	mock *MockRouter
+}
+
+// NewMockRouter creates a new mock instance.
+func NewMockRouter(ctrl *gomock.Controller) *MockRouter {
+	mock := &MockRouter{ctrl: ctrl}
+	mock.recorder = &MockRouterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRouter) EXPECT() *MockRouterMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockRouter; create it with NewMockRouter")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockRouter) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Chain mocks base method.
+func (m *MockRouter) Chain(arg0 ...handler.Middleware[*generic_func_types.Request]) handler.Func[*generic_func_types.Request] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockRouter; create it with NewMockRouter")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Chain", varargs...)
+	ret0, _ := ret[0].(handler.Func[*generic_func_types.Request])
+	return ret0
+}
+
+// Chain indicates an expected call of Chain.
+func (mr *MockRouterMockRecorder) Chain(arg0 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chain", reflect.TypeOf((*MockRouter)(nil).Chain), arg0...)
+}
+
+// Handler mocks base method.
+func (m *MockRouter) Handler(arg0 string) handler.Func[generic_func_types.Request] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockRouter; create it with NewMockRouter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handler", arg0)
+	ret0, _ := ret[0].(handler.Func[generic_func_types.Request])
+	return ret0
+}
+
+// Handler indicates an expected call of Handler.
+func (mr *MockRouterMockRecorder) Handler(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handler", reflect.TypeOf((*MockRouter)(nil).Handler), arg0)
+}
+
+// Respond mocks base method.
+func (m *MockRouter) Respond() generic_func_types.Transform[generic_func_types.Request, *generic_func_types.Response] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockRouter; create it with NewMockRouter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Respond")
+	ret0, _ := ret[0].(generic_func_types.Transform[generic_func_types.Request, *generic_func_types.Response])
+	return ret0
+}
+
+// Respond indicates an expected call of Respond.
+func (mr *MockRouterMockRecorder) Respond() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Respond", reflect.TypeOf((*MockRouter)(nil).Respond))
+}
+
+// Routes mocks base method.
+func (m *MockRouter) Routes(arg0 map[string]handler.Func[generic_func_types.Request]) []generic_func_types.Transform[string, handler.Func[generic_func_types.Request]] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockRouter; create it with NewMockRouter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Routes", arg0)
+	ret0, _ := ret[0].([]generic_func_types.Transform[string, handler.Func[generic_func_types.Request]])
+	return ret0
+}
+
+// Routes indicates an expected call of Routes.
+func (mr *MockRouterMockRecorder) Routes(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Routes", reflect.TypeOf((*MockRouter)(nil).Routes), arg0)
+}
+
+// Use mocks base method.
+func (m *MockRouter) Use(arg0 handler.Middleware[generic_func_types.Request]) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockRouter; create it with NewMockRouter")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Use", arg0)
+}
+
+// Use indicates an expected call of Use.
+func (mr *MockRouterMockRecorder) Use(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Use", reflect.TypeOf((*MockRouter)(nil).Use), arg0)
+}
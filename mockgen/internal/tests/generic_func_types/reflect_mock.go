@@ -0,0 +1,301 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/generic_func_types (interfaces: Router)
+//
+// Generated by this command:
+//
+//	mockgen -package generic_func_types -destination reflect_mock.go -typed . Router
+//
+
+// Package generic_func_types is a generated GoMock package.
+package generic_func_types
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	handler "go.uber.org/mock/mockgen/internal/tests/generic_func_types/handler"
+)
+
+// MockRouter is a mock of Router interface.
+type MockRouter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRouterMockRecorder
+}
+
+// MockRouterMockRecorder is the mock recorder for MockRouter.
+type MockRouterMockRecorder struct {
+	mock *MockRouter
+}
+
+// NewMockRouter creates a new mock instance.
+func NewMockRouter(ctrl *gomock.Controller) *MockRouter {
+	mock := &MockRouter{ctrl: ctrl}
+	mock.recorder = &MockRouterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRouter) EXPECT() *MockRouterMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockRouter; create it with NewMockRouter")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockRouter) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Chain mocks base method.
+func (m *MockRouter) Chain(arg0 ...handler.Middleware[*Request]) handler.Func[*Request] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockRouter; create it with NewMockRouter")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Chain", varargs...)
+	ret0, _ := ret[0].(handler.Func[*Request])
+	return ret0
+}
+
+// Chain indicates an expected call of Chain.
+func (mr *MockRouterMockRecorder) Chain(arg0 ...any) *MockRouterChainCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chain", reflect.TypeOf((*MockRouter)(nil).Chain), arg0...)
+	return &MockRouterChainCall{Call: call}
+}
+
+// MockRouterChainCall wrap *gomock.Call
+type MockRouterChainCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRouterChainCall) Return(arg0 handler.Func[*Request]) *MockRouterChainCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRouterChainCall) Do(f func(...handler.Middleware[*Request]) handler.Func[*Request]) *MockRouterChainCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRouterChainCall) DoAndReturn(f func(...handler.Middleware[*Request]) handler.Func[*Request]) *MockRouterChainCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockRouterChainCall) ReturnsInOrder(rets ...handler.Func[*Request]) *MockRouterChainCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Handler mocks base method.
+func (m *MockRouter) Handler(arg0 string) handler.Func[Request] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockRouter; create it with NewMockRouter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handler", arg0)
+	ret0, _ := ret[0].(handler.Func[Request])
+	return ret0
+}
+
+// Handler indicates an expected call of Handler.
+func (mr *MockRouterMockRecorder) Handler(arg0 any) *MockRouterHandlerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handler", reflect.TypeOf((*MockRouter)(nil).Handler), arg0)
+	return &MockRouterHandlerCall{Call: call}
+}
+
+// MockRouterHandlerCall wrap *gomock.Call
+type MockRouterHandlerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRouterHandlerCall) Return(arg0 handler.Func[Request]) *MockRouterHandlerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRouterHandlerCall) Do(f func(string) handler.Func[Request]) *MockRouterHandlerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRouterHandlerCall) DoAndReturn(f func(string) handler.Func[Request]) *MockRouterHandlerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockRouterHandlerCall) ReturnsInOrder(rets ...handler.Func[Request]) *MockRouterHandlerCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Respond mocks base method.
+func (m *MockRouter) Respond() Transform[Request, *Response] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockRouter; create it with NewMockRouter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Respond")
+	ret0, _ := ret[0].(Transform[Request, *Response])
+	return ret0
+}
+
+// Respond indicates an expected call of Respond.
+func (mr *MockRouterMockRecorder) Respond() *MockRouterRespondCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Respond", reflect.TypeOf((*MockRouter)(nil).Respond))
+	return &MockRouterRespondCall{Call: call}
+}
+
+// MockRouterRespondCall wrap *gomock.Call
+type MockRouterRespondCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRouterRespondCall) Return(arg0 Transform[Request, *Response]) *MockRouterRespondCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRouterRespondCall) Do(f func() Transform[Request, *Response]) *MockRouterRespondCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRouterRespondCall) DoAndReturn(f func() Transform[Request, *Response]) *MockRouterRespondCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockRouterRespondCall) ReturnsInOrder(rets ...Transform[Request, *Response]) *MockRouterRespondCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Routes mocks base method.
+func (m *MockRouter) Routes(arg0 map[string]handler.Func[Request]) []Transform[string, handler.Func[Request]] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockRouter; create it with NewMockRouter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Routes", arg0)
+	ret0, _ := ret[0].([]Transform[string, handler.Func[Request]])
+	return ret0
+}
+
+// Routes indicates an expected call of Routes.
+func (mr *MockRouterMockRecorder) Routes(arg0 any) *MockRouterRoutesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Routes", reflect.TypeOf((*MockRouter)(nil).Routes), arg0)
+	return &MockRouterRoutesCall{Call: call}
+}
+
+// MockRouterRoutesCall wrap *gomock.Call
+type MockRouterRoutesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRouterRoutesCall) Return(arg0 []Transform[string, handler.Func[Request]]) *MockRouterRoutesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRouterRoutesCall) Do(f func(map[string]handler.Func[Request]) []Transform[string, handler.Func[Request]]) *MockRouterRoutesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRouterRoutesCall) DoAndReturn(f func(map[string]handler.Func[Request]) []Transform[string, handler.Func[Request]]) *MockRouterRoutesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockRouterRoutesCall) ReturnsInOrder(rets ...[]Transform[string, handler.Func[Request]]) *MockRouterRoutesCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Use mocks base method.
+func (m *MockRouter) Use(arg0 handler.Middleware[Request]) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockRouter; create it with NewMockRouter")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Use", arg0)
+}
+
+// Use indicates an expected call of Use.
+func (mr *MockRouterMockRecorder) Use(arg0 any) *MockRouterUseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Use", reflect.TypeOf((*MockRouter)(nil).Use), arg0)
+	return &MockRouterUseCall{Call: call}
+}
+
+// MockRouterUseCall wrap *gomock.Call
+type MockRouterUseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRouterUseCall) Return() *MockRouterUseCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRouterUseCall) Do(f func(handler.Middleware[Request])) *MockRouterUseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRouterUseCall) DoAndReturn(f func(handler.Middleware[Request])) *MockRouterUseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockRouterUseCall) ReturnsInOrder(rets ...[]any) *MockRouterUseCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
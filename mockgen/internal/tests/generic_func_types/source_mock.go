@@ -0,0 +1,183 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package generic_func_types -destination source_mock.go -source input.go -mock_names Router=MockSourceRouter,Server=MockSourceServer
+//
+
+// Package generic_func_types is a generated GoMock package.
+package generic_func_types
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	handler "go.uber.org/mock/mockgen/internal/tests/generic_func_types/handler"
+)
+
+// MockSourceRouter is a mock of Router interface.
+type MockSourceRouter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceRouterMockRecorder
+}
+
+// MockSourceRouterMockRecorder is the mock recorder for MockSourceRouter.
+type MockSourceRouterMockRecorder struct {
+	mock *MockSourceRouter
+}
+
+// NewMockSourceRouter creates a new mock instance.
+func NewMockSourceRouter(ctrl *gomock.Controller) *MockSourceRouter {
+	mock := &MockSourceRouter{ctrl: ctrl}
+	mock.recorder = &MockSourceRouterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceRouter) EXPECT() *MockSourceRouterMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceRouter; create it with NewMockSourceRouter")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceRouter) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Chain mocks base method.
+func (m *MockSourceRouter) Chain(mws ...handler.Middleware[*Request]) handler.Func[*Request] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceRouter; create it with NewMockSourceRouter")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range mws {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Chain", varargs...)
+	ret0, _ := ret[0].(handler.Func[*Request])
+	return ret0
+}
+
+// Chain indicates an expected call of Chain.
+func (mr *MockSourceRouterMockRecorder) Chain(mws ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chain", reflect.TypeOf((*MockSourceRouter)(nil).Chain), mws...)
+}
+
+// Handler mocks base method.
+func (m *MockSourceRouter) Handler(path string) handler.Func[Request] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceRouter; create it with NewMockSourceRouter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handler", path)
+	ret0, _ := ret[0].(handler.Func[Request])
+	return ret0
+}
+
+// Handler indicates an expected call of Handler.
+func (mr *MockSourceRouterMockRecorder) Handler(path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handler", reflect.TypeOf((*MockSourceRouter)(nil).Handler), path)
+}
+
+// Respond mocks base method.
+func (m *MockSourceRouter) Respond() Transform[Request, *Response] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceRouter; create it with NewMockSourceRouter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Respond")
+	ret0, _ := ret[0].(Transform[Request, *Response])
+	return ret0
+}
+
+// Respond indicates an expected call of Respond.
+func (mr *MockSourceRouterMockRecorder) Respond() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Respond", reflect.TypeOf((*MockSourceRouter)(nil).Respond))
+}
+
+// Routes mocks base method.
+func (m *MockSourceRouter) Routes(arg0 map[string]handler.Func[Request]) []Transform[string, handler.Func[Request]] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceRouter; create it with NewMockSourceRouter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Routes", arg0)
+	ret0, _ := ret[0].([]Transform[string, handler.Func[Request]])
+	return ret0
+}
+
+// Routes indicates an expected call of Routes.
+func (mr *MockSourceRouterMockRecorder) Routes(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Routes", reflect.TypeOf((*MockSourceRouter)(nil).Routes), arg0)
+}
+
+// Use mocks base method.
+func (m *MockSourceRouter) Use(mw handler.Middleware[Request]) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceRouter; create it with NewMockSourceRouter")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Use", mw)
+}
+
+// Use indicates an expected call of Use.
+func (mr *MockSourceRouterMockRecorder) Use(mw any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Use", reflect.TypeOf((*MockSourceRouter)(nil).Use), mw)
+}
+
+// MockSourceServer is a mock of Server interface.
+type MockSourceServer[Req any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceServerMockRecorder[Req]
+}
+
+// MockSourceServerMockRecorder is the mock recorder for MockSourceServer.
+type MockSourceServerMockRecorder[Req any] struct {
+	mock *MockSourceServer[Req]
+}
+
+// NewMockSourceServer creates a new mock instance.
+func NewMockSourceServer[Req any](ctrl *gomock.Controller) *MockSourceServer[Req] {
+	mock := &MockSourceServer[Req]{ctrl: ctrl}
+	mock.recorder = &MockSourceServerMockRecorder[Req]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceServer[Req]) EXPECT() *MockSourceServerMockRecorder[Req] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceServer; create it with NewMockSourceServer")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceServer[Req]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Serve mocks base method.
+func (m *MockSourceServer[Req]) Serve(h handler.Func[Req]) Transform[Req, handler.Middleware[Req]] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceServer; create it with NewMockSourceServer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Serve", h)
+	ret0, _ := ret[0].(Transform[Req, handler.Middleware[Req]])
+	return ret0
+}
+
+// Serve indicates an expected call of Serve.
+func (mr *MockSourceServerMockRecorder[Req]) Serve(h any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Serve", reflect.TypeOf((*MockSourceServer[Req])(nil).Serve), h)
+}
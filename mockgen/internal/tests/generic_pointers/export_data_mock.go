@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/generic_pointers (interfaces: Shapes)
+//
+// Generated by this command:
+//
+//	mockgen -package generic_pointers -destination export_data_mock.go -export_data -mock_names Shapes=MockExportDataShapes . Shapes
+//
+
+// Package generic_pointers is a generated GoMock package.
+package generic_pointers
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	result "go.uber.org/mock/mockgen/internal/tests/generic_pointers/result"
+)
+
+// MockExportDataShapes is a mock of Shapes interface.
+type MockExportDataShapes struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportDataShapesMockRecorder
+}
+
+// MockExportDataShapesMockRecorder is the mock recorder for MockExportDataShapes.
+type MockExportDataShapesMockRecorder struct {
+	mock *MockExportDataShapes
+}
+
+// NewMockExportDataShapes creates a new mock instance.
+func NewMockExportDataShapes(ctrl *gomock.Controller) *MockExportDataShapes {
+	mock := &MockExportDataShapes{ctrl: ctrl}
+	mock.recorder = &MockExportDataShapesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportDataShapes) EXPECT() *MockExportDataShapesMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataShapes; create it with NewMockExportDataShapes")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockExportDataShapes) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Box mocks base method.
+func (m *MockExportDataShapes) Box() *Box[int] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataShapes; create it with NewMockExportDataShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Box")
+	ret0, _ := ret[0].(*Box[int])
+	return ret0
+}
+
+// Box indicates an expected call of Box.
+func (mr *MockExportDataShapesMockRecorder) Box() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Box", reflect.TypeOf((*MockExportDataShapes)(nil).Box))
+}
+
+// Boxes mocks base method.
+func (m *MockExportDataShapes) Boxes(arg0 ...*Box[[]*Pair[string, *User]]) [2]*Box[*Box[int]] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataShapes; create it with NewMockExportDataShapes")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Boxes", varargs...)
+	ret0, _ := ret[0].([2]*Box[*Box[int]])
+	return ret0
+}
+
+// Boxes indicates an expected call of Boxes.
+func (mr *MockExportDataShapesMockRecorder) Boxes(arg0 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Boxes", reflect.TypeOf((*MockExportDataShapes)(nil).Boxes), arg0...)
+}
+
+// Find mocks base method.
+func (m *MockExportDataShapes) Find(arg0 string) *result.Result[User, error] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataShapes; create it with NewMockExportDataShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", arg0)
+	ret0, _ := ret[0].(*result.Result[User, error])
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockExportDataShapesMockRecorder) Find(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockExportDataShapes)(nil).Find), arg0)
+}
+
+// Nodes mocks base method.
+func (m *MockExportDataShapes) Nodes() map[string]*Node[*User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataShapes; create it with NewMockExportDataShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nodes")
+	ret0, _ := ret[0].(map[string]*Node[*User])
+	return ret0
+}
+
+// Nodes indicates an expected call of Nodes.
+func (mr *MockExportDataShapesMockRecorder) Nodes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nodes", reflect.TypeOf((*MockExportDataShapes)(nil).Nodes))
+}
+
+// Pairs mocks base method.
+func (m *MockExportDataShapes) Pairs() []*Pair[string, int] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataShapes; create it with NewMockExportDataShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pairs")
+	ret0, _ := ret[0].([]*Pair[string, int])
+	return ret0
+}
+
+// Pairs indicates an expected call of Pairs.
+func (mr *MockExportDataShapesMockRecorder) Pairs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pairs", reflect.TypeOf((*MockExportDataShapes)(nil).Pairs))
+}
+
+// Set mocks base method.
+func (m *MockExportDataShapes) Set(arg0 *Pair[string, *Node[User]]) **Box[User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataShapes; create it with NewMockExportDataShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", arg0)
+	ret0, _ := ret[0].(**Box[User])
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockExportDataShapesMockRecorder) Set(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockExportDataShapes)(nil).Set), arg0)
+}
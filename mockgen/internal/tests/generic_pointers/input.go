@@ -0,0 +1,49 @@
+// Package generic_pointers has interfaces whose methods take and return
+// pointers to instantiations of generic types, alone and within slices and
+// maps.
+package generic_pointers
+
+import "go.uber.org/mock/mockgen/internal/tests/generic_pointers/result"
+
+//go:generate mockgen -package generic_pointers -destination source_mock.go -source input.go -typed -mock_names Shapes=MockSourceShapes,Tree=MockSourceTree
+//go:generate mockgen -package generic_pointers -destination reflect_mock.go -typed . Shapes
+//go:generate mockgen -package generic_pointers -destination export_data_mock.go -export_data -mock_names Shapes=MockExportDataShapes . Shapes
+//go:generate mockgen -destination mock_generic_pointers/mock.go . Shapes
+
+// User is a user.
+type User struct {
+	Name string
+}
+
+// Box holds a value.
+type Box[T any] struct {
+	Value T
+}
+
+// Pair holds a key and a value.
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// Node is a node of a tree.
+type Node[V any] struct {
+	Value    V
+	Children []*Node[V]
+}
+
+type Shapes interface {
+	Box() *Box[int]
+	Pairs() []*Pair[string, int]
+	Nodes() map[string]*Node[*User]
+	Find(name string) *result.Result[User, error]
+	Boxes(...*Box[[]*Pair[string, *User]]) [2]*Box[*Box[int]]
+	Set(*Pair[string, *Node[User]]) **Box[User]
+}
+
+type Tree[K comparable, V any] interface {
+	Nodes() map[K]*Node[V]
+	Options() []*Box[V]
+	Get(K) *result.Result[*Node[V], error]
+	Put(map[K]*Node[V], ...*Pair[K, *V]) []*Pair[K, *Node[V]]
+}
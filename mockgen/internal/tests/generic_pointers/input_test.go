@@ -0,0 +1,82 @@
+package generic_pointers_test
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/generic_pointers"
+	"go.uber.org/mock/mockgen/internal/tests/generic_pointers/mock_generic_pointers"
+	"go.uber.org/mock/mockgen/internal/tests/generic_pointers/result"
+)
+
+type (
+	User = generic_pointers.User
+	Node = generic_pointers.Node[*User]
+)
+
+var (
+	_ generic_pointers.Shapes            = (*generic_pointers.MockShapes)(nil)
+	_ generic_pointers.Shapes            = (*generic_pointers.MockSourceShapes)(nil)
+	_ generic_pointers.Shapes            = (*generic_pointers.MockExportDataShapes)(nil)
+	_ generic_pointers.Shapes            = (*mock_generic_pointers.MockShapes)(nil)
+	_ generic_pointers.Tree[string, int] = (*generic_pointers.MockSourceTree[string, int])(nil)
+)
+
+func TestGenericPointers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	shapes := generic_pointers.NewMockShapes(ctrl)
+
+	box := &generic_pointers.Box[int]{Value: 1}
+	shapes.EXPECT().Box().Return(box)
+	if got := shapes.Box(); got != box {
+		t.Errorf("Box() = %v, want %v", got, box)
+	}
+
+	pairs := []*generic_pointers.Pair[string, int]{{Key: "a", Value: 1}}
+	shapes.EXPECT().Pairs().Return(pairs)
+	if got := shapes.Pairs(); len(got) != 1 || got[0] != pairs[0] {
+		t.Errorf("Pairs() = %v, want %v", got, pairs)
+	}
+
+	ann := &User{Name: "Ann"}
+	shapes.EXPECT().Nodes().Return(map[string]*Node{"root": {Value: ann}})
+	if got := shapes.Nodes(); got["root"].Value != ann {
+		t.Errorf("Nodes()[root] = %v, want Ann", got["root"])
+	}
+
+	shapes.EXPECT().Find("Ann").Return(&result.Result[User, error]{Value: *ann})
+	if got := shapes.Find("Ann"); got.Value.Name != "Ann" || got.Err != nil {
+		t.Errorf("Find(Ann) = %v, want Ann", got)
+	}
+
+	inner := &generic_pointers.Box[User]{Value: *ann}
+	shapes.EXPECT().Set(gomock.Any()).Return(&inner)
+	if got := shapes.Set(nil); *got != inner {
+		t.Errorf("Set(nil) = %v, want %v", got, &inner)
+	}
+}
+
+func TestGenericPointers_GenericInterface(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tree := generic_pointers.NewMockSourceTree[string, *User](ctrl)
+
+	nodes := map[string]*generic_pointers.Node[*User]{"a": {Value: &User{Name: "Ann"}}}
+	tree.EXPECT().Nodes().Return(nodes)
+	if got := tree.Nodes(); got["a"] != nodes["a"] {
+		t.Errorf("Nodes() = %v, want %v", got, nodes)
+	}
+
+	tree.EXPECT().Get("a").Return(&result.Result[*Node, error]{Value: nodes["a"]})
+	if got := tree.Get("a"); got.Value != nodes["a"] {
+		t.Errorf("Get(a) = %v, want %v", got, nodes["a"])
+	}
+
+	bob := &User{Name: "Bob"}
+	tree.EXPECT().Put(nodes, &generic_pointers.Pair[string, **User]{Key: "b", Value: &bob}).
+		DoAndReturn(func(m map[string]*Node, pairs ...*generic_pointers.Pair[string, **User]) []*generic_pointers.Pair[string, *Node] {
+			return []*generic_pointers.Pair[string, *Node]{{Key: pairs[0].Key, Value: m["a"]}}
+		})
+	if got := tree.Put(nodes, &generic_pointers.Pair[string, **User]{Key: "b", Value: &bob}); got[0].Key != "b" || got[0].Value != nodes["a"] {
+		t.Errorf("Put() = %v, want b with node a", got)
+	}
+}
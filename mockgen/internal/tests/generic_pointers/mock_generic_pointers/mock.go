@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/generic_pointers (interfaces: Shapes)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_generic_pointers/mock.go . Shapes
+//
+
+// Package mock_generic_pointers is a generated GoMock package.
+package mock_generic_pointers
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	generic_pointers "go.uber.org/mock/mockgen/internal/tests/generic_pointers"
+	result "go.uber.org/mock/mockgen/internal/tests/generic_pointers/result"
+)
+
+// MockShapes is a mock of Shapes interface.
+type MockShapes struct {
+	ctrl     *gomock.Controller
+	recorder *MockShapesMockRecorder
+}
+
+// MockShapesMockRecorder is the mock recorder for MockShapes.
+type MockShapesMockRecorder struct {
+	mock *MockShapes
+}
+
+// NewMockShapes creates a new mock instance.
+func NewMockShapes(ctrl *gomock.Controller) *MockShapes {
+	mock := &MockShapes{ctrl: ctrl}
+	mock.recorder = &MockShapesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShapes) EXPECT() *MockShapesMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockShapes) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Box mocks base method.
+func (m *MockShapes) Box() *generic_pointers.Box[int] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Box")
+	ret0, _ := ret[0].(*generic_pointers.Box[int])
+	return ret0
+}
+
+// Box indicates an expected call of Box.
+func (mr *MockShapesMockRecorder) Box() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Box", reflect.TypeOf((*MockShapes)(nil).Box))
+}
+
+// Boxes mocks base method.
+func (m *MockShapes) Boxes(arg0 ...*generic_pointers.Box[[]*generic_pointers.Pair[string, *generic_pointers.User]]) [2]*generic_pointers.Box[*generic_pointers.Box[int]] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Boxes", varargs...)
+	ret0, _ := ret[0].([2]*generic_pointers.Box[*generic_pointers.Box[int]])
+	return ret0
+}
+
+// Boxes indicates an expected call of Boxes.
+func (mr *MockShapesMockRecorder) Boxes(arg0 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Boxes", reflect.TypeOf((*MockShapes)(nil).Boxes), arg0...)
+}
+
+// Find mocks base method.
+func (m *MockShapes) Find(arg0 string) *result.Result[generic_pointers.User, error] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", arg0)
+	ret0, _ := ret[0].(*result.Result[generic_pointers.User, error])
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockShapesMockRecorder) Find(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockShapes)(nil).Find), arg0)
+}
+
+// Nodes mocks base method.
+func (m *MockShapes) Nodes() map[string]*generic_pointers.Node[*generic_pointers.User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nodes")
+	ret0, _ := ret[0].(map[string]*generic_pointers.Node[*generic_pointers.User])
+	return ret0
+}
+
+// Nodes indicates an expected call of Nodes.
+func (mr *MockShapesMockRecorder) Nodes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nodes", reflect.TypeOf((*MockShapes)(nil).Nodes))
+}
+
+// Pairs mocks base method.
+func (m *MockShapes) Pairs() []*generic_pointers.Pair[string, int] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pairs")
+	ret0, _ := ret[0].([]*generic_pointers.Pair[string, int])
+	return ret0
+}
+
+// Pairs indicates an expected call of Pairs.
+func (mr *MockShapesMockRecorder) Pairs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pairs", reflect.TypeOf((*MockShapes)(nil).Pairs))
+}
+
+// Set mocks base method.
+func (m *MockShapes) Set(arg0 *generic_pointers.Pair[string, *generic_pointers.Node[generic_pointers.User]]) **generic_pointers.Box[generic_pointers.User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", arg0)
+	ret0, _ := ret[0].(**generic_pointers.Box[generic_pointers.User])
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockShapesMockRecorder) Set(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockShapes)(nil).Set), arg0)
+}
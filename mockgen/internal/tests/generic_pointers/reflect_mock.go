@@ -0,0 +1,358 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/generic_pointers (interfaces: Shapes)
+//
+// Generated by this command:
+//
+//	mockgen -package generic_pointers -destination reflect_mock.go -typed . Shapes
+//
+
+// Package generic_pointers is a generated GoMock package.
+package generic_pointers
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	result "go.uber.org/mock/mockgen/internal/tests/generic_pointers/result"
+)
+
+// MockShapes is a mock of Shapes interface.
+type MockShapes struct {
+	ctrl     *gomock.Controller
+	recorder *MockShapesMockRecorder
+}
+
+// MockShapesMockRecorder is the mock recorder for MockShapes.
+type MockShapesMockRecorder struct {
+	mock *MockShapes
+}
+
+// NewMockShapes creates a new mock instance.
+func NewMockShapes(ctrl *gomock.Controller) *MockShapes {
+	mock := &MockShapes{ctrl: ctrl}
+	mock.recorder = &MockShapesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShapes) EXPECT() *MockShapesMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockShapes) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Box mocks base method.
+func (m *MockShapes) Box() *Box[int] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Box")
+	ret0, _ := ret[0].(*Box[int])
+	return ret0
+}
+
+// Box indicates an expected call of Box.
+func (mr *MockShapesMockRecorder) Box() *MockShapesBoxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Box", reflect.TypeOf((*MockShapes)(nil).Box))
+	return &MockShapesBoxCall{Call: call}
+}
+
+// MockShapesBoxCall wrap *gomock.Call
+type MockShapesBoxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockShapesBoxCall) Return(arg0 *Box[int]) *MockShapesBoxCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockShapesBoxCall) Do(f func() *Box[int]) *MockShapesBoxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockShapesBoxCall) DoAndReturn(f func() *Box[int]) *MockShapesBoxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockShapesBoxCall) ReturnsInOrder(rets ...*Box[int]) *MockShapesBoxCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Boxes mocks base method.
+func (m *MockShapes) Boxes(arg0 ...*Box[[]*Pair[string, *User]]) [2]*Box[*Box[int]] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Boxes", varargs...)
+	ret0, _ := ret[0].([2]*Box[*Box[int]])
+	return ret0
+}
+
+// Boxes indicates an expected call of Boxes.
+func (mr *MockShapesMockRecorder) Boxes(arg0 ...any) *MockShapesBoxesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Boxes", reflect.TypeOf((*MockShapes)(nil).Boxes), arg0...)
+	return &MockShapesBoxesCall{Call: call}
+}
+
+// MockShapesBoxesCall wrap *gomock.Call
+type MockShapesBoxesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockShapesBoxesCall) Return(arg0 [2]*Box[*Box[int]]) *MockShapesBoxesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockShapesBoxesCall) Do(f func(...*Box[[]*Pair[string, *User]]) [2]*Box[*Box[int]]) *MockShapesBoxesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockShapesBoxesCall) DoAndReturn(f func(...*Box[[]*Pair[string, *User]]) [2]*Box[*Box[int]]) *MockShapesBoxesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockShapesBoxesCall) ReturnsInOrder(rets ...[2]*Box[*Box[int]]) *MockShapesBoxesCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Find mocks base method.
+func (m *MockShapes) Find(arg0 string) *result.Result[User, error] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", arg0)
+	ret0, _ := ret[0].(*result.Result[User, error])
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockShapesMockRecorder) Find(arg0 any) *MockShapesFindCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockShapes)(nil).Find), arg0)
+	return &MockShapesFindCall{Call: call}
+}
+
+// MockShapesFindCall wrap *gomock.Call
+type MockShapesFindCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockShapesFindCall) Return(arg0 *result.Result[User, error]) *MockShapesFindCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockShapesFindCall) Do(f func(string) *result.Result[User, error]) *MockShapesFindCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockShapesFindCall) DoAndReturn(f func(string) *result.Result[User, error]) *MockShapesFindCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockShapesFindCall) ReturnsInOrder(rets ...*result.Result[User, error]) *MockShapesFindCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Nodes mocks base method.
+func (m *MockShapes) Nodes() map[string]*Node[*User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nodes")
+	ret0, _ := ret[0].(map[string]*Node[*User])
+	return ret0
+}
+
+// Nodes indicates an expected call of Nodes.
+func (mr *MockShapesMockRecorder) Nodes() *MockShapesNodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nodes", reflect.TypeOf((*MockShapes)(nil).Nodes))
+	return &MockShapesNodesCall{Call: call}
+}
+
+// MockShapesNodesCall wrap *gomock.Call
+type MockShapesNodesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockShapesNodesCall) Return(arg0 map[string]*Node[*User]) *MockShapesNodesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockShapesNodesCall) Do(f func() map[string]*Node[*User]) *MockShapesNodesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockShapesNodesCall) DoAndReturn(f func() map[string]*Node[*User]) *MockShapesNodesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockShapesNodesCall) ReturnsInOrder(rets ...map[string]*Node[*User]) *MockShapesNodesCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Pairs mocks base method.
+func (m *MockShapes) Pairs() []*Pair[string, int] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pairs")
+	ret0, _ := ret[0].([]*Pair[string, int])
+	return ret0
+}
+
+// Pairs indicates an expected call of Pairs.
+func (mr *MockShapesMockRecorder) Pairs() *MockShapesPairsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pairs", reflect.TypeOf((*MockShapes)(nil).Pairs))
+	return &MockShapesPairsCall{Call: call}
+}
+
+// MockShapesPairsCall wrap *gomock.Call
+type MockShapesPairsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockShapesPairsCall) Return(arg0 []*Pair[string, int]) *MockShapesPairsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockShapesPairsCall) Do(f func() []*Pair[string, int]) *MockShapesPairsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockShapesPairsCall) DoAndReturn(f func() []*Pair[string, int]) *MockShapesPairsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockShapesPairsCall) ReturnsInOrder(rets ...[]*Pair[string, int]) *MockShapesPairsCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Set mocks base method.
+func (m *MockShapes) Set(arg0 *Pair[string, *Node[User]]) **Box[User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockShapes; create it with NewMockShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", arg0)
+	ret0, _ := ret[0].(**Box[User])
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockShapesMockRecorder) Set(arg0 any) *MockShapesSetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockShapes)(nil).Set), arg0)
+	return &MockShapesSetCall{Call: call}
+}
+
+// MockShapesSetCall wrap *gomock.Call
+type MockShapesSetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockShapesSetCall) Return(arg0 **Box[User]) *MockShapesSetCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockShapesSetCall) Do(f func(*Pair[string, *Node[User]]) **Box[User]) *MockShapesSetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockShapesSetCall) DoAndReturn(f func(*Pair[string, *Node[User]]) **Box[User]) *MockShapesSetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockShapesSetCall) ReturnsInOrder(rets ...**Box[User]) *MockShapesSetCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
@@ -0,0 +1,8 @@
+// Package result has a generic type for generic_pointers.
+package result
+
+// Result is a value or an error.
+type Result[T any, E error] struct {
+	Value T
+	Err   E
+}
@@ -0,0 +1,598 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package generic_pointers -destination source_mock.go -source input.go -typed -mock_names Shapes=MockSourceShapes,Tree=MockSourceTree
+//
+
+// Package generic_pointers is a generated GoMock package.
+package generic_pointers
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	result "go.uber.org/mock/mockgen/internal/tests/generic_pointers/result"
+)
+
+// MockSourceShapes is a mock of Shapes interface.
+type MockSourceShapes struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceShapesMockRecorder
+}
+
+// MockSourceShapesMockRecorder is the mock recorder for MockSourceShapes.
+type MockSourceShapesMockRecorder struct {
+	mock *MockSourceShapes
+}
+
+// NewMockSourceShapes creates a new mock instance.
+func NewMockSourceShapes(ctrl *gomock.Controller) *MockSourceShapes {
+	mock := &MockSourceShapes{ctrl: ctrl}
+	mock.recorder = &MockSourceShapesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceShapes) EXPECT() *MockSourceShapesMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceShapes; create it with NewMockSourceShapes")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceShapes) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Box mocks base method.
+func (m *MockSourceShapes) Box() *Box[int] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceShapes; create it with NewMockSourceShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Box")
+	ret0, _ := ret[0].(*Box[int])
+	return ret0
+}
+
+// Box indicates an expected call of Box.
+func (mr *MockSourceShapesMockRecorder) Box() *MockSourceShapesBoxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Box", reflect.TypeOf((*MockSourceShapes)(nil).Box))
+	return &MockSourceShapesBoxCall{Call: call}
+}
+
+// MockSourceShapesBoxCall wrap *gomock.Call
+type MockSourceShapesBoxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceShapesBoxCall) Return(arg0 *Box[int]) *MockSourceShapesBoxCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceShapesBoxCall) Do(f func() *Box[int]) *MockSourceShapesBoxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceShapesBoxCall) DoAndReturn(f func() *Box[int]) *MockSourceShapesBoxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceShapesBoxCall) ReturnsInOrder(rets ...*Box[int]) *MockSourceShapesBoxCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Boxes mocks base method.
+func (m *MockSourceShapes) Boxes(arg0 ...*Box[[]*Pair[string, *User]]) [2]*Box[*Box[int]] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceShapes; create it with NewMockSourceShapes")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Boxes", varargs...)
+	ret0, _ := ret[0].([2]*Box[*Box[int]])
+	return ret0
+}
+
+// Boxes indicates an expected call of Boxes.
+func (mr *MockSourceShapesMockRecorder) Boxes(arg0 ...any) *MockSourceShapesBoxesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Boxes", reflect.TypeOf((*MockSourceShapes)(nil).Boxes), arg0...)
+	return &MockSourceShapesBoxesCall{Call: call}
+}
+
+// MockSourceShapesBoxesCall wrap *gomock.Call
+type MockSourceShapesBoxesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceShapesBoxesCall) Return(arg0 [2]*Box[*Box[int]]) *MockSourceShapesBoxesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceShapesBoxesCall) Do(f func(...*Box[[]*Pair[string, *User]]) [2]*Box[*Box[int]]) *MockSourceShapesBoxesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceShapesBoxesCall) DoAndReturn(f func(...*Box[[]*Pair[string, *User]]) [2]*Box[*Box[int]]) *MockSourceShapesBoxesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceShapesBoxesCall) ReturnsInOrder(rets ...[2]*Box[*Box[int]]) *MockSourceShapesBoxesCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Find mocks base method.
+func (m *MockSourceShapes) Find(name string) *result.Result[User, error] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceShapes; create it with NewMockSourceShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", name)
+	ret0, _ := ret[0].(*result.Result[User, error])
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockSourceShapesMockRecorder) Find(name any) *MockSourceShapesFindCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSourceShapes)(nil).Find), name)
+	return &MockSourceShapesFindCall{Call: call}
+}
+
+// MockSourceShapesFindCall wrap *gomock.Call
+type MockSourceShapesFindCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceShapesFindCall) Return(arg0 *result.Result[User, error]) *MockSourceShapesFindCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceShapesFindCall) Do(f func(string) *result.Result[User, error]) *MockSourceShapesFindCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceShapesFindCall) DoAndReturn(f func(string) *result.Result[User, error]) *MockSourceShapesFindCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceShapesFindCall) ReturnsInOrder(rets ...*result.Result[User, error]) *MockSourceShapesFindCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Nodes mocks base method.
+func (m *MockSourceShapes) Nodes() map[string]*Node[*User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceShapes; create it with NewMockSourceShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nodes")
+	ret0, _ := ret[0].(map[string]*Node[*User])
+	return ret0
+}
+
+// Nodes indicates an expected call of Nodes.
+func (mr *MockSourceShapesMockRecorder) Nodes() *MockSourceShapesNodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nodes", reflect.TypeOf((*MockSourceShapes)(nil).Nodes))
+	return &MockSourceShapesNodesCall{Call: call}
+}
+
+// MockSourceShapesNodesCall wrap *gomock.Call
+type MockSourceShapesNodesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceShapesNodesCall) Return(arg0 map[string]*Node[*User]) *MockSourceShapesNodesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceShapesNodesCall) Do(f func() map[string]*Node[*User]) *MockSourceShapesNodesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceShapesNodesCall) DoAndReturn(f func() map[string]*Node[*User]) *MockSourceShapesNodesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceShapesNodesCall) ReturnsInOrder(rets ...map[string]*Node[*User]) *MockSourceShapesNodesCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Pairs mocks base method.
+func (m *MockSourceShapes) Pairs() []*Pair[string, int] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceShapes; create it with NewMockSourceShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pairs")
+	ret0, _ := ret[0].([]*Pair[string, int])
+	return ret0
+}
+
+// Pairs indicates an expected call of Pairs.
+func (mr *MockSourceShapesMockRecorder) Pairs() *MockSourceShapesPairsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pairs", reflect.TypeOf((*MockSourceShapes)(nil).Pairs))
+	return &MockSourceShapesPairsCall{Call: call}
+}
+
+// MockSourceShapesPairsCall wrap *gomock.Call
+type MockSourceShapesPairsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceShapesPairsCall) Return(arg0 []*Pair[string, int]) *MockSourceShapesPairsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceShapesPairsCall) Do(f func() []*Pair[string, int]) *MockSourceShapesPairsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceShapesPairsCall) DoAndReturn(f func() []*Pair[string, int]) *MockSourceShapesPairsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceShapesPairsCall) ReturnsInOrder(rets ...[]*Pair[string, int]) *MockSourceShapesPairsCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Set mocks base method.
+func (m *MockSourceShapes) Set(arg0 *Pair[string, *Node[User]]) **Box[User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceShapes; create it with NewMockSourceShapes")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", arg0)
+	ret0, _ := ret[0].(**Box[User])
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockSourceShapesMockRecorder) Set(arg0 any) *MockSourceShapesSetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockSourceShapes)(nil).Set), arg0)
+	return &MockSourceShapesSetCall{Call: call}
+}
+
+// MockSourceShapesSetCall wrap *gomock.Call
+type MockSourceShapesSetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceShapesSetCall) Return(arg0 **Box[User]) *MockSourceShapesSetCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceShapesSetCall) Do(f func(*Pair[string, *Node[User]]) **Box[User]) *MockSourceShapesSetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceShapesSetCall) DoAndReturn(f func(*Pair[string, *Node[User]]) **Box[User]) *MockSourceShapesSetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceShapesSetCall) ReturnsInOrder(rets ...**Box[User]) *MockSourceShapesSetCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// MockSourceTree is a mock of Tree interface.
+type MockSourceTree[K comparable, V any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceTreeMockRecorder[K, V]
+}
+
+// MockSourceTreeMockRecorder is the mock recorder for MockSourceTree.
+type MockSourceTreeMockRecorder[K comparable, V any] struct {
+	mock *MockSourceTree[K, V]
+}
+
+// NewMockSourceTree creates a new mock instance.
+func NewMockSourceTree[K comparable, V any](ctrl *gomock.Controller) *MockSourceTree[K, V] {
+	mock := &MockSourceTree[K, V]{ctrl: ctrl}
+	mock.recorder = &MockSourceTreeMockRecorder[K, V]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceTree[K, V]) EXPECT() *MockSourceTreeMockRecorder[K, V] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceTree; create it with NewMockSourceTree")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceTree[K, V]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Get mocks base method.
+func (m *MockSourceTree[K, V]) Get(arg0 K) *result.Result[*Node[V], error] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceTree; create it with NewMockSourceTree")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*result.Result[*Node[V], error])
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSourceTreeMockRecorder[K, V]) Get(arg0 any) *MockSourceTreeGetCall[K, V] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSourceTree[K, V])(nil).Get), arg0)
+	return &MockSourceTreeGetCall[K, V]{Call: call}
+}
+
+// MockSourceTreeGetCall wrap *gomock.Call
+type MockSourceTreeGetCall[K comparable, V any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceTreeGetCall[K, V]) Return(arg0 *result.Result[*Node[V], error]) *MockSourceTreeGetCall[K, V] {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceTreeGetCall[K, V]) Do(f func(K) *result.Result[*Node[V], error]) *MockSourceTreeGetCall[K, V] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceTreeGetCall[K, V]) DoAndReturn(f func(K) *result.Result[*Node[V], error]) *MockSourceTreeGetCall[K, V] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceTreeGetCall[K, V]) ReturnsInOrder(rets ...*result.Result[*Node[V], error]) *MockSourceTreeGetCall[K, V] {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Nodes mocks base method.
+func (m *MockSourceTree[K, V]) Nodes() map[K]*Node[V] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceTree; create it with NewMockSourceTree")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nodes")
+	ret0, _ := ret[0].(map[K]*Node[V])
+	return ret0
+}
+
+// Nodes indicates an expected call of Nodes.
+func (mr *MockSourceTreeMockRecorder[K, V]) Nodes() *MockSourceTreeNodesCall[K, V] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nodes", reflect.TypeOf((*MockSourceTree[K, V])(nil).Nodes))
+	return &MockSourceTreeNodesCall[K, V]{Call: call}
+}
+
+// MockSourceTreeNodesCall wrap *gomock.Call
+type MockSourceTreeNodesCall[K comparable, V any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceTreeNodesCall[K, V]) Return(arg0 map[K]*Node[V]) *MockSourceTreeNodesCall[K, V] {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceTreeNodesCall[K, V]) Do(f func() map[K]*Node[V]) *MockSourceTreeNodesCall[K, V] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceTreeNodesCall[K, V]) DoAndReturn(f func() map[K]*Node[V]) *MockSourceTreeNodesCall[K, V] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceTreeNodesCall[K, V]) ReturnsInOrder(rets ...map[K]*Node[V]) *MockSourceTreeNodesCall[K, V] {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Options mocks base method.
+func (m *MockSourceTree[K, V]) Options() []*Box[V] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceTree; create it with NewMockSourceTree")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Options")
+	ret0, _ := ret[0].([]*Box[V])
+	return ret0
+}
+
+// Options indicates an expected call of Options.
+func (mr *MockSourceTreeMockRecorder[K, V]) Options() *MockSourceTreeOptionsCall[K, V] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Options", reflect.TypeOf((*MockSourceTree[K, V])(nil).Options))
+	return &MockSourceTreeOptionsCall[K, V]{Call: call}
+}
+
+// MockSourceTreeOptionsCall wrap *gomock.Call
+type MockSourceTreeOptionsCall[K comparable, V any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceTreeOptionsCall[K, V]) Return(arg0 []*Box[V]) *MockSourceTreeOptionsCall[K, V] {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceTreeOptionsCall[K, V]) Do(f func() []*Box[V]) *MockSourceTreeOptionsCall[K, V] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceTreeOptionsCall[K, V]) DoAndReturn(f func() []*Box[V]) *MockSourceTreeOptionsCall[K, V] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceTreeOptionsCall[K, V]) ReturnsInOrder(rets ...[]*Box[V]) *MockSourceTreeOptionsCall[K, V] {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Put mocks base method.
+func (m *MockSourceTree[K, V]) Put(arg0 map[K]*Node[V], arg1 ...*Pair[K, *V]) []*Pair[K, *Node[V]] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceTree; create it with NewMockSourceTree")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Put", varargs...)
+	ret0, _ := ret[0].([]*Pair[K, *Node[V]])
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockSourceTreeMockRecorder[K, V]) Put(arg0 any, arg1 ...any) *MockSourceTreePutCall[K, V] {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockSourceTree[K, V])(nil).Put), varargs...)
+	return &MockSourceTreePutCall[K, V]{Call: call}
+}
+
+// MockSourceTreePutCall wrap *gomock.Call
+type MockSourceTreePutCall[K comparable, V any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSourceTreePutCall[K, V]) Return(arg0 []*Pair[K, *Node[V]]) *MockSourceTreePutCall[K, V] {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSourceTreePutCall[K, V]) Do(f func(map[K]*Node[V], ...*Pair[K, *V]) []*Pair[K, *Node[V]]) *MockSourceTreePutCall[K, V] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSourceTreePutCall[K, V]) DoAndReturn(f func(map[K]*Node[V], ...*Pair[K, *V]) []*Pair[K, *Node[V]]) *MockSourceTreePutCall[K, V] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockSourceTreePutCall[K, V]) ReturnsInOrder(rets ...[]*Pair[K, *Node[V]]) *MockSourceTreePutCall[K, V] {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
@@ -0,0 +1,22 @@
+// Package container has generic types used by the interfaces of its parent.
+package container
+
+// List is a generic list.
+type List[T any] struct {
+	Items []T
+}
+
+// Set is a generic set.
+type Set[T comparable] map[T]struct{}
+
+// Option holds a value or nothing.
+type Option[T any] struct {
+	Value T
+	Valid bool
+}
+
+// Pair holds two values.
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
@@ -0,0 +1,23 @@
+// Package generic_returns has interfaces whose methods take and return
+// instantiations of generic types, nested and with type arguments from other
+// packages.
+package generic_returns
+
+import (
+	"go.uber.org/mock/mockgen/internal/tests/generic_returns/container"
+	otheruser "go.uber.org/mock/mockgen/internal/tests/generic_returns/other/user"
+	"go.uber.org/mock/mockgen/internal/tests/generic_returns/user"
+)
+
+//go:generate mockgen -package generic_returns -destination source_mock.go -source input.go -mock_names Users=MockSourceUsers
+//go:generate mockgen -package generic_returns -destination reflect_mock.go . Users
+
+// Users returns instantiations of generic types.
+type Users interface {
+	List() *container.List[user.User]
+	Tags() map[string]container.Set[int]
+	Find(name string) container.Option[*user.User]
+	Groups(container.Option[container.List[user.User]]) []container.Pair[string, container.List[*user.User]]
+	Index() container.List[map[otheruser.ID][]user.User]
+	Stream(container.Option[chan<- container.Pair[otheruser.ID, [2]int]]) container.Set[otheruser.ID]
+}
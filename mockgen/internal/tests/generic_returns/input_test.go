@@ -0,0 +1,40 @@
+package generic_returns
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/generic_returns/container"
+	otheruser "go.uber.org/mock/mockgen/internal/tests/generic_returns/other/user"
+	"go.uber.org/mock/mockgen/internal/tests/generic_returns/user"
+)
+
+var (
+	_ Users = (*MockUsers)(nil)
+	_ Users = (*MockSourceUsers)(nil)
+)
+
+func TestGenericReturns(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	users := NewMockUsers(ctrl)
+	ann := &user.User{Name: "Ann"}
+	users.EXPECT().Find("Ann").Return(container.Option[*user.User]{Value: ann, Valid: true})
+	if got := users.Find("Ann"); !got.Valid || got.Value != ann {
+		t.Errorf("Find() = %v, want Ann", got)
+	}
+
+	index := container.List[map[otheruser.ID][]user.User]{
+		Items: []map[otheruser.ID][]user.User{{"a": {*ann}}},
+	}
+	users.EXPECT().Index().Return(index)
+	if got := users.Index(); len(got.Items) != 1 || got.Items[0]["a"][0].Name != "Ann" {
+		t.Errorf("Index() = %v, want %v", got, index)
+	}
+
+	source := NewMockSourceUsers(ctrl)
+	source.EXPECT().Tags().Return(map[string]container.Set[int]{"a": {1: {}}})
+	if got := source.Tags(); len(got["a"]) != 1 {
+		t.Errorf("Tags() = %v, want one tag", got)
+	}
+}
@@ -0,0 +1,5 @@
+// Package user has a type of the same package name as ../../user.
+package user
+
+// ID identifies a user.
+type ID string
@@ -0,0 +1,152 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/generic_returns (interfaces: Users)
+//
+// Generated by this command:
+//
+//	mockgen -package generic_returns -destination reflect_mock.go . Users
+//
+
+// Package generic_returns is a generated GoMock package.
+package generic_returns
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	container "go.uber.org/mock/mockgen/internal/tests/generic_returns/container"
+	user "go.uber.org/mock/mockgen/internal/tests/generic_returns/other/user"
+	user0 "go.uber.org/mock/mockgen/internal/tests/generic_returns/user"
+)
+
+// MockUsers is a mock of Users interface.
+type MockUsers struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsersMockRecorder
+}
+
+// MockUsersMockRecorder is the mock recorder for MockUsers.
+type MockUsersMockRecorder struct {
+	mock *MockUsers
+}
+
+// NewMockUsers creates a new mock instance.
+func NewMockUsers(ctrl *gomock.Controller) *MockUsers {
+	mock := &MockUsers{ctrl: ctrl}
+	mock.recorder = &MockUsersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsers) EXPECT() *MockUsersMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockUsers; create it with NewMockUsers")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockUsers) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Find mocks base method.
+func (m *MockUsers) Find(arg0 string) container.Option[*user0.User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockUsers; create it with NewMockUsers")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", arg0)
+	ret0, _ := ret[0].(container.Option[*user0.User])
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockUsersMockRecorder) Find(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockUsers)(nil).Find), arg0)
+}
+
+// Groups mocks base method.
+func (m *MockUsers) Groups(arg0 container.Option[container.List[user0.User]]) []container.Pair[string, container.List[*user0.User]] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockUsers; create it with NewMockUsers")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Groups", arg0)
+	ret0, _ := ret[0].([]container.Pair[string, container.List[*user0.User]])
+	return ret0
+}
+
+// Groups indicates an expected call of Groups.
+func (mr *MockUsersMockRecorder) Groups(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Groups", reflect.TypeOf((*MockUsers)(nil).Groups), arg0)
+}
+
+// Index mocks base method.
+func (m *MockUsers) Index() container.List[map[user.ID][]user0.User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockUsers; create it with NewMockUsers")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Index")
+	ret0, _ := ret[0].(container.List[map[user.ID][]user0.User])
+	return ret0
+}
+
+// Index indicates an expected call of Index.
+func (mr *MockUsersMockRecorder) Index() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Index", reflect.TypeOf((*MockUsers)(nil).Index))
+}
+
+// List mocks base method.
+func (m *MockUsers) List() *container.List[user0.User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockUsers; create it with NewMockUsers")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].(*container.List[user0.User])
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockUsersMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUsers)(nil).List))
+}
+
+// Stream mocks base method.
+func (m *MockUsers) Stream(arg0 container.Option[chan<- container.Pair[user.ID, [2]int]]) container.Set[user.ID] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockUsers; create it with NewMockUsers")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", arg0)
+	ret0, _ := ret[0].(container.Set[user.ID])
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockUsersMockRecorder) Stream(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockUsers)(nil).Stream), arg0)
+}
+
+// Tags mocks base method.
+func (m *MockUsers) Tags() map[string]container.Set[int] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockUsers; create it with NewMockUsers")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tags")
+	ret0, _ := ret[0].(map[string]container.Set[int])
+	return ret0
+}
+
+// Tags indicates an expected call of Tags.
+func (mr *MockUsersMockRecorder) Tags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tags", reflect.TypeOf((*MockUsers)(nil).Tags))
+}
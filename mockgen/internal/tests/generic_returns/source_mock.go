@@ -0,0 +1,152 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package generic_returns -destination source_mock.go -source input.go -mock_names Users=MockSourceUsers
+//
+
+// Package generic_returns is a generated GoMock package.
+package generic_returns
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	container "go.uber.org/mock/mockgen/internal/tests/generic_returns/container"
+	user "go.uber.org/mock/mockgen/internal/tests/generic_returns/other/user"
+	user0 "go.uber.org/mock/mockgen/internal/tests/generic_returns/user"
+)
+
+// MockSourceUsers is a mock of Users interface.
+type MockSourceUsers struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceUsersMockRecorder
+}
+
+// MockSourceUsersMockRecorder is the mock recorder for MockSourceUsers.
+type MockSourceUsersMockRecorder struct {
+	mock *MockSourceUsers
+}
+
+// NewMockSourceUsers creates a new mock instance.
+func NewMockSourceUsers(ctrl *gomock.Controller) *MockSourceUsers {
+	mock := &MockSourceUsers{ctrl: ctrl}
+	mock.recorder = &MockSourceUsersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceUsers) EXPECT() *MockSourceUsersMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceUsers; create it with NewMockSourceUsers")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceUsers) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Find mocks base method.
+func (m *MockSourceUsers) Find(name string) container.Option[*user0.User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceUsers; create it with NewMockSourceUsers")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", name)
+	ret0, _ := ret[0].(container.Option[*user0.User])
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockSourceUsersMockRecorder) Find(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSourceUsers)(nil).Find), name)
+}
+
+// Groups mocks base method.
+func (m *MockSourceUsers) Groups(arg0 container.Option[container.List[user0.User]]) []container.Pair[string, container.List[*user0.User]] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceUsers; create it with NewMockSourceUsers")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Groups", arg0)
+	ret0, _ := ret[0].([]container.Pair[string, container.List[*user0.User]])
+	return ret0
+}
+
+// Groups indicates an expected call of Groups.
+func (mr *MockSourceUsersMockRecorder) Groups(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Groups", reflect.TypeOf((*MockSourceUsers)(nil).Groups), arg0)
+}
+
+// Index mocks base method.
+func (m *MockSourceUsers) Index() container.List[map[user.ID][]user0.User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceUsers; create it with NewMockSourceUsers")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Index")
+	ret0, _ := ret[0].(container.List[map[user.ID][]user0.User])
+	return ret0
+}
+
+// Index indicates an expected call of Index.
+func (mr *MockSourceUsersMockRecorder) Index() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Index", reflect.TypeOf((*MockSourceUsers)(nil).Index))
+}
+
+// List mocks base method.
+func (m *MockSourceUsers) List() *container.List[user0.User] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceUsers; create it with NewMockSourceUsers")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].(*container.List[user0.User])
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockSourceUsersMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSourceUsers)(nil).List))
+}
+
+// Stream mocks base method.
+func (m *MockSourceUsers) Stream(arg0 container.Option[chan<- container.Pair[user.ID, [2]int]]) container.Set[user.ID] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceUsers; create it with NewMockSourceUsers")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", arg0)
+	ret0, _ := ret[0].(container.Set[user.ID])
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockSourceUsersMockRecorder) Stream(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockSourceUsers)(nil).Stream), arg0)
+}
+
+// Tags mocks base method.
+func (m *MockSourceUsers) Tags() map[string]container.Set[int] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceUsers; create it with NewMockSourceUsers")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tags")
+	ret0, _ := ret[0].(map[string]container.Set[int])
+	return ret0
+}
+
+// Tags indicates an expected call of Tags.
+func (mr *MockSourceUsersMockRecorder) Tags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tags", reflect.TypeOf((*MockSourceUsers)(nil).Tags))
+}
@@ -0,0 +1,7 @@
+// Package user has a type used as a type argument.
+package user
+
+// User is a user.
+type User struct {
+	Name string
+}
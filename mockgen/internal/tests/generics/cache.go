@@ -0,0 +1,30 @@
+package generics
+
+import (
+	"fmt"
+
+	"go.uber.org/mock/mockgen/internal/tests/generics/other"
+	"golang.org/x/exp/constraints"
+)
+
+//go:generate mockgen --source=cache.go --destination=source/mock_cache_mock.go --package source
+
+// Cache has several type parameters constrained by an external package.
+type Cache[K comparable, V constraints.Ordered] interface {
+	Get(K) (V, bool)
+	Set(K, V)
+	Max() V
+}
+
+// Index has union and approximation constraints.
+type Index[K constraints.Integer | ~string, S ~[]V, V fmt.Stringer] interface {
+	Lookup(K) S
+}
+
+// Store has an unnamed interface constraint and a generic one.
+type Store[K interface {
+	comparable
+	fmt.Stringer
+}, V other.Either[K, int, string, bool]] interface {
+	Load(K) V
+}
@@ -0,0 +1,205 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cache.go
+//
+// Generated by this command:
+//
+//	mockgen --source=cache.go --destination=source/mock_cache_mock.go --package source
+//
+
+// Package source is a generated GoMock package.
+package source
+
+import (
+	fmt "fmt"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	other "go.uber.org/mock/mockgen/internal/tests/generics/other"
+	constraints "golang.org/x/exp/constraints"
+)
+
+// MockCache is a mock of Cache interface.
+type MockCache[K comparable, V constraints.Ordered] struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMockRecorder[K, V]
+}
+
+// MockCacheMockRecorder is the mock recorder for MockCache.
+type MockCacheMockRecorder[K comparable, V constraints.Ordered] struct {
+	mock *MockCache[K, V]
+}
+
+// NewMockCache creates a new mock instance.
+func NewMockCache[K comparable, V constraints.Ordered](ctrl *gomock.Controller) *MockCache[K, V] {
+	mock := &MockCache[K, V]{ctrl: ctrl}
+	mock.recorder = &MockCacheMockRecorder[K, V]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCache[K, V]) EXPECT() *MockCacheMockRecorder[K, V] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockCache[K, V]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Get mocks base method.
+func (m *MockCache[K, V]) Get(arg0 K) (V, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(V)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCacheMockRecorder[K, V]) Get(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCache[K, V])(nil).Get), arg0)
+}
+
+// Max mocks base method.
+func (m *MockCache[K, V]) Max() V {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Max")
+	ret0, _ := ret[0].(V)
+	return ret0
+}
+
+// Max indicates an expected call of Max.
+func (mr *MockCacheMockRecorder[K, V]) Max() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Max", reflect.TypeOf((*MockCache[K, V])(nil).Max))
+}
+
+// Set mocks base method.
+func (m *MockCache[K, V]) Set(arg0 K, arg1 V) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", arg0, arg1)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockCacheMockRecorder[K, V]) Set(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCache[K, V])(nil).Set), arg0, arg1)
+}
+
+// MockIndex is a mock of Index interface.
+type MockIndex[K constraints.Integer | ~string, S ~[]V, V fmt.Stringer] struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndexMockRecorder[K, S, V]
+}
+
+// MockIndexMockRecorder is the mock recorder for MockIndex.
+type MockIndexMockRecorder[K constraints.Integer | ~string, S ~[]V, V fmt.Stringer] struct {
+	mock *MockIndex[K, S, V]
+}
+
+// NewMockIndex creates a new mock instance.
+func NewMockIndex[K constraints.Integer | ~string, S ~[]V, V fmt.Stringer](ctrl *gomock.Controller) *MockIndex[K, S, V] {
+	mock := &MockIndex[K, S, V]{ctrl: ctrl}
+	mock.recorder = &MockIndexMockRecorder[K, S, V]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIndex[K, S, V]) EXPECT() *MockIndexMockRecorder[K, S, V] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockIndex[K, S, V]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Lookup mocks base method.
+func (m *MockIndex[K, S, V]) Lookup(arg0 K) S {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lookup", arg0)
+	ret0, _ := ret[0].(S)
+	return ret0
+}
+
+// Lookup indicates an expected call of Lookup.
+func (mr *MockIndexMockRecorder[K, S, V]) Lookup(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lookup", reflect.TypeOf((*MockIndex[K, S, V])(nil).Lookup), arg0)
+}
+
+// MockStore is a mock of Store interface.
+type MockStore[K interface {
+	comparable
+	fmt.Stringer
+}, V other.Either[K, int, string, bool]] struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder[K, V]
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder[K interface {
+	comparable
+	fmt.Stringer
+}, V other.Either[K, int, string, bool]] struct {
+	mock *MockStore[K, V]
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore[K interface {
+	comparable
+	fmt.Stringer
+}, V other.Either[K, int, string, bool]](ctrl *gomock.Controller) *MockStore[K, V] {
+	mock := &MockStore[K, V]{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder[K, V]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore[K, V]) EXPECT() *MockStoreMockRecorder[K, V] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockStore[K, V]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Load mocks base method.
+func (m *MockStore[K, V]) Load(arg0 K) V {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", arg0)
+	ret0, _ := ret[0].(V)
+	return ret0
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockStoreMockRecorder[K, V]) Load(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockStore[K, V])(nil).Load), arg0)
+}
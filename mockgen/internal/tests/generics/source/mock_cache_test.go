@@ -0,0 +1,41 @@
+package source
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/generics"
+	"go.uber.org/mock/mockgen/internal/tests/generics/other"
+)
+
+type key string
+
+func (k key) String() string { return string(k) }
+
+var (
+	_ generics.Cache[string, float64]                           = (*MockCache[string, float64])(nil)
+	_ generics.Index[uint8, []key, key]                         = (*MockIndex[uint8, []key, key])(nil)
+	_ generics.Store[key, other.Either[key, int, string, bool]] = (*MockStore[key, other.Either[key, int, string, bool]])(nil)
+)
+
+func TestMockCache_Max(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	m := NewMockCache[string, float64](ctrl)
+	m.EXPECT().Set("a", 1.5)
+	m.EXPECT().Max().Return(1.5)
+	m.Set("a", 1.5)
+	if v := m.Max(); v != 1.5 {
+		t.Errorf("Max() = %v, want %v", v, 1.5)
+	}
+}
+
+func TestMockIndex_Lookup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	m := NewMockIndex[key, []key, key](ctrl)
+	m.EXPECT().Lookup(key("a")).Return([]key{"b"})
+	if v := m.Lookup("a"); len(v) != 1 || v[0] != "b" {
+		t.Errorf("Lookup() = %v, want %v", v, []key{"b"})
+	}
+}
@@ -0,0 +1,16 @@
+package gostring
+
+//go:generate mockgen -package gostring -destination mock.go -source input.go -gostring
+
+type Store interface {
+	Get(key string) (string, error)
+}
+
+type Cache[V any] interface {
+	Load(key string) (V, bool)
+}
+
+// Value has a GoString method, which its mock mocks.
+type Value interface {
+	GoString() string
+}
@@ -0,0 +1,26 @@
+package gostring
+
+import (
+	"fmt"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestGoString(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	deps := struct {
+		Store *MockStore
+		Cache *MockCache[int]
+	}{NewMockStore(ctrl), NewMockCache[int](ctrl)}
+	if got, want := fmt.Sprintf("%#v", deps), "struct { Store *gostring.MockStore; Cache *gostring.MockCache[int] }{Store:MockStore{}, Cache:MockCache{}}"; got != want {
+		t.Errorf("%%#v = %s, want %s", got, want)
+	}
+
+	value := NewMockValue(ctrl)
+	value.EXPECT().GoString().Return("Value{}")
+	if got := fmt.Sprintf("%#v", value); got != "Value{}" {
+		t.Errorf("%%#v of a mock mocking GoString = %s, want Value{}", got)
+	}
+}
@@ -37,11 +37,22 @@ func NewMockSource(ctrl *gomock.Controller) *MockSource {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockSource) EXPECT() *MockSourceMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSource; create it with NewMockSource")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSource) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Bar mocks base method.
 func (m *MockSource) Bar() Baz {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSource; create it with NewMockSource")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Bar")
 	ret0, _ := ret[0].(Baz)
@@ -56,6 +67,9 @@ func (mr *MockSourceMockRecorder) Bar() *gomock.Call {
 
 // Error mocks base method.
 func (m *MockSource) Error() string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSource; create it with NewMockSource")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Error")
 	ret0, _ := ret[0].(string)
@@ -70,6 +84,9 @@ func (mr *MockSourceMockRecorder) Error() *gomock.Call {
 
 // Ersatz mocks base method.
 func (m *MockSource) Ersatz() ersatz.Return {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSource; create it with NewMockSource")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Ersatz")
 	ret0, _ := ret[0].(ersatz.Return)
@@ -84,6 +101,9 @@ func (mr *MockSourceMockRecorder) Ersatz() *gomock.Call {
 
 // OtherErsatz mocks base method.
 func (m *MockSource) OtherErsatz() ersatz0.Return {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSource; create it with NewMockSource")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "OtherErsatz")
 	ret0, _ := ret[0].(ersatz0.Return)
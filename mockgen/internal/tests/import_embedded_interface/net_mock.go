@@ -36,11 +36,22 @@ func NewMockNet(ctrl *gomock.Controller) *MockNet {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockNet) EXPECT() *MockNetMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockNet; create it with NewMockNet")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockNet) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Header mocks base method.
 func (m *MockNet) Header() http.Header {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockNet; create it with NewMockNet")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Header")
 	ret0, _ := ret[0].(http.Header)
@@ -55,6 +66,9 @@ func (mr *MockNetMockRecorder) Header() *gomock.Call {
 
 // Write mocks base method.
 func (m *MockNet) Write(arg0 []byte) (int, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockNet; create it with NewMockNet")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Write", arg0)
 	ret0, _ := ret[0].(int)
@@ -70,6 +84,9 @@ func (mr *MockNetMockRecorder) Write(arg0 any) *gomock.Call {
 
 // WriteHeader mocks base method.
 func (m *MockNet) WriteHeader(statusCode int) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockNet; create it with NewMockNet")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "WriteHeader", statusCode)
 }
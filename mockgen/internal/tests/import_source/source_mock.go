@@ -36,11 +36,22 @@ func NewMockS(ctrl *gomock.Controller) *MockS {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockS) EXPECT() *MockSMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockS; create it with NewMockS")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockS) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // F mocks base method.
 func (m *MockS) F(arg0 source.X) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockS; create it with NewMockS")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "F", arg0)
 }
@@ -0,0 +1,19 @@
+package inline_interface
+
+//go:generate mockgen -package inline_interface -destination mock.go -source input.go
+//go:generate mockgen -destination mock_reflect/mock.go . Doer
+
+import "io"
+
+// Doer has parameters and results of unnamed interface types.
+type Doer interface {
+	Do(r interface{ Read([]byte) (int, error) }) error
+	Close(c interface {
+		io.Closer
+		Name() string
+	})
+	Open(name string) interface {
+		io.ReadCloser
+		Stat(...string) (map[string]int, error)
+	}
+}
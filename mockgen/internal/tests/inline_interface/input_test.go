@@ -0,0 +1,25 @@
+package inline_interface
+
+import (
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/inline_interface/mock_reflect"
+)
+
+var (
+	_ Doer = (*MockDoer)(nil)
+	_ Doer = (*mock_inline_interface.MockDoer)(nil)
+)
+
+func TestInlineInterfaceParameter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := NewMockDoer(ctrl)
+
+	r := strings.NewReader("abc")
+	m.EXPECT().Do(r).Return(nil)
+	if err := m.Do(r); err != nil {
+		t.Errorf("Do() = %v, want nil", err)
+	}
+}
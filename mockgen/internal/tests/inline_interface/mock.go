@@ -0,0 +1,106 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package inline_interface -destination mock.go -source input.go
+//
+
+// Package inline_interface is a generated GoMock package.
+package inline_interface
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDoer is a mock of Doer interface.
+type MockDoer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDoerMockRecorder
+}
+
+// MockDoerMockRecorder is the mock recorder for MockDoer.
+type MockDoerMockRecorder struct {
+	mock *MockDoer
+}
+
+// NewMockDoer creates a new mock instance.
+func NewMockDoer(ctrl *gomock.Controller) *MockDoer {
+	mock := &MockDoer{ctrl: ctrl}
+	mock.recorder = &MockDoerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDoer) EXPECT() *MockDoerMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockDoer) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Close mocks base method.
+func (m *MockDoer) Close(c interface {
+	io.Closer
+	Name() string
+}) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close", c)
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockDoerMockRecorder) Close(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDoer)(nil).Close), c)
+}
+
+// Do mocks base method.
+func (m *MockDoer) Do(r interface{ Read([]byte) (int, error) }) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDoerMockRecorder) Do(r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDoer)(nil).Do), r)
+}
+
+// Open mocks base method.
+func (m *MockDoer) Open(name string) interface {
+	io.ReadCloser
+	Stat(...string) (map[string]int, error)
+} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", name)
+	ret0, _ := ret[0].(interface {
+		io.ReadCloser
+		Stat(...string) (map[string]int, error)
+	})
+	return ret0
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockDoerMockRecorder) Open(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockDoer)(nil).Open), name)
+}
@@ -0,0 +1,107 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/inline_interface (interfaces: Doer)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_reflect/mock.go . Doer
+//
+
+// Package mock_inline_interface is a generated GoMock package.
+package mock_inline_interface
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDoer is a mock of Doer interface.
+type MockDoer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDoerMockRecorder
+}
+
+// MockDoerMockRecorder is the mock recorder for MockDoer.
+type MockDoerMockRecorder struct {
+	mock *MockDoer
+}
+
+// NewMockDoer creates a new mock instance.
+func NewMockDoer(ctrl *gomock.Controller) *MockDoer {
+	mock := &MockDoer{ctrl: ctrl}
+	mock.recorder = &MockDoerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDoer) EXPECT() *MockDoerMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockDoer) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Close mocks base method.
+func (m *MockDoer) Close(arg0 interface {
+	Close() error
+	Name() string
+}) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close", arg0)
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockDoerMockRecorder) Close(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDoer)(nil).Close), arg0)
+}
+
+// Do mocks base method.
+func (m *MockDoer) Do(arg0 interface{ Read([]byte) (int, error) }) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDoerMockRecorder) Do(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDoer)(nil).Do), arg0)
+}
+
+// Open mocks base method.
+func (m *MockDoer) Open(arg0 string) interface {
+	Close() error
+	Read([]byte) (int, error)
+	Stat(...string) (map[string]int, error)
+} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0)
+	ret0, _ := ret[0].(interface {
+		Close() error
+		Read([]byte) (int, error)
+		Stat(...string) (map[string]int, error)
+	})
+	return ret0
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockDoerMockRecorder) Open(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockDoer)(nil).Open), arg0)
+}
@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/interface_collections (interfaces: Dispatcher)
+//
+// Generated by this command:
+//
+//	mockgen -package interface_collections -destination export_data_mock.go -export_data -mock_names Dispatcher=MockExportDataDispatcher . Dispatcher
+//
+
+// Package interface_collections is a generated GoMock package.
+package interface_collections
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExportDataDispatcher is a mock of Dispatcher interface.
+type MockExportDataDispatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportDataDispatcherMockRecorder
+}
+
+// MockExportDataDispatcherMockRecorder is the mock recorder for MockExportDataDispatcher.
+type MockExportDataDispatcherMockRecorder struct {
+	mock *MockExportDataDispatcher
+}
+
+// NewMockExportDataDispatcher creates a new mock instance.
+func NewMockExportDataDispatcher(ctrl *gomock.Controller) *MockExportDataDispatcher {
+	mock := &MockExportDataDispatcher{ctrl: ctrl}
+	mock.recorder = &MockExportDataDispatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportDataDispatcher) EXPECT() *MockExportDataDispatcherMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataDispatcher; create it with NewMockExportDataDispatcher")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockExportDataDispatcher) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Chain mocks base method.
+func (m *MockExportDataDispatcher) Chain(arg0 ...[]Handler) []interface{ Handle(string) error } {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataDispatcher; create it with NewMockExportDataDispatcher")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Chain", varargs...)
+	ret0, _ := ret[0].([]interface{ Handle(string) error })
+	return ret0
+}
+
+// Chain indicates an expected call of Chain.
+func (mr *MockExportDataDispatcherMockRecorder) Chain(arg0 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chain", reflect.TypeOf((*MockExportDataDispatcher)(nil).Chain), arg0...)
+}
+
+// Do mocks base method.
+func (m *MockExportDataDispatcher) Do(arg0 []Handler) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataDispatcher; create it with NewMockExportDataDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockExportDataDispatcherMockRecorder) Do(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockExportDataDispatcher)(nil).Do), arg0)
+}
+
+// Optional mocks base method.
+func (m *MockExportDataDispatcher) Optional(arg0 []Option[Handler]) map[string][]Option[io.Reader] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataDispatcher; create it with NewMockExportDataDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Optional", arg0)
+	ret0, _ := ret[0].(map[string][]Option[io.Reader])
+	return ret0
+}
+
+// Optional indicates an expected call of Optional.
+func (mr *MockExportDataDispatcherMockRecorder) Optional(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Optional", reflect.TypeOf((*MockExportDataDispatcher)(nil).Optional), arg0)
+}
+
+// Read mocks base method.
+func (m *MockExportDataDispatcher) Read(arg0 []io.Reader) ([]io.Reader, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataDispatcher; create it with NewMockExportDataDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0)
+	ret0, _ := ret[0].([]io.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockExportDataDispatcherMockRecorder) Read(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockExportDataDispatcher)(nil).Read), arg0)
+}
+
+// Route mocks base method.
+func (m *MockExportDataDispatcher) Route(arg0 map[string]Handler) map[Handler]int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataDispatcher; create it with NewMockExportDataDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Route", arg0)
+	ret0, _ := ret[0].(map[Handler]int)
+	return ret0
+}
+
+// Route indicates an expected call of Route.
+func (mr *MockExportDataDispatcherMockRecorder) Route(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Route", reflect.TypeOf((*MockExportDataDispatcher)(nil).Route), arg0)
+}
+
+// Stages mocks base method.
+func (m *MockExportDataDispatcher) Stages(arg0 [][]Handler, arg1 [2]Handler) [][]Handler {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataDispatcher; create it with NewMockExportDataDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stages", arg0, arg1)
+	ret0, _ := ret[0].([][]Handler)
+	return ret0
+}
+
+// Stages indicates an expected call of Stages.
+func (mr *MockExportDataDispatcherMockRecorder) Stages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stages", reflect.TypeOf((*MockExportDataDispatcher)(nil).Stages), arg0, arg1)
+}
@@ -0,0 +1,30 @@
+// Package interface_collections has an interface whose methods take and
+// return slices, maps and arrays of interfaces and generic instantiations.
+package interface_collections
+
+//go:generate mockgen -package interface_collections -destination source_mock.go -source input.go -mock_names Dispatcher=MockSourceDispatcher,Handler=MockSourceHandler
+//go:generate mockgen -package interface_collections -destination reflect_mock.go -mock_names Dispatcher=MockReflectDispatcher . Dispatcher
+//go:generate mockgen -package interface_collections -destination export_data_mock.go -export_data -mock_names Dispatcher=MockExportDataDispatcher . Dispatcher
+//go:generate mockgen -destination mock_interface_collections/mock.go . Dispatcher
+
+import "io"
+
+// Handler handles a request.
+type Handler interface {
+	Handle(req string) error
+}
+
+// Option is an optional value.
+type Option[T any] struct {
+	Value T
+	Valid bool
+}
+
+type Dispatcher interface {
+	Do(handlers []Handler) error
+	Read(readers []io.Reader) ([]io.Reader, error)
+	Route(routes map[string]Handler) map[Handler]int
+	Stages(stages [][]Handler, fixed [2]Handler) [][]Handler
+	Optional(handlers []Option[Handler]) map[string][]Option[io.Reader]
+	Chain(handlers ...[]Handler) []interface{ Handler }
+}
@@ -0,0 +1,71 @@
+package interface_collections_test
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/interface_collections"
+	"go.uber.org/mock/mockgen/internal/tests/interface_collections/mock_interface_collections"
+)
+
+type handler string
+
+func (h handler) Handle(string) error { return nil }
+
+var (
+	_ interface_collections.Dispatcher = (*interface_collections.MockSourceDispatcher)(nil)
+	_ interface_collections.Dispatcher = (*interface_collections.MockReflectDispatcher)(nil)
+	_ interface_collections.Dispatcher = (*interface_collections.MockExportDataDispatcher)(nil)
+	_ interface_collections.Dispatcher = (*mock_interface_collections.MockDispatcher)(nil)
+)
+
+func TestInterfaceCollections(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	a, b := handler("a"), handler("b")
+	r := strings.NewReader("r")
+
+	for _, d := range []interface_collections.Dispatcher{
+		interface_collections.NewMockSourceDispatcher(ctrl),
+		interface_collections.NewMockReflectDispatcher(ctrl),
+		interface_collections.NewMockExportDataDispatcher(ctrl),
+		mock_interface_collections.NewMockDispatcher(ctrl),
+	} {
+		// All the mocks have the same recorder methods, which take any.
+		recorder := reflect.ValueOf(d).MethodByName("EXPECT").Call(nil)[0]
+		expect := func(method string, args ...any) *gomock.Call {
+			in := make([]reflect.Value, len(args))
+			for i, arg := range args {
+				in[i] = reflect.ValueOf(arg)
+			}
+			return recorder.MethodByName(method).Call(in)[0].Interface().(*gomock.Call)
+		}
+		expect("Do", []interface_collections.Handler{a, b}).Return(nil)
+		expect("Read", []io.Reader{r}).Return([]io.Reader{r}, nil)
+		expect("Route", map[string]interface_collections.Handler{"a": a}).Return(map[interface_collections.Handler]int{a: 1})
+		expect("Stages", [][]interface_collections.Handler{{a}, {b}}, [2]interface_collections.Handler{a, b}).Return([][]interface_collections.Handler{{b}})
+		expect("Optional", []interface_collections.Option[interface_collections.Handler]{{Value: a, Valid: true}}).Return(map[string][]interface_collections.Option[io.Reader]{"r": {{Value: r}}})
+		expect("Chain", []interface_collections.Handler{a}, []interface_collections.Handler{b}).Return([]interface{ interface_collections.Handler }{b})
+
+		if err := d.Do([]interface_collections.Handler{a, b}); err != nil {
+			t.Errorf("%T.Do() = %v, want nil", d, err)
+		}
+		if got, err := d.Read([]io.Reader{r}); err != nil || len(got) != 1 || got[0] != r {
+			t.Errorf("%T.Read() = %v, %v, want [%v], nil", d, got, err, r)
+		}
+		if got := d.Route(map[string]interface_collections.Handler{"a": a}); got[a] != 1 {
+			t.Errorf("%T.Route() = %v, want map[a:1]", d, got)
+		}
+		if got := d.Stages([][]interface_collections.Handler{{a}, {b}}, [2]interface_collections.Handler{a, b}); len(got) != 1 || got[0][0] != b {
+			t.Errorf("%T.Stages() = %v, want [[b]]", d, got)
+		}
+		if got := d.Optional([]interface_collections.Option[interface_collections.Handler]{{Value: a, Valid: true}}); got["r"][0].Value != r {
+			t.Errorf("%T.Optional() = %v, want map[r:[{%v false}]]", d, got, r)
+		}
+		if got := d.Chain([]interface_collections.Handler{a}, []interface_collections.Handler{b}); len(got) != 1 || got[0] != b {
+			t.Errorf("%T.Chain() = %v, want [b]", d, got)
+		}
+	}
+}
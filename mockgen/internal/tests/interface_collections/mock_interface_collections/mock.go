@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/interface_collections (interfaces: Dispatcher)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_interface_collections/mock.go . Dispatcher
+//
+
+// Package mock_interface_collections is a generated GoMock package.
+package mock_interface_collections
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	interface_collections "go.uber.org/mock/mockgen/internal/tests/interface_collections"
+)
+
+// MockDispatcher is a mock of Dispatcher interface.
+type MockDispatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockDispatcherMockRecorder
+}
+
+// MockDispatcherMockRecorder is the mock recorder for MockDispatcher.
+type MockDispatcherMockRecorder struct {
+	mock *MockDispatcher
+}
+
+// NewMockDispatcher creates a new mock instance.
+func NewMockDispatcher(ctrl *gomock.Controller) *MockDispatcher {
+	mock := &MockDispatcher{ctrl: ctrl}
+	mock.recorder = &MockDispatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDispatcher) EXPECT() *MockDispatcherMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDispatcher; create it with NewMockDispatcher")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockDispatcher) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Chain mocks base method.
+func (m *MockDispatcher) Chain(arg0 ...[]interface_collections.Handler) []interface{ Handle(string) error } {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDispatcher; create it with NewMockDispatcher")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Chain", varargs...)
+	ret0, _ := ret[0].([]interface{ Handle(string) error })
+	return ret0
+}
+
+// Chain indicates an expected call of Chain.
+func (mr *MockDispatcherMockRecorder) Chain(arg0 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chain", reflect.TypeOf((*MockDispatcher)(nil).Chain), arg0...)
+}
+
+// Do mocks base method.
+func (m *MockDispatcher) Do(arg0 []interface_collections.Handler) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDispatcher; create it with NewMockDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDispatcherMockRecorder) Do(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDispatcher)(nil).Do), arg0)
+}
+
+// Optional mocks base method.
+func (m *MockDispatcher) Optional(arg0 []interface_collections.Option[interface_collections.Handler]) map[string][]interface_collections.Option[io.Reader] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDispatcher; create it with NewMockDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Optional", arg0)
+	ret0, _ := ret[0].(map[string][]interface_collections.Option[io.Reader])
+	return ret0
+}
+
+// Optional indicates an expected call of Optional.
+func (mr *MockDispatcherMockRecorder) Optional(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Optional", reflect.TypeOf((*MockDispatcher)(nil).Optional), arg0)
+}
+
+// Read mocks base method.
+func (m *MockDispatcher) Read(arg0 []io.Reader) ([]io.Reader, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDispatcher; create it with NewMockDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0)
+	ret0, _ := ret[0].([]io.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockDispatcherMockRecorder) Read(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockDispatcher)(nil).Read), arg0)
+}
+
+// Route mocks base method.
+func (m *MockDispatcher) Route(arg0 map[string]interface_collections.Handler) map[interface_collections.Handler]int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDispatcher; create it with NewMockDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Route", arg0)
+	ret0, _ := ret[0].(map[interface_collections.Handler]int)
+	return ret0
+}
+
+// Route indicates an expected call of Route.
+func (mr *MockDispatcherMockRecorder) Route(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Route", reflect.TypeOf((*MockDispatcher)(nil).Route), arg0)
+}
+
+// Stages mocks base method.
+func (m *MockDispatcher) Stages(arg0 [][]interface_collections.Handler, arg1 [2]interface_collections.Handler) [][]interface_collections.Handler {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDispatcher; create it with NewMockDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stages", arg0, arg1)
+	ret0, _ := ret[0].([][]interface_collections.Handler)
+	return ret0
+}
+
+// Stages indicates an expected call of Stages.
+func (mr *MockDispatcherMockRecorder) Stages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stages", reflect.TypeOf((*MockDispatcher)(nil).Stages), arg0, arg1)
+}
@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/interface_collections (interfaces: Dispatcher)
+//
+// Generated by this command:
+//
+//	mockgen -package interface_collections -destination reflect_mock.go -mock_names Dispatcher=MockReflectDispatcher . Dispatcher
+//
+
+// Package interface_collections is a generated GoMock package.
+package interface_collections
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReflectDispatcher is a mock of Dispatcher interface.
+type MockReflectDispatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockReflectDispatcherMockRecorder
+}
+
+// MockReflectDispatcherMockRecorder is the mock recorder for MockReflectDispatcher.
+type MockReflectDispatcherMockRecorder struct {
+	mock *MockReflectDispatcher
+}
+
+// NewMockReflectDispatcher creates a new mock instance.
+func NewMockReflectDispatcher(ctrl *gomock.Controller) *MockReflectDispatcher {
+	mock := &MockReflectDispatcher{ctrl: ctrl}
+	mock.recorder = &MockReflectDispatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReflectDispatcher) EXPECT() *MockReflectDispatcherMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectDispatcher; create it with NewMockReflectDispatcher")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockReflectDispatcher) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Chain mocks base method.
+func (m *MockReflectDispatcher) Chain(arg0 ...[]Handler) []interface{ Handle(string) error } {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectDispatcher; create it with NewMockReflectDispatcher")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Chain", varargs...)
+	ret0, _ := ret[0].([]interface{ Handle(string) error })
+	return ret0
+}
+
+// Chain indicates an expected call of Chain.
+func (mr *MockReflectDispatcherMockRecorder) Chain(arg0 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chain", reflect.TypeOf((*MockReflectDispatcher)(nil).Chain), arg0...)
+}
+
+// Do mocks base method.
+func (m *MockReflectDispatcher) Do(arg0 []Handler) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectDispatcher; create it with NewMockReflectDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockReflectDispatcherMockRecorder) Do(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockReflectDispatcher)(nil).Do), arg0)
+}
+
+// Optional mocks base method.
+func (m *MockReflectDispatcher) Optional(arg0 []Option[Handler]) map[string][]Option[io.Reader] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectDispatcher; create it with NewMockReflectDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Optional", arg0)
+	ret0, _ := ret[0].(map[string][]Option[io.Reader])
+	return ret0
+}
+
+// Optional indicates an expected call of Optional.
+func (mr *MockReflectDispatcherMockRecorder) Optional(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Optional", reflect.TypeOf((*MockReflectDispatcher)(nil).Optional), arg0)
+}
+
+// Read mocks base method.
+func (m *MockReflectDispatcher) Read(arg0 []io.Reader) ([]io.Reader, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectDispatcher; create it with NewMockReflectDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0)
+	ret0, _ := ret[0].([]io.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockReflectDispatcherMockRecorder) Read(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReflectDispatcher)(nil).Read), arg0)
+}
+
+// Route mocks base method.
+func (m *MockReflectDispatcher) Route(arg0 map[string]Handler) map[Handler]int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectDispatcher; create it with NewMockReflectDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Route", arg0)
+	ret0, _ := ret[0].(map[Handler]int)
+	return ret0
+}
+
+// Route indicates an expected call of Route.
+func (mr *MockReflectDispatcherMockRecorder) Route(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Route", reflect.TypeOf((*MockReflectDispatcher)(nil).Route), arg0)
+}
+
+// Stages mocks base method.
+func (m *MockReflectDispatcher) Stages(arg0 [][]Handler, arg1 [2]Handler) [][]Handler {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectDispatcher; create it with NewMockReflectDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stages", arg0, arg1)
+	ret0, _ := ret[0].([][]Handler)
+	return ret0
+}
+
+// Stages indicates an expected call of Stages.
+func (mr *MockReflectDispatcherMockRecorder) Stages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stages", reflect.TypeOf((*MockReflectDispatcher)(nil).Stages), arg0, arg1)
+}
@@ -0,0 +1,203 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package interface_collections -destination source_mock.go -source input.go -mock_names Dispatcher=MockSourceDispatcher,Handler=MockSourceHandler
+//
+
+// Package interface_collections is a generated GoMock package.
+package interface_collections
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSourceHandler is a mock of Handler interface.
+type MockSourceHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceHandlerMockRecorder
+}
+
+// MockSourceHandlerMockRecorder is the mock recorder for MockSourceHandler.
+type MockSourceHandlerMockRecorder struct {
+	mock *MockSourceHandler
+}
+
+// NewMockSourceHandler creates a new mock instance.
+func NewMockSourceHandler(ctrl *gomock.Controller) *MockSourceHandler {
+	mock := &MockSourceHandler{ctrl: ctrl}
+	mock.recorder = &MockSourceHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceHandler) EXPECT() *MockSourceHandlerMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceHandler; create it with NewMockSourceHandler")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceHandler) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Handle mocks base method.
+func (m *MockSourceHandler) Handle(req string) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceHandler; create it with NewMockSourceHandler")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockSourceHandlerMockRecorder) Handle(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockSourceHandler)(nil).Handle), req)
+}
+
+// MockSourceDispatcher is a mock of Dispatcher interface.
+type MockSourceDispatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceDispatcherMockRecorder
+}
+
+// MockSourceDispatcherMockRecorder is the mock recorder for MockSourceDispatcher.
+type MockSourceDispatcherMockRecorder struct {
+	mock *MockSourceDispatcher
+}
+
+// NewMockSourceDispatcher creates a new mock instance.
+func NewMockSourceDispatcher(ctrl *gomock.Controller) *MockSourceDispatcher {
+	mock := &MockSourceDispatcher{ctrl: ctrl}
+	mock.recorder = &MockSourceDispatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceDispatcher) EXPECT() *MockSourceDispatcherMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceDispatcher; create it with NewMockSourceDispatcher")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceDispatcher) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Chain mocks base method.
+func (m *MockSourceDispatcher) Chain(handlers ...[]Handler) []interface{ Handler } {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceDispatcher; create it with NewMockSourceDispatcher")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range handlers {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Chain", varargs...)
+	ret0, _ := ret[0].([]interface{ Handler })
+	return ret0
+}
+
+// Chain indicates an expected call of Chain.
+func (mr *MockSourceDispatcherMockRecorder) Chain(handlers ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chain", reflect.TypeOf((*MockSourceDispatcher)(nil).Chain), handlers...)
+}
+
+// Do mocks base method.
+func (m *MockSourceDispatcher) Do(handlers []Handler) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceDispatcher; create it with NewMockSourceDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", handlers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSourceDispatcherMockRecorder) Do(handlers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSourceDispatcher)(nil).Do), handlers)
+}
+
+// Optional mocks base method.
+func (m *MockSourceDispatcher) Optional(handlers []Option[Handler]) map[string][]Option[io.Reader] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceDispatcher; create it with NewMockSourceDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Optional", handlers)
+	ret0, _ := ret[0].(map[string][]Option[io.Reader])
+	return ret0
+}
+
+// Optional indicates an expected call of Optional.
+func (mr *MockSourceDispatcherMockRecorder) Optional(handlers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Optional", reflect.TypeOf((*MockSourceDispatcher)(nil).Optional), handlers)
+}
+
+// Read mocks base method.
+func (m *MockSourceDispatcher) Read(readers []io.Reader) ([]io.Reader, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceDispatcher; create it with NewMockSourceDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", readers)
+	ret0, _ := ret[0].([]io.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockSourceDispatcherMockRecorder) Read(readers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockSourceDispatcher)(nil).Read), readers)
+}
+
+// Route mocks base method.
+func (m *MockSourceDispatcher) Route(routes map[string]Handler) map[Handler]int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceDispatcher; create it with NewMockSourceDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Route", routes)
+	ret0, _ := ret[0].(map[Handler]int)
+	return ret0
+}
+
+// Route indicates an expected call of Route.
+func (mr *MockSourceDispatcherMockRecorder) Route(routes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Route", reflect.TypeOf((*MockSourceDispatcher)(nil).Route), routes)
+}
+
+// Stages mocks base method.
+func (m *MockSourceDispatcher) Stages(stages [][]Handler, fixed [2]Handler) [][]Handler {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceDispatcher; create it with NewMockSourceDispatcher")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stages", stages, fixed)
+	ret0, _ := ret[0].([][]Handler)
+	return ret0
+}
+
+// Stages indicates an expected call of Stages.
+func (mr *MockSourceDispatcherMockRecorder) Stages(stages, fixed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stages", reflect.TypeOf((*MockSourceDispatcher)(nil).Stages), stages, fixed)
+}
@@ -36,11 +36,22 @@ func NewMockArg(ctrl *gomock.Controller) *MockArg {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockArg) EXPECT() *MockArgMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockArg; create it with NewMockArg")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockArg) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Foo mocks base method.
 func (m *MockArg) Foo() int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockArg; create it with NewMockArg")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Foo")
 	ret0, _ := ret[0].(int)
@@ -73,11 +84,22 @@ func NewMockIntf(ctrl *gomock.Controller) *MockIntf {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockIntf) EXPECT() *MockIntfMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIntf; create it with NewMockIntf")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockIntf) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // F mocks base method.
 func (m *MockIntf) F() pkg.Arg {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIntf; create it with NewMockIntf")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "F")
 	ret0, _ := ret[0].(pkg.Arg)
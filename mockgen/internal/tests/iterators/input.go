@@ -0,0 +1,20 @@
+//go:build go1.23
+
+package iterators
+
+//go:generate mockgen -package iterators -destination mock.go -source input.go -typed
+
+import (
+	"iter"
+
+	"go.uber.org/mock/mockgen/internal/tests/iterators/item"
+)
+
+// Store has methods returning range-over-func iterators.
+type Store interface {
+	All() iter.Seq[int]
+	Pairs() iter.Seq2[string, int]
+	Items() iter.Seq[item.Item]
+	Keys() func(yield func(string) bool)
+	Filter(keep func(int) bool) iter.Seq[int]
+}
@@ -0,0 +1,69 @@
+//go:build go1.23
+
+package iterators
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/iterators/item"
+)
+
+func TestReturnedIterators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := NewMockStore(ctrl)
+
+	m.EXPECT().All().Return(slices.Values([]int{1, 2, 3}))
+	m.EXPECT().Pairs().Return(maps.All(map[string]int{"a": 1}))
+	m.EXPECT().Items().Return(slices.Values([]item.Item{{Name: "x"}}))
+	m.EXPECT().Filter(gomock.Any()).DoAndReturn(func(keep func(int) bool) iter.Seq[int] {
+		return func(yield func(int) bool) {
+			for _, v := range []int{1, 2, 3, 4} {
+				if keep(v) && !yield(v) {
+					return
+				}
+			}
+		}
+	})
+
+	var sum int
+	for v := range m.All() {
+		sum += v
+	}
+	if sum != 6 {
+		t.Errorf("sum of All() = %d, want 6", sum)
+	}
+	for k, v := range m.Pairs() {
+		if k != "a" || v != 1 {
+			t.Errorf("Pairs() yielded %q, %d", k, v)
+		}
+	}
+	if got := slices.Collect(m.Items()); len(got) != 1 || got[0].Name != "x" {
+		t.Errorf("Items() = %v", got)
+	}
+	even := slices.Collect(m.Filter(func(v int) bool { return v%2 == 0 }))
+	if !slices.Equal(even, []int{2, 4}) {
+		t.Errorf("Filter() = %v, want [2 4]", even)
+	}
+}
+
+func TestIteratorStopsEarly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := NewMockStore(ctrl)
+
+	m.EXPECT().Keys().Return(slices.Values([]string{"a", "b", "c"}))
+
+	var keys []string
+	for k := range m.Keys() {
+		keys = append(keys, k)
+		if k == "b" {
+			break
+		}
+	}
+	if !slices.Equal(keys, []string{"a", "b"}) {
+		t.Errorf("keys = %v, want [a b]", keys)
+	}
+}
@@ -0,0 +1,6 @@
+// Package item is imported by the iterators of the Store interface.
+package item
+
+type Item struct {
+	Name string
+}
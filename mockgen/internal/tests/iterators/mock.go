@@ -0,0 +1,306 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package iterators -destination mock.go -source input.go -typed
+//
+
+//go:build go1.23
+
+// Package iterators is a generated GoMock package.
+package iterators
+
+import (
+	iter "iter"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	item "go.uber.org/mock/mockgen/internal/tests/iterators/item"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// All mocks base method.
+func (m *MockStore) All() iter.Seq[int] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All")
+	ret0, _ := ret[0].(iter.Seq[int])
+	return ret0
+}
+
+// All indicates an expected call of All.
+func (mr *MockStoreMockRecorder) All() *MockStoreAllCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockStore)(nil).All))
+	return &MockStoreAllCall{Call: call}
+}
+
+// MockStoreAllCall wrap *gomock.Call
+type MockStoreAllCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreAllCall) Return(arg0 iter.Seq[int]) *MockStoreAllCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreAllCall) Do(f func() iter.Seq[int]) *MockStoreAllCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreAllCall) DoAndReturn(f func() iter.Seq[int]) *MockStoreAllCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStoreAllCall) ReturnsInOrder(rets ...iter.Seq[int]) *MockStoreAllCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Filter mocks base method.
+func (m *MockStore) Filter(keep func(int) bool) iter.Seq[int] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", keep)
+	ret0, _ := ret[0].(iter.Seq[int])
+	return ret0
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockStoreMockRecorder) Filter(keep any) *MockStoreFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockStore)(nil).Filter), keep)
+	return &MockStoreFilterCall{Call: call}
+}
+
+// MockStoreFilterCall wrap *gomock.Call
+type MockStoreFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreFilterCall) Return(arg0 iter.Seq[int]) *MockStoreFilterCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreFilterCall) Do(f func(func(int) bool) iter.Seq[int]) *MockStoreFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreFilterCall) DoAndReturn(f func(func(int) bool) iter.Seq[int]) *MockStoreFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStoreFilterCall) ReturnsInOrder(rets ...iter.Seq[int]) *MockStoreFilterCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Items mocks base method.
+func (m *MockStore) Items() iter.Seq[item.Item] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Items")
+	ret0, _ := ret[0].(iter.Seq[item.Item])
+	return ret0
+}
+
+// Items indicates an expected call of Items.
+func (mr *MockStoreMockRecorder) Items() *MockStoreItemsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Items", reflect.TypeOf((*MockStore)(nil).Items))
+	return &MockStoreItemsCall{Call: call}
+}
+
+// MockStoreItemsCall wrap *gomock.Call
+type MockStoreItemsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreItemsCall) Return(arg0 iter.Seq[item.Item]) *MockStoreItemsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreItemsCall) Do(f func() iter.Seq[item.Item]) *MockStoreItemsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreItemsCall) DoAndReturn(f func() iter.Seq[item.Item]) *MockStoreItemsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStoreItemsCall) ReturnsInOrder(rets ...iter.Seq[item.Item]) *MockStoreItemsCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Keys mocks base method.
+func (m *MockStore) Keys() func(func(string) bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Keys")
+	ret0, _ := ret[0].(func(func(string) bool))
+	return ret0
+}
+
+// Keys indicates an expected call of Keys.
+func (mr *MockStoreMockRecorder) Keys() *MockStoreKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockStore)(nil).Keys))
+	return &MockStoreKeysCall{Call: call}
+}
+
+// MockStoreKeysCall wrap *gomock.Call
+type MockStoreKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreKeysCall) Return(arg0 func(func(string) bool)) *MockStoreKeysCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreKeysCall) Do(f func() func(func(string) bool)) *MockStoreKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreKeysCall) DoAndReturn(f func() func(func(string) bool)) *MockStoreKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStoreKeysCall) ReturnsInOrder(rets ...func(func(string) bool)) *MockStoreKeysCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Pairs mocks base method.
+func (m *MockStore) Pairs() iter.Seq2[string, int] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pairs")
+	ret0, _ := ret[0].(iter.Seq2[string, int])
+	return ret0
+}
+
+// Pairs indicates an expected call of Pairs.
+func (mr *MockStoreMockRecorder) Pairs() *MockStorePairsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pairs", reflect.TypeOf((*MockStore)(nil).Pairs))
+	return &MockStorePairsCall{Call: call}
+}
+
+// MockStorePairsCall wrap *gomock.Call
+type MockStorePairsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorePairsCall) Return(arg0 iter.Seq2[string, int]) *MockStorePairsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorePairsCall) Do(f func() iter.Seq2[string, int]) *MockStorePairsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorePairsCall) DoAndReturn(f func() iter.Seq2[string, int]) *MockStorePairsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStorePairsCall) ReturnsInOrder(rets ...iter.Seq2[string, int]) *MockStorePairsCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
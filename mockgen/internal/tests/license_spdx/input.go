@@ -0,0 +1,7 @@
+package license_spdx
+
+//go:generate mockgen -package license_spdx -destination mock.go -source input.go -license_spdx=Apache-2.0 -copyright_file=../copyright_file/mock_copyright_header
+
+type Greeter interface {
+	Greet(name string) string
+}
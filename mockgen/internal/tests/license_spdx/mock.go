@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// This is a mock copyright header.
+//
+// Lorem ipsum dolor sit amet, consectetur adipiscing elit,
+// sed do eiusmod tempor incididunt ut labore et dolore magna
+// aliqua. Velit ut tortor pretium viverra suspendisse potenti.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package license_spdx -destination mock.go -source input.go -license_spdx=Apache-2.0 -copyright_file=../copyright_file/mock_copyright_header
+//
+
+// Package license_spdx is a generated GoMock package.
+package license_spdx
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGreeter is a mock of Greeter interface.
+type MockGreeter struct {
+	ctrl     *gomock.Controller
+	recorder *MockGreeterMockRecorder
+}
+
+// MockGreeterMockRecorder is the mock recorder for MockGreeter.
+type MockGreeterMockRecorder struct {
+	mock *MockGreeter
+}
+
+// NewMockGreeter creates a new mock instance.
+func NewMockGreeter(ctrl *gomock.Controller) *MockGreeter {
+	mock := &MockGreeter{ctrl: ctrl}
+	mock.recorder = &MockGreeterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGreeter) EXPECT() *MockGreeterMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockGreeter; create it with NewMockGreeter")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockGreeter) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Greet mocks base method.
+func (m *MockGreeter) Greet(name string) string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockGreeter; create it with NewMockGreeter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Greet", name)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Greet indicates an expected call of Greet.
+func (mr *MockGreeterMockRecorder) Greet(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Greet", reflect.TypeOf((*MockGreeter)(nil).Greet), name)
+}
@@ -0,0 +1,21 @@
+// Package method_name_collision has interfaces with methods named like the
+// identifiers of generated mocks.
+package method_name_collision
+
+//go:generate mockgen -package method_name_collision -destination mock.go -source input.go
+//go:generate mockgen -typed -builder -destination mock_method_name_collision/mock.go . Controls
+
+// Controls has methods named like the methods and fields of mocks.
+type Controls interface {
+	Ctrl() string
+	Recorder() int
+	EXPECT() bool
+	ISGOMOCK() struct{}
+}
+
+// fields has methods named exactly like the fields of mocks and recorders.
+type fields interface {
+	ctrl()
+	recorder() string
+	mock(int)
+}
@@ -0,0 +1,59 @@
+package method_name_collision
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/method_name_collision/mock_method_name_collision"
+)
+
+var (
+	_ Controls = (*MockControls)(nil)
+	_ Controls = (*mock_method_name_collision.MockControls)(nil)
+	_ fields   = (*Mockfields)(nil)
+)
+
+func TestCollidingMethods(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	m := NewMockControls(ctrl)
+	m.EXPECT_().Ctrl().Return("ctrl")
+	m.EXPECT_().Recorder().Return(1)
+	m.EXPECT_().EXPECT().Return(true)
+	m.EXPECT_().ISGOMOCK().Return(struct{}{})
+	if got := m.Ctrl(); got != "ctrl" {
+		t.Errorf("Ctrl() = %q, want ctrl", got)
+	}
+	if got := m.Recorder(); got != 1 {
+		t.Errorf("Recorder() = %d, want 1", got)
+	}
+	if !m.EXPECT() {
+		t.Error("EXPECT() = false, want true")
+	}
+	m.ISGOMOCK()
+
+	f := NewMockfields(ctrl)
+	f.EXPECT().ctrl()
+	f.EXPECT().recorder().Return("recorder")
+	f.EXPECT().mock(1)
+	f.ctrl()
+	if got := f.recorder(); got != "recorder" {
+		t.Errorf("recorder() = %q, want recorder", got)
+	}
+	f.mock(1)
+}
+
+func TestCollidingMethodsBuilder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	b := mock_method_name_collision.NewMockControlsBuilder(ctrl)
+	b.ExpectEXPECT().Return(true)
+	b.ExpectCtrl().DoAndReturn(func() string { return "ctrl" })
+	m := b.Build()
+	if !m.EXPECT() {
+		t.Error("EXPECT() = false, want true")
+	}
+	if got := m.Ctrl(); got != "ctrl" {
+		t.Errorf("Ctrl() = %q, want ctrl", got)
+	}
+}
@@ -0,0 +1,188 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package method_name_collision -destination mock.go -source input.go
+//
+
+// Package method_name_collision is a generated GoMock package.
+package method_name_collision
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockControls is a mock of Controls interface.
+type MockControls struct {
+	ctrl     *gomock.Controller
+	recorder *MockControlsMockRecorder
+}
+
+// MockControlsMockRecorder is the mock recorder for MockControls.
+type MockControlsMockRecorder struct {
+	mock *MockControls
+}
+
+// NewMockControls creates a new mock instance.
+func NewMockControls(ctrl *gomock.Controller) *MockControls {
+	mock := &MockControls{ctrl: ctrl}
+	mock.recorder = &MockControlsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT_ returns an object that allows the caller to indicate expected use.
+func (m *MockControls) EXPECT_() *MockControlsMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockControls; create it with NewMockControls")
+	}
+	return m.recorder
+}
+
+// Ctrl mocks base method.
+func (m *MockControls) Ctrl() string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockControls; create it with NewMockControls")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ctrl")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Ctrl indicates an expected call of Ctrl.
+func (mr *MockControlsMockRecorder) Ctrl() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ctrl", reflect.TypeOf((*MockControls)(nil).Ctrl))
+}
+
+// EXPECT mocks base method.
+func (m *MockControls) EXPECT() bool {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockControls; create it with NewMockControls")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EXPECT")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// EXPECT indicates an expected call of EXPECT.
+func (mr *MockControlsMockRecorder) EXPECT() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EXPECT", reflect.TypeOf((*MockControls)(nil).EXPECT))
+}
+
+// ISGOMOCK mocks base method.
+func (m *MockControls) ISGOMOCK() struct{} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockControls; create it with NewMockControls")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ISGOMOCK")
+	ret0, _ := ret[0].(struct{})
+	return ret0
+}
+
+// ISGOMOCK indicates an expected call of ISGOMOCK.
+func (mr *MockControlsMockRecorder) ISGOMOCK() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ISGOMOCK", reflect.TypeOf((*MockControls)(nil).ISGOMOCK))
+}
+
+// Recorder mocks base method.
+func (m *MockControls) Recorder() int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockControls; create it with NewMockControls")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recorder")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Recorder indicates an expected call of Recorder.
+func (mr *MockControlsMockRecorder) Recorder() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recorder", reflect.TypeOf((*MockControls)(nil).Recorder))
+}
+
+// Mockfields is a mock of fields interface.
+type Mockfields struct {
+	ctrl_     *gomock.Controller
+	recorder_ *MockfieldsMockRecorder
+}
+
+// MockfieldsMockRecorder is the mock recorder for Mockfields.
+type MockfieldsMockRecorder struct {
+	mock_ *Mockfields
+}
+
+// NewMockfields creates a new mock instance.
+func NewMockfields(ctrl *gomock.Controller) *Mockfields {
+	mock := &Mockfields{ctrl_: ctrl}
+	mock.recorder_ = &MockfieldsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockfields) EXPECT() *MockfieldsMockRecorder {
+	if m == nil || m.ctrl_ == nil {
+		panic("gomock: method called on a nil or uninitialized *Mockfields; create it with NewMockfields")
+	}
+	return m.recorder_
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *Mockfields) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// ctrl mocks base method.
+func (m *Mockfields) ctrl() {
+	if m == nil || m.ctrl_ == nil {
+		panic("gomock: method called on a nil or uninitialized *Mockfields; create it with NewMockfields")
+	}
+	m.ctrl_.T.Helper()
+	m.ctrl_.Call(m, "ctrl")
+}
+
+// ctrl indicates an expected call of ctrl.
+func (mr *MockfieldsMockRecorder) ctrl() *gomock.Call {
+	mr.mock_.ctrl_.T.Helper()
+	return mr.mock_.ctrl_.RecordCallWithMethodType(mr.mock_, "ctrl", reflect.TypeOf((*Mockfields)(nil).ctrl))
+}
+
+// mock mocks base method.
+func (m *Mockfields) mock(arg0 int) {
+	if m == nil || m.ctrl_ == nil {
+		panic("gomock: method called on a nil or uninitialized *Mockfields; create it with NewMockfields")
+	}
+	m.ctrl_.T.Helper()
+	m.ctrl_.Call(m, "mock", arg0)
+}
+
+// mock indicates an expected call of mock.
+func (mr *MockfieldsMockRecorder) mock(arg0 any) *gomock.Call {
+	mr.mock_.ctrl_.T.Helper()
+	return mr.mock_.ctrl_.RecordCallWithMethodType(mr.mock_, "mock", reflect.TypeOf((*Mockfields)(nil).mock), arg0)
+}
+
+// recorder mocks base method.
+func (m *Mockfields) recorder() string {
+	if m == nil || m.ctrl_ == nil {
+		panic("gomock: method called on a nil or uninitialized *Mockfields; create it with NewMockfields")
+	}
+	m.ctrl_.T.Helper()
+	ret := m.ctrl_.Call(m, "recorder")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// recorder indicates an expected call of recorder.
+func (mr *MockfieldsMockRecorder) recorder() *gomock.Call {
+	mr.mock_.ctrl_.T.Helper()
+	return mr.mock_.ctrl_.RecordCallWithMethodType(mr.mock_, "recorder", reflect.TypeOf((*Mockfields)(nil).recorder))
+}
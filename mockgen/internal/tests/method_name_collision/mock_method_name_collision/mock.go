@@ -0,0 +1,285 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/method_name_collision (interfaces: Controls)
+//
+// Generated by this command:
+//
+//	mockgen -typed -builder -destination mock_method_name_collision/mock.go . Controls
+//
+
+// Package mock_method_name_collision is a generated GoMock package.
+package mock_method_name_collision
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockControls is a mock of Controls interface.
+type MockControls struct {
+	ctrl     *gomock.Controller
+	recorder *MockControlsMockRecorder
+}
+
+// MockControlsMockRecorder is the mock recorder for MockControls.
+type MockControlsMockRecorder struct {
+	mock *MockControls
+}
+
+// NewMockControls creates a new mock instance.
+func NewMockControls(ctrl *gomock.Controller) *MockControls {
+	mock := &MockControls{ctrl: ctrl}
+	mock.recorder = &MockControlsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT_ returns an object that allows the caller to indicate expected use.
+func (m *MockControls) EXPECT_() *MockControlsMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockControls; create it with NewMockControls")
+	}
+	return m.recorder
+}
+
+// Ctrl mocks base method.
+func (m *MockControls) Ctrl() string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockControls; create it with NewMockControls")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ctrl")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Ctrl indicates an expected call of Ctrl.
+func (mr *MockControlsMockRecorder) Ctrl() *MockControlsCtrlCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ctrl", reflect.TypeOf((*MockControls)(nil).Ctrl))
+	return &MockControlsCtrlCall{Call: call}
+}
+
+// MockControlsCtrlCall wrap *gomock.Call
+type MockControlsCtrlCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControlsCtrlCall) Return(arg0 string) *MockControlsCtrlCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControlsCtrlCall) Do(f func() string) *MockControlsCtrlCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControlsCtrlCall) DoAndReturn(f func() string) *MockControlsCtrlCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockControlsCtrlCall) ReturnsInOrder(rets ...string) *MockControlsCtrlCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// EXPECT mocks base method.
+func (m *MockControls) EXPECT() bool {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockControls; create it with NewMockControls")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EXPECT")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// EXPECT indicates an expected call of EXPECT.
+func (mr *MockControlsMockRecorder) EXPECT() *MockControlsEXPECTCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EXPECT", reflect.TypeOf((*MockControls)(nil).EXPECT))
+	return &MockControlsEXPECTCall{Call: call}
+}
+
+// MockControlsEXPECTCall wrap *gomock.Call
+type MockControlsEXPECTCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControlsEXPECTCall) Return(arg0 bool) *MockControlsEXPECTCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControlsEXPECTCall) Do(f func() bool) *MockControlsEXPECTCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControlsEXPECTCall) DoAndReturn(f func() bool) *MockControlsEXPECTCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockControlsEXPECTCall) ReturnsInOrder(rets ...bool) *MockControlsEXPECTCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// ISGOMOCK mocks base method.
+func (m *MockControls) ISGOMOCK() struct{} {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockControls; create it with NewMockControls")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ISGOMOCK")
+	ret0, _ := ret[0].(struct{})
+	return ret0
+}
+
+// ISGOMOCK indicates an expected call of ISGOMOCK.
+func (mr *MockControlsMockRecorder) ISGOMOCK() *MockControlsISGOMOCKCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ISGOMOCK", reflect.TypeOf((*MockControls)(nil).ISGOMOCK))
+	return &MockControlsISGOMOCKCall{Call: call}
+}
+
+// MockControlsISGOMOCKCall wrap *gomock.Call
+type MockControlsISGOMOCKCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControlsISGOMOCKCall) Return(arg0 struct{}) *MockControlsISGOMOCKCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControlsISGOMOCKCall) Do(f func() struct{}) *MockControlsISGOMOCKCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControlsISGOMOCKCall) DoAndReturn(f func() struct{}) *MockControlsISGOMOCKCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockControlsISGOMOCKCall) ReturnsInOrder(rets ...struct{}) *MockControlsISGOMOCKCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Recorder mocks base method.
+func (m *MockControls) Recorder() int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockControls; create it with NewMockControls")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recorder")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Recorder indicates an expected call of Recorder.
+func (mr *MockControlsMockRecorder) Recorder() *MockControlsRecorderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recorder", reflect.TypeOf((*MockControls)(nil).Recorder))
+	return &MockControlsRecorderCall{Call: call}
+}
+
+// MockControlsRecorderCall wrap *gomock.Call
+type MockControlsRecorderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControlsRecorderCall) Return(arg0 int) *MockControlsRecorderCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControlsRecorderCall) Do(f func() int) *MockControlsRecorderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControlsRecorderCall) DoAndReturn(f func() int) *MockControlsRecorderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockControlsRecorderCall) ReturnsInOrder(rets ...int) *MockControlsRecorderCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// MockControlsBuilder registers expected calls of a MockControls before building it.
+type MockControlsBuilder struct {
+	mock *MockControls
+}
+
+// NewMockControlsBuilder creates a builder of a new mock instance.
+func NewMockControlsBuilder(ctrl *gomock.Controller) *MockControlsBuilder {
+	return &MockControlsBuilder{mock: NewMockControls(ctrl)}
+}
+
+// Build returns the mock with the expected calls registered by the builder.
+func (b *MockControlsBuilder) Build() *MockControls {
+	return b.mock
+}
+
+// ExpectCtrl indicates an expected call of Ctrl.
+func (b *MockControlsBuilder) ExpectCtrl() *MockControlsCtrlCall {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Ctrl()
+}
+
+// ExpectEXPECT indicates an expected call of EXPECT.
+func (b *MockControlsBuilder) ExpectEXPECT() *MockControlsEXPECTCall {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.EXPECT()
+}
+
+// ExpectISGOMOCK indicates an expected call of ISGOMOCK.
+func (b *MockControlsBuilder) ExpectISGOMOCK() *MockControlsISGOMOCKCall {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.ISGOMOCK()
+}
+
+// ExpectRecorder indicates an expected call of Recorder.
+func (b *MockControlsBuilder) ExpectRecorder() *MockControlsRecorderCall {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Recorder()
+}
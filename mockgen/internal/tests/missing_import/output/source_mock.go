@@ -36,11 +36,22 @@ func NewMockBar(ctrl *gomock.Controller) *MockBar {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockBar) EXPECT() *MockBarMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBar; create it with NewMockBar")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockBar) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Baz mocks base method.
 func (m *MockBar) Baz(arg0 source.Foo) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockBar; create it with NewMockBar")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Baz", arg0)
 }
@@ -36,11 +36,22 @@ func NewMockFinder(ctrl *gomock.Controller) *MockFinder {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockFinder) EXPECT() *MockFinderMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockFinder; create it with NewMockFinder")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockFinder) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Add mocks base method.
 func (m *MockFinder) Add(u users.User) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockFinder; create it with NewMockFinder")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Add", u)
 }
@@ -53,6 +64,9 @@ func (mr *MockFinderMockRecorder) Add(u any) *gomock.Call {
 
 // FindUser mocks base method.
 func (m *MockFinder) FindUser(name string) users.User {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockFinder; create it with NewMockFinder")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindUser", name)
 	ret0, _ := ret[0].(users.User)
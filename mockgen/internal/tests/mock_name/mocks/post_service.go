@@ -37,11 +37,22 @@ func NewPostServiceMock(ctrl *gomock.Controller) *PostServiceMock {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *PostServiceMock) EXPECT() *PostServiceMockMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *PostServiceMock; create it with NewPostServiceMock")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *PostServiceMock) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Create mocks base method.
 func (m *PostServiceMock) Create(arg0, arg1 string, arg2 *user.User) (*post.Post, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *PostServiceMock; create it with NewPostServiceMock")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*post.Post)
@@ -78,3 +89,9 @@ func (c *PostServiceMockCreateCall) DoAndReturn(f func(string, string, *user.Use
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *PostServiceMockCreateCall) ReturnsInOrder(rets ...[]any) *PostServiceMockCreateCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
@@ -36,11 +36,22 @@ func NewUserServiceMock(ctrl *gomock.Controller) *UserServiceMock {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *UserServiceMock) EXPECT() *UserServiceMockMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *UserServiceMock; create it with NewUserServiceMock")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *UserServiceMock) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Create mocks base method.
 func (m *UserServiceMock) Create(arg0 string) (*user.User, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *UserServiceMock; create it with NewUserServiceMock")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", arg0)
 	ret0, _ := ret[0].(*user.User)
@@ -77,3 +88,9 @@ func (c *UserServiceMockCreateCall) DoAndReturn(f func(string) (*user.User, erro
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *UserServiceMockCreateCall) ReturnsInOrder(rets ...[]any) *UserServiceMockCreateCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
@@ -0,0 +1,11 @@
+// Package multiple_errors has an interface whose methods return several
+// errors.
+package multiple_errors
+
+//go:generate mockgen -package multiple_errors -destination mock.go -source input.go
+//go:generate mockgen -package multiple_errors -destination typed_mock.go -source input.go -typed -return_zero -mock_names Validator=MockTypedValidator
+
+type Validator interface {
+	Validate(v any) (error, error)
+	Check() (warn error, err error, ok bool)
+}
@@ -0,0 +1,37 @@
+package multiple_errors
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestMultipleErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errFirst, errSecond := errors.New("first"), errors.New("second")
+
+	m := NewMockValidator(ctrl)
+	m.EXPECT().Validate(1).Return(errFirst, errSecond)
+	m.EXPECT().Validate(2).Return(nil, errSecond)
+	m.EXPECT().Check().Return(errFirst, nil, true)
+	if warn, err := m.Validate(1); warn != errFirst || err != errSecond {
+		t.Errorf("Validate(1) = %v, %v, want %v, %v", warn, err, errFirst, errSecond)
+	}
+	if warn, err := m.Validate(2); warn != nil || err != errSecond {
+		t.Errorf("Validate(2) = %v, %v, want nil, %v", warn, err, errSecond)
+	}
+	if warn, err, ok := m.Check(); warn != errFirst || err != nil || !ok {
+		t.Errorf("Check() = %v, %v, %v, want %v, nil, true", warn, err, ok, errFirst)
+	}
+
+	typed := NewMockTypedValidator(ctrl)
+	typed.EXPECT().Validate(1).Return(errFirst, errSecond)
+	typed.EXPECT().Check().ReturnZero()
+	if warn, err := typed.Validate(1); warn != errFirst || err != errSecond {
+		t.Errorf("Validate(1) = %v, %v, want %v, %v", warn, err, errFirst, errSecond)
+	}
+	if warn, err, ok := typed.Check(); warn != nil || err != nil || ok {
+		t.Errorf("Check() = %v, %v, %v, want zero values", warn, err, ok)
+	}
+}
@@ -0,0 +1,165 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package multiple_errors -destination typed_mock.go -source input.go -typed -return_zero -mock_names Validator=MockTypedValidator
+//
+
+// Package multiple_errors is a generated GoMock package.
+package multiple_errors
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTypedValidator is a mock of Validator interface.
+type MockTypedValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockTypedValidatorMockRecorder
+}
+
+// MockTypedValidatorMockRecorder is the mock recorder for MockTypedValidator.
+type MockTypedValidatorMockRecorder struct {
+	mock *MockTypedValidator
+}
+
+// NewMockTypedValidator creates a new mock instance.
+func NewMockTypedValidator(ctrl *gomock.Controller) *MockTypedValidator {
+	mock := &MockTypedValidator{ctrl: ctrl}
+	mock.recorder = &MockTypedValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTypedValidator) EXPECT() *MockTypedValidatorMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedValidator; create it with NewMockTypedValidator")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockTypedValidator) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Check mocks base method.
+func (m *MockTypedValidator) Check() (error, error, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedValidator; create it with NewMockTypedValidator")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check")
+	ret0, _ := ret[0].(error)
+	ret1, _ := ret[1].(error)
+	ret2, _ := ret[2].(bool)
+	return ret0, ret1, ret2
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockTypedValidatorMockRecorder) Check() *MockTypedValidatorCheckCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockTypedValidator)(nil).Check))
+	return &MockTypedValidatorCheckCall{Call: call}
+}
+
+// MockTypedValidatorCheckCall wrap *gomock.Call
+type MockTypedValidatorCheckCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedValidatorCheckCall) Return(warn, err error, ok bool) *MockTypedValidatorCheckCall {
+	c.Call = c.Call.Return(warn, err, ok)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedValidatorCheckCall) Do(f func() (error, error, bool)) *MockTypedValidatorCheckCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedValidatorCheckCall) DoAndReturn(f func() (error, error, bool)) *MockTypedValidatorCheckCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnZero rewrite *gomock.Call.Return with the zero values of the results
+func (c *MockTypedValidatorCheckCall) ReturnZero() *MockTypedValidatorCheckCall {
+	var (
+		warn error
+		err  error
+		ok   bool
+	)
+	c.Call = c.Call.Return(warn, err, ok)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedValidatorCheckCall) ReturnsInOrder(rets ...[]any) *MockTypedValidatorCheckCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// Validate mocks base method.
+func (m *MockTypedValidator) Validate(v any) (error, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedValidator; create it with NewMockTypedValidator")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", v)
+	ret0, _ := ret[0].(error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockTypedValidatorMockRecorder) Validate(v any) *MockTypedValidatorValidateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockTypedValidator)(nil).Validate), v)
+	return &MockTypedValidatorValidateCall{Call: call}
+}
+
+// MockTypedValidatorValidateCall wrap *gomock.Call
+type MockTypedValidatorValidateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedValidatorValidateCall) Return(arg0, arg1 error) *MockTypedValidatorValidateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedValidatorValidateCall) Do(f func(any) (error, error)) *MockTypedValidatorValidateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedValidatorValidateCall) DoAndReturn(f func(any) (error, error)) *MockTypedValidatorValidateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnZero rewrite *gomock.Call.Return with the zero values of the results
+func (c *MockTypedValidatorValidateCall) ReturnZero() *MockTypedValidatorValidateCall {
+	var (
+		arg0 error
+		arg1 error
+	)
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedValidatorValidateCall) ReturnsInOrder(rets ...[]any) *MockTypedValidatorValidateCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/named_composite (interfaces: Client)
+//
+// Generated by this command:
+//
+//	mockgen -package named_composite -destination export_data_mock.go -export_data -mock_names Client=MockExportDataClient . Client
+//
+
+// Package named_composite is a generated GoMock package.
+package named_composite
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExportDataClient is a mock of Client interface.
+type MockExportDataClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportDataClientMockRecorder
+}
+
+// MockExportDataClientMockRecorder is the mock recorder for MockExportDataClient.
+type MockExportDataClientMockRecorder struct {
+	mock *MockExportDataClient
+}
+
+// NewMockExportDataClient creates a new mock instance.
+func NewMockExportDataClient(ctrl *gomock.Controller) *MockExportDataClient {
+	mock := &MockExportDataClient{ctrl: ctrl}
+	mock.recorder = &MockExportDataClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportDataClient) EXPECT() *MockExportDataClientMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataClient; create it with NewMockExportDataClient")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockExportDataClient) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Batch mocks base method.
+func (m *MockExportDataClient) Batch(arg0 []Headers, arg1 map[string]Tags) (Tags, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataClient; create it with NewMockExportDataClient")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Batch", arg0, arg1)
+	ret0, _ := ret[0].(Tags)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Batch indicates an expected call of Batch.
+func (mr *MockExportDataClientMockRecorder) Batch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Batch", reflect.TypeOf((*MockExportDataClient)(nil).Batch), arg0, arg1)
+}
+
+// Send mocks base method.
+func (m *MockExportDataClient) Send(arg0 Headers, arg1 http.Header) Tags {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataClient; create it with NewMockExportDataClient")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0, arg1)
+	ret0, _ := ret[0].(Tags)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockExportDataClientMockRecorder) Send(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockExportDataClient)(nil).Send), arg0, arg1)
+}
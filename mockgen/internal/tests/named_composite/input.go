@@ -0,0 +1,27 @@
+// Package named_composite has interfaces with parameters and results of named
+// map and slice types.
+package named_composite
+
+//go:generate mockgen -package named_composite -destination source_mock.go -source input.go -mock_names Client=MockSourceClient
+//go:generate mockgen -package named_composite -destination reflect_mock.go . Client
+//go:generate mockgen -package named_composite -destination export_data_mock.go -export_data -mock_names Client=MockExportDataClient . Client
+//go:generate mockgen -destination mock_named_composite/mock.go . Client
+
+import "net/http"
+
+// Headers is a named map type with methods.
+type Headers map[string]string
+
+// Get returns the value of key.
+func (h Headers) Get(key string) string { return h[key] }
+
+// Tags is a named slice type with methods.
+type Tags []string
+
+// Len returns the number of tags.
+func (t Tags) Len() int { return len(t) }
+
+type Client interface {
+	Send(h Headers, std http.Header) Tags
+	Batch(hs []Headers, byName map[string]Tags) (Tags, error)
+}
@@ -0,0 +1,36 @@
+package named_composite
+
+import (
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNamedComposite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Each mock's signature has the named types, so that their methods are
+	// available on the results without conversions.
+	for _, c := range []Client{NewMockSourceClient(ctrl), NewMockClient(ctrl), NewMockExportDataClient(ctrl)} {
+		switch m := c.(type) {
+		case *MockSourceClient:
+			m.EXPECT().Send(Headers{"a": "b"}, gomock.Nil()).Return(Tags{"x"})
+			m.EXPECT().Batch(gomock.Len(1), gomock.Any()).Return(Tags{"x", "y"}, nil)
+		case *MockClient:
+			m.EXPECT().Send(Headers{"a": "b"}, gomock.Nil()).Return(Tags{"x"})
+			m.EXPECT().Batch(gomock.Len(1), gomock.Any()).Return(Tags{"x", "y"}, nil)
+		case *MockExportDataClient:
+			m.EXPECT().Send(Headers{"a": "b"}, gomock.Nil()).Return(Tags{"x"})
+			m.EXPECT().Batch(gomock.Len(1), gomock.Any()).Return(Tags{"x", "y"}, nil)
+		}
+
+		if n := c.Send(Headers{"a": "b"}, http.Header(nil)).Len(); n != 1 {
+			t.Errorf("%T.Send returned %d tags, want 1", c, n)
+		}
+		tags, err := c.Batch([]Headers{{"a": "b"}}, map[string]Tags{"t": nil})
+		if err != nil || tags.Len() != 2 {
+			t.Errorf("%T.Batch = %v, %v, want 2 tags", c, tags, err)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package named_composite -destination source_mock.go -source input.go -mock_names Client=MockSourceClient
+//
+
+// Package named_composite is a generated GoMock package.
+package named_composite
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSourceClient is a mock of Client interface.
+type MockSourceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceClientMockRecorder
+}
+
+// MockSourceClientMockRecorder is the mock recorder for MockSourceClient.
+type MockSourceClientMockRecorder struct {
+	mock *MockSourceClient
+}
+
+// NewMockSourceClient creates a new mock instance.
+func NewMockSourceClient(ctrl *gomock.Controller) *MockSourceClient {
+	mock := &MockSourceClient{ctrl: ctrl}
+	mock.recorder = &MockSourceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceClient) EXPECT() *MockSourceClientMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceClient; create it with NewMockSourceClient")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceClient) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Batch mocks base method.
+func (m *MockSourceClient) Batch(hs []Headers, byName map[string]Tags) (Tags, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceClient; create it with NewMockSourceClient")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Batch", hs, byName)
+	ret0, _ := ret[0].(Tags)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Batch indicates an expected call of Batch.
+func (mr *MockSourceClientMockRecorder) Batch(hs, byName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Batch", reflect.TypeOf((*MockSourceClient)(nil).Batch), hs, byName)
+}
+
+// Send mocks base method.
+func (m *MockSourceClient) Send(h Headers, std http.Header) Tags {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceClient; create it with NewMockSourceClient")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", h, std)
+	ret0, _ := ret[0].(Tags)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSourceClientMockRecorder) Send(h, std any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSourceClient)(nil).Send), h, std)
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/named_error (interfaces: Store)
+//
+// Generated by this command:
+//
+//	mockgen -package named_error -destination export_data_mock.go -export_data -mock_names Store=MockExportDataStore . Store
+//
+
+// Package named_error is a generated GoMock package.
+package named_error
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExportDataStore is a mock of Store interface.
+type MockExportDataStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportDataStoreMockRecorder
+}
+
+// MockExportDataStoreMockRecorder is the mock recorder for MockExportDataStore.
+type MockExportDataStoreMockRecorder struct {
+	mock *MockExportDataStore
+}
+
+// NewMockExportDataStore creates a new mock instance.
+func NewMockExportDataStore(ctrl *gomock.Controller) *MockExportDataStore {
+	mock := &MockExportDataStore{ctrl: ctrl}
+	mock.recorder = &MockExportDataStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportDataStore) EXPECT() *MockExportDataStoreMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataStore; create it with NewMockExportDataStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockExportDataStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Get mocks base method.
+func (m *MockExportDataStore) Get(arg0 string) (string, MyErr) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataStore; create it with NewMockExportDataStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(MyErr)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockExportDataStoreMockRecorder) Get(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockExportDataStore)(nil).Get), arg0)
+}
+
+// Put mocks base method.
+func (m *MockExportDataStore) Put(arg0, arg1 string) CodedError {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExportDataStore; create it with NewMockExportDataStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", arg0, arg1)
+	ret0, _ := ret[0].(CodedError)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockExportDataStoreMockRecorder) Put(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockExportDataStore)(nil).Put), arg0, arg1)
+}
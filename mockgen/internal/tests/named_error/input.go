@@ -0,0 +1,22 @@
+// Package named_error has an interface whose methods return named error
+// types rather than error itself.
+package named_error
+
+//go:generate mockgen -package named_error -destination mock.go -source input.go
+//go:generate mockgen -package named_error -destination typed_mock.go -source input.go -typed -mock_names Store=MockTypedStore,CodedError=MockTypedCodedError
+//go:generate mockgen -package named_error -destination reflect_mock.go -mock_names Store=MockReflectStore . Store
+//go:generate mockgen -package named_error -destination export_data_mock.go -export_data -mock_names Store=MockExportDataStore . Store
+
+// MyErr is an error under another name.
+type MyErr error
+
+// CodedError is an error that carries a code.
+type CodedError interface {
+	error
+	Code() int
+}
+
+type Store interface {
+	Get(key string) (value string, err MyErr)
+	Put(key, value string) CodedError
+}
@@ -0,0 +1,73 @@
+package named_error
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+type codedError struct{ code int }
+
+func (e codedError) Error() string { return fmt.Sprintf("code %d", e.code) }
+func (e codedError) Code() int     { return e.code }
+
+// fatalReporter records the first fatal error instead of failing the test.
+type fatalReporter struct {
+	fatal string
+}
+
+func (r *fatalReporter) Errorf(format string, args ...any) {}
+
+func (r *fatalReporter) Fatalf(format string, args ...any) {
+	if r.fatal == "" {
+		r.fatal = fmt.Sprintf(format, args...)
+	}
+}
+
+func TestNamedErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errNotFound := MyErr(errors.New("not found"))
+	errConflict := codedError{code: 409}
+
+	m := NewMockStore(ctrl)
+	m.EXPECT().Get("a").Return("", errNotFound)
+	m.EXPECT().Put("a", "b").Return(errConflict)
+	if _, err := m.Get("a"); err != errNotFound {
+		t.Errorf("Get(a) error = %v, want %v", err, errNotFound)
+	}
+	if err := m.Put("a", "b"); err != errConflict || err.Code() != 409 {
+		t.Errorf("Put(a, b) = %v, want %v", err, errConflict)
+	}
+
+	typed := NewMockTypedStore(ctrl)
+	typed.EXPECT().Put("a", "b").Return(errConflict)
+	if err := typed.Put("a", "b"); err != errConflict {
+		t.Errorf("Put(a, b) = %v, want %v", err, errConflict)
+	}
+
+	reflected := NewMockReflectStore(ctrl)
+	reflected.EXPECT().Get("a").Return("", errNotFound)
+	if _, err := reflected.Get("a"); err != errNotFound {
+		t.Errorf("Get(a) error = %v, want %v", err, errNotFound)
+	}
+
+	exported := NewMockExportDataStore(ctrl)
+	exported.EXPECT().Put("a", "b").Return(nil)
+	if err := exported.Put("a", "b"); err != nil {
+		t.Errorf("Put(a, b) = %v, want nil", err)
+	}
+}
+
+func TestNamedErrorReturnValidation(t *testing.T) {
+	reporter := &fatalReporter{}
+	ctrl := gomock.NewController(reporter)
+
+	m := NewMockStore(ctrl)
+	m.EXPECT().Put("a", "b").Return(errors.New("plain"))
+	if !strings.Contains(reporter.fatal, "is not assignable to named_error.CodedError") {
+		t.Errorf("Return of a plain error to a CodedError result reported %q", reporter.fatal)
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/named_error (interfaces: Store)
+//
+// Generated by this command:
+//
+//	mockgen -package named_error -destination reflect_mock.go -mock_names Store=MockReflectStore . Store
+//
+
+// Package named_error is a generated GoMock package.
+package named_error
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReflectStore is a mock of Store interface.
+type MockReflectStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockReflectStoreMockRecorder
+}
+
+// MockReflectStoreMockRecorder is the mock recorder for MockReflectStore.
+type MockReflectStoreMockRecorder struct {
+	mock *MockReflectStore
+}
+
+// NewMockReflectStore creates a new mock instance.
+func NewMockReflectStore(ctrl *gomock.Controller) *MockReflectStore {
+	mock := &MockReflectStore{ctrl: ctrl}
+	mock.recorder = &MockReflectStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReflectStore) EXPECT() *MockReflectStoreMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectStore; create it with NewMockReflectStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockReflectStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Get mocks base method.
+func (m *MockReflectStore) Get(arg0 string) (string, MyErr) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectStore; create it with NewMockReflectStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(MyErr)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReflectStoreMockRecorder) Get(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReflectStore)(nil).Get), arg0)
+}
+
+// Put mocks base method.
+func (m *MockReflectStore) Put(arg0, arg1 string) CodedError {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectStore; create it with NewMockReflectStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", arg0, arg1)
+	ret0, _ := ret[0].(CodedError)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockReflectStoreMockRecorder) Put(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockReflectStore)(nil).Put), arg0, arg1)
+}
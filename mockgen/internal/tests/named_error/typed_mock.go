@@ -0,0 +1,279 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package named_error -destination typed_mock.go -source input.go -typed -mock_names Store=MockTypedStore,CodedError=MockTypedCodedError
+//
+
+// Package named_error is a generated GoMock package.
+package named_error
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTypedCodedError is a mock of CodedError interface.
+type MockTypedCodedError struct {
+	ctrl     *gomock.Controller
+	recorder *MockTypedCodedErrorMockRecorder
+}
+
+// MockTypedCodedErrorMockRecorder is the mock recorder for MockTypedCodedError.
+type MockTypedCodedErrorMockRecorder struct {
+	mock *MockTypedCodedError
+}
+
+// NewMockTypedCodedError creates a new mock instance.
+func NewMockTypedCodedError(ctrl *gomock.Controller) *MockTypedCodedError {
+	mock := &MockTypedCodedError{ctrl: ctrl}
+	mock.recorder = &MockTypedCodedErrorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTypedCodedError) EXPECT() *MockTypedCodedErrorMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedCodedError; create it with NewMockTypedCodedError")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockTypedCodedError) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Code mocks base method.
+func (m *MockTypedCodedError) Code() int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedCodedError; create it with NewMockTypedCodedError")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Code")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Code indicates an expected call of Code.
+func (mr *MockTypedCodedErrorMockRecorder) Code() *MockTypedCodedErrorCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Code", reflect.TypeOf((*MockTypedCodedError)(nil).Code))
+	return &MockTypedCodedErrorCodeCall{Call: call}
+}
+
+// MockTypedCodedErrorCodeCall wrap *gomock.Call
+type MockTypedCodedErrorCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedCodedErrorCodeCall) Return(arg0 int) *MockTypedCodedErrorCodeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedCodedErrorCodeCall) Do(f func() int) *MockTypedCodedErrorCodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedCodedErrorCodeCall) DoAndReturn(f func() int) *MockTypedCodedErrorCodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedCodedErrorCodeCall) ReturnsInOrder(rets ...int) *MockTypedCodedErrorCodeCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Error mocks base method.
+func (m *MockTypedCodedError) Error() string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedCodedError; create it with NewMockTypedCodedError")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockTypedCodedErrorMockRecorder) Error() *MockTypedCodedErrorErrorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockTypedCodedError)(nil).Error))
+	return &MockTypedCodedErrorErrorCall{Call: call}
+}
+
+// MockTypedCodedErrorErrorCall wrap *gomock.Call
+type MockTypedCodedErrorErrorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedCodedErrorErrorCall) Return(arg0 string) *MockTypedCodedErrorErrorCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedCodedErrorErrorCall) Do(f func() string) *MockTypedCodedErrorErrorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedCodedErrorErrorCall) DoAndReturn(f func() string) *MockTypedCodedErrorErrorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedCodedErrorErrorCall) ReturnsInOrder(rets ...string) *MockTypedCodedErrorErrorCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// MockTypedStore is a mock of Store interface.
+type MockTypedStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockTypedStoreMockRecorder
+}
+
+// MockTypedStoreMockRecorder is the mock recorder for MockTypedStore.
+type MockTypedStoreMockRecorder struct {
+	mock *MockTypedStore
+}
+
+// NewMockTypedStore creates a new mock instance.
+func NewMockTypedStore(ctrl *gomock.Controller) *MockTypedStore {
+	mock := &MockTypedStore{ctrl: ctrl}
+	mock.recorder = &MockTypedStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTypedStore) EXPECT() *MockTypedStoreMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedStore; create it with NewMockTypedStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockTypedStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Get mocks base method.
+func (m *MockTypedStore) Get(key string) (string, MyErr) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedStore; create it with NewMockTypedStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(MyErr)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTypedStoreMockRecorder) Get(key any) *MockTypedStoreGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTypedStore)(nil).Get), key)
+	return &MockTypedStoreGetCall{Call: call}
+}
+
+// MockTypedStoreGetCall wrap *gomock.Call
+type MockTypedStoreGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedStoreGetCall) Return(value string, err MyErr) *MockTypedStoreGetCall {
+	c.Call = c.Call.Return(value, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedStoreGetCall) Do(f func(string) (string, MyErr)) *MockTypedStoreGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedStoreGetCall) DoAndReturn(f func(string) (string, MyErr)) *MockTypedStoreGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedStoreGetCall) ReturnsInOrder(rets ...[]any) *MockTypedStoreGetCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// Put mocks base method.
+func (m *MockTypedStore) Put(key, value string) CodedError {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedStore; create it with NewMockTypedStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", key, value)
+	ret0, _ := ret[0].(CodedError)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockTypedStoreMockRecorder) Put(key, value any) *MockTypedStorePutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockTypedStore)(nil).Put), key, value)
+	return &MockTypedStorePutCall{Call: call}
+}
+
+// MockTypedStorePutCall wrap *gomock.Call
+type MockTypedStorePutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedStorePutCall) Return(arg0 CodedError) *MockTypedStorePutCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedStorePutCall) Do(f func(string, string) CodedError) *MockTypedStorePutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedStorePutCall) DoAndReturn(f func(string, string) CodedError) *MockTypedStorePutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedStorePutCall) ReturnsInOrder(rets ...CodedError) *MockTypedStorePutCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
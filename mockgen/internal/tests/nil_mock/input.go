@@ -0,0 +1,11 @@
+package nil_mock
+
+//go:generate mockgen -package nil_mock -destination mock.go -source input.go
+
+// Foo is mocked to check that using a mock without its constructor fails
+// with a clear message.
+type Foo interface {
+	Bar(s string) int
+	// Do has a parameter named after the panic the nil check calls.
+	Do(panic string) error
+}
@@ -0,0 +1,52 @@
+package nil_mock
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUninitializedMock(t *testing.T) {
+	var nilMock *MockFoo
+	tests := []struct {
+		name string
+		mock *MockFoo
+	}{
+		{name: "nil", mock: nilMock},
+		{name: "zero value", mock: &MockFoo{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, call := range []func(){
+				func() { tt.mock.Bar("a") },
+				func() { tt.mock.Do("a") },
+				func() { tt.mock.EXPECT() },
+			} {
+				msg := recoverPanic(call)
+				if !strings.Contains(msg, "*MockFoo") || !strings.Contains(msg, "NewMockFoo") {
+					t.Errorf("panic message %q doesn't name the mock type and its constructor", msg)
+				}
+			}
+		})
+	}
+}
+
+func TestParamNamedPanic(t *testing.T) {
+	m := NewMockFoo(gomock.NewController(t))
+	errDone := errors.New("done")
+	m.EXPECT().Do("a").Return(errDone)
+	if err := m.Do("a"); err != errDone {
+		t.Errorf("Do(a) = %v, want %v", err, errDone)
+	}
+}
+
+func recoverPanic(f func()) (msg string) {
+	defer func() {
+		msg = fmt.Sprint(recover())
+	}()
+	f()
+	return ""
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package nil_mock -destination mock.go -source input.go
+//
+
+// Package nil_mock is a generated GoMock package.
+package nil_mock
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFoo is a mock of Foo interface.
+type MockFoo struct {
+	ctrl     *gomock.Controller
+	recorder *MockFooMockRecorder
+}
+
+// MockFooMockRecorder is the mock recorder for MockFoo.
+type MockFooMockRecorder struct {
+	mock *MockFoo
+}
+
+// NewMockFoo creates a new mock instance.
+func NewMockFoo(ctrl *gomock.Controller) *MockFoo {
+	mock := &MockFoo{ctrl: ctrl}
+	mock.recorder = &MockFooMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFoo) EXPECT() *MockFooMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockFoo; create it with NewMockFoo")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockFoo) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Bar mocks base method.
+func (m *MockFoo) Bar(s string) int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockFoo; create it with NewMockFoo")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bar", s)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Bar indicates an expected call of Bar.
+func (mr *MockFooMockRecorder) Bar(s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bar", reflect.TypeOf((*MockFoo)(nil).Bar), s)
+}
+
+// Do mocks base method.
+func (m *MockFoo) Do(panic_2 string) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockFoo; create it with NewMockFoo")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", panic_2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockFooMockRecorder) Do(panic_2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockFoo)(nil).Do), panic_2)
+}
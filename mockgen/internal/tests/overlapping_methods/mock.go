@@ -35,11 +35,22 @@ func NewMockReadWriteCloser(ctrl *gomock.Controller) *MockReadWriteCloser {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockReadWriteCloser) EXPECT() *MockReadWriteCloserMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReadWriteCloser; create it with NewMockReadWriteCloser")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockReadWriteCloser) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Close mocks base method.
 func (m *MockReadWriteCloser) Close() error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReadWriteCloser; create it with NewMockReadWriteCloser")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Close")
 	ret0, _ := ret[0].(error)
@@ -54,6 +65,9 @@ func (mr *MockReadWriteCloserMockRecorder) Close() *gomock.Call {
 
 // Read mocks base method.
 func (m *MockReadWriteCloser) Read(arg0 []byte) (int, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReadWriteCloser; create it with NewMockReadWriteCloser")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Read", arg0)
 	ret0, _ := ret[0].(int)
@@ -69,6 +83,9 @@ func (mr *MockReadWriteCloserMockRecorder) Read(arg0 any) *gomock.Call {
 
 // Write mocks base method.
 func (m *MockReadWriteCloser) Write(arg0 []byte) (int, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReadWriteCloser; create it with NewMockReadWriteCloser")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Write", arg0)
 	ret0, _ := ret[0].(int)
@@ -35,11 +35,22 @@ func NewMockFoo(ctrl *gomock.Controller) *MockFoo {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockFoo) EXPECT() *MockFooMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockFoo; create it with NewMockFoo")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockFoo) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Bar mocks base method.
 func (m *MockFoo) Bar() string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockFoo; create it with NewMockFoo")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Bar")
 	ret0, _ := ret[0].(string)
@@ -54,6 +65,9 @@ func (mr *MockFooMockRecorder) Bar() *gomock.Call {
 
 // Baz mocks base method.
 func (m *MockFoo) Baz() string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockFoo; create it with NewMockFoo")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Baz")
 	ret0, _ := ret[0].(string)
@@ -0,0 +1,15 @@
+package param_names
+
+//go:generate mockgen -package param_names -destination mock.go -source input.go -typed
+
+import "context"
+
+// Doer has parameters whose names would collide with the names mockgen
+// generates or shadow the packages the generated code refers to.
+type Doer interface {
+	Do(_ int, gomock string)
+	Collide(_ int, arg0 string) error
+	Context(context string) context.Context
+	Reflect(reflect int, any ...string) bool
+	Blank(_ ...string)
+}
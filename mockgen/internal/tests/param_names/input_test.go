@@ -0,0 +1,37 @@
+package param_names
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+var _ Doer = (*MockDoer)(nil)
+
+func TestRenamedParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	m := NewMockDoer(ctrl)
+	m.EXPECT().Do(1, "a")
+	m.Do(1, "a")
+
+	m.EXPECT().Collide(2, "b").Return(nil)
+	if err := m.Collide(2, "b"); err != nil {
+		t.Errorf("Collide() = %v, want nil", err)
+	}
+
+	ctx := context.Background()
+	m.EXPECT().Context("c").Return(ctx)
+	if got := m.Context("c"); got != ctx {
+		t.Errorf("Context() = %v, want %v", got, ctx)
+	}
+
+	m.EXPECT().Reflect(3, "d", "e").Return(true)
+	if !m.Reflect(3, "d", "e") {
+		t.Error("Reflect() = false, want true")
+	}
+
+	m.EXPECT().Blank("f")
+	m.Blank("f")
+}
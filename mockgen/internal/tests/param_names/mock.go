@@ -0,0 +1,300 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package param_names -destination mock.go -source input.go -typed
+//
+
+// Package param_names is a generated GoMock package.
+package param_names
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDoer is a mock of Doer interface.
+type MockDoer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDoerMockRecorder
+}
+
+// MockDoerMockRecorder is the mock recorder for MockDoer.
+type MockDoerMockRecorder struct {
+	mock *MockDoer
+}
+
+// NewMockDoer creates a new mock instance.
+func NewMockDoer(ctrl *gomock.Controller) *MockDoer {
+	mock := &MockDoer{ctrl: ctrl}
+	mock.recorder = &MockDoerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDoer) EXPECT() *MockDoerMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockDoer) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Blank mocks base method.
+func (m *MockDoer) Blank(arg0 ...string) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Blank", varargs...)
+}
+
+// Blank indicates an expected call of Blank.
+func (mr *MockDoerMockRecorder) Blank(arg0 ...any) *MockDoerBlankCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Blank", reflect.TypeOf((*MockDoer)(nil).Blank), arg0...)
+	return &MockDoerBlankCall{Call: call}
+}
+
+// MockDoerBlankCall wrap *gomock.Call
+type MockDoerBlankCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDoerBlankCall) Return() *MockDoerBlankCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDoerBlankCall) Do(f func(...string)) *MockDoerBlankCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDoerBlankCall) DoAndReturn(f func(...string)) *MockDoerBlankCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockDoerBlankCall) ReturnsInOrder(rets ...[]any) *MockDoerBlankCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// Collide mocks base method.
+func (m *MockDoer) Collide(arg0_2 int, arg0 string) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Collide", arg0_2, arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Collide indicates an expected call of Collide.
+func (mr *MockDoerMockRecorder) Collide(arg0_2, arg0 any) *MockDoerCollideCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collide", reflect.TypeOf((*MockDoer)(nil).Collide), arg0_2, arg0)
+	return &MockDoerCollideCall{Call: call}
+}
+
+// MockDoerCollideCall wrap *gomock.Call
+type MockDoerCollideCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDoerCollideCall) Return(arg0 error) *MockDoerCollideCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDoerCollideCall) Do(f func(int, string) error) *MockDoerCollideCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDoerCollideCall) DoAndReturn(f func(int, string) error) *MockDoerCollideCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockDoerCollideCall) ReturnsInOrder(rets ...error) *MockDoerCollideCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Context mocks base method.
+func (m *MockDoer) Context(context_2 string) context.Context {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context", context_2)
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockDoerMockRecorder) Context(context_2 any) *MockDoerContextCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockDoer)(nil).Context), context_2)
+	return &MockDoerContextCall{Call: call}
+}
+
+// MockDoerContextCall wrap *gomock.Call
+type MockDoerContextCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDoerContextCall) Return(arg0 context.Context) *MockDoerContextCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDoerContextCall) Do(f func(string) context.Context) *MockDoerContextCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDoerContextCall) DoAndReturn(f func(string) context.Context) *MockDoerContextCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockDoerContextCall) ReturnsInOrder(rets ...context.Context) *MockDoerContextCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Do mocks base method.
+func (m *MockDoer) Do(arg0 int, gomock_2 string) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Do", arg0, gomock_2)
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDoerMockRecorder) Do(arg0, gomock_2 any) *MockDoerDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDoer)(nil).Do), arg0, gomock_2)
+	return &MockDoerDoCall{Call: call}
+}
+
+// MockDoerDoCall wrap *gomock.Call
+type MockDoerDoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDoerDoCall) Return() *MockDoerDoCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDoerDoCall) Do(f func(int, string)) *MockDoerDoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDoerDoCall) DoAndReturn(f func(int, string)) *MockDoerDoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockDoerDoCall) ReturnsInOrder(rets ...[]any) *MockDoerDoCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// Reflect mocks base method.
+func (m *MockDoer) Reflect(reflect_2 int, any_2 ...string) bool {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockDoer; create it with NewMockDoer")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{reflect_2}
+	for _, a := range any_2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Reflect", varargs...)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Reflect indicates an expected call of Reflect.
+func (mr *MockDoerMockRecorder) Reflect(reflect_2 any, any_2 ...any) *MockDoerReflectCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{reflect_2}, any_2...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reflect", reflect.TypeOf((*MockDoer)(nil).Reflect), varargs...)
+	return &MockDoerReflectCall{Call: call}
+}
+
+// MockDoerReflectCall wrap *gomock.Call
+type MockDoerReflectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDoerReflectCall) Return(arg0 bool) *MockDoerReflectCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDoerReflectCall) Do(f func(int, ...string) bool) *MockDoerReflectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDoerReflectCall) DoAndReturn(f func(int, ...string) bool) *MockDoerReflectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockDoerReflectCall) ReturnsInOrder(rets ...bool) *MockDoerReflectCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
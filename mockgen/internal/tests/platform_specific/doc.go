@@ -0,0 +1,5 @@
+// Package platform_specific has an interface whose method set depends on
+// GOOS, to test generating a mock for another platform with -goos.
+package platform_specific
+
+//go:generate mockgen -package platform_specific -destination mock_windows.go -goos windows . Console
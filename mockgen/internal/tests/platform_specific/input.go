@@ -0,0 +1,9 @@
+//go:build windows
+
+package platform_specific
+
+// Console is a terminal window. On Windows it also exposes its handle.
+type Console interface {
+	SetTitle(title string) error
+	Handle() uintptr
+}
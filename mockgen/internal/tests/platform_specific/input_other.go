@@ -0,0 +1,8 @@
+//go:build !windows
+
+package platform_specific
+
+// Console is a terminal window.
+type Console interface {
+	SetTitle(title string) error
+}
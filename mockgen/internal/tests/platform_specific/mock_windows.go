@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/platform_specific (interfaces: Console)
+//
+// Generated by this command:
+//
+//	mockgen -package platform_specific -destination mock_windows.go -goos windows . Console
+//
+
+//go:build windows
+
+// Package platform_specific is a generated GoMock package.
+package platform_specific
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConsole is a mock of Console interface.
+type MockConsole struct {
+	ctrl     *gomock.Controller
+	recorder *MockConsoleMockRecorder
+}
+
+// MockConsoleMockRecorder is the mock recorder for MockConsole.
+type MockConsoleMockRecorder struct {
+	mock *MockConsole
+}
+
+// NewMockConsole creates a new mock instance.
+func NewMockConsole(ctrl *gomock.Controller) *MockConsole {
+	mock := &MockConsole{ctrl: ctrl}
+	mock.recorder = &MockConsoleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConsole) EXPECT() *MockConsoleMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockConsole; create it with NewMockConsole")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockConsole) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Handle mocks base method.
+func (m *MockConsole) Handle() uintptr {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockConsole; create it with NewMockConsole")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle")
+	ret0, _ := ret[0].(uintptr)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockConsoleMockRecorder) Handle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockConsole)(nil).Handle))
+}
+
+// SetTitle mocks base method.
+func (m *MockConsole) SetTitle(arg0 string) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockConsole; create it with NewMockConsole")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTitle", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTitle indicates an expected call of SetTitle.
+func (mr *MockConsoleMockRecorder) SetTitle(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTitle", reflect.TypeOf((*MockConsole)(nil).SetTitle), arg0)
+}
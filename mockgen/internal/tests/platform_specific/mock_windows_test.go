@@ -0,0 +1,21 @@
+//go:build windows
+
+package platform_specific
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+var _ Console = (*MockConsole)(nil)
+
+func TestMockConsoleHandle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	m := NewMockConsole(ctrl)
+	m.EXPECT().Handle().Return(uintptr(42))
+	if got := m.Handle(); got != 42 {
+		t.Errorf("Handle() = %v, want 42", got)
+	}
+}
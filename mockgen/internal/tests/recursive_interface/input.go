@@ -0,0 +1,21 @@
+// Package recursive_interface has interfaces referring to themselves.
+package recursive_interface
+
+//go:generate mockgen -package recursive_interface -destination source_mock.go -source input.go -mock_names Node=MockSourceNode,Tree=MockSourceTree
+//go:generate mockgen -package recursive_interface -destination reflect_mock.go . Node,Tree
+//go:generate mockgen -destination mock_recursive_interface/mock.go . Node,Tree
+
+// Node is a node of a linked list.
+type Node interface {
+	Next() Node
+	SetNext(Node)
+	Walk(func(Node) bool) Node
+}
+
+// Tree is a node of a tree.
+type Tree interface {
+	Children() []Tree
+	Parent() (Tree, bool)
+	Index() map[string]Tree
+	List() Node
+}
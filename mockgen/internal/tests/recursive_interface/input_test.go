@@ -0,0 +1,44 @@
+package recursive_interface_test
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/recursive_interface"
+	"go.uber.org/mock/mockgen/internal/tests/recursive_interface/mock_recursive_interface"
+)
+
+var (
+	_ recursive_interface.Node = (*recursive_interface.MockNode)(nil)
+	_ recursive_interface.Node = (*recursive_interface.MockSourceNode)(nil)
+	_ recursive_interface.Node = (*mock_recursive_interface.MockNode)(nil)
+	_ recursive_interface.Tree = (*recursive_interface.MockTree)(nil)
+	_ recursive_interface.Tree = (*recursive_interface.MockSourceTree)(nil)
+	_ recursive_interface.Tree = (*mock_recursive_interface.MockTree)(nil)
+)
+
+func TestLinkedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	head := recursive_interface.NewMockSourceNode(ctrl)
+	tail := mock_recursive_interface.NewMockNode(ctrl)
+	head.EXPECT().SetNext(tail)
+	head.EXPECT().Next().Return(tail)
+	tail.EXPECT().Next().Return(nil)
+
+	head.SetNext(tail)
+	if got := head.Next(); got != tail {
+		t.Errorf("Next() = %v, want the tail", got)
+	}
+	if got := tail.Next(); got != nil {
+		t.Errorf("tail Next() = %v, want nil", got)
+	}
+
+	root := recursive_interface.NewMockTree(ctrl)
+	child := recursive_interface.NewMockTree(ctrl)
+	root.EXPECT().Children().Return([]recursive_interface.Tree{child})
+	child.EXPECT().Parent().Return(root, true)
+	if got, ok := root.Children()[0].Parent(); !ok || got != root {
+		t.Errorf("Parent() = %v, %v, want the root", got, ok)
+	}
+}
@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/recursive_interface (interfaces: Node,Tree)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_recursive_interface/mock.go . Node,Tree
+//
+
+// Package mock_recursive_interface is a generated GoMock package.
+package mock_recursive_interface
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	recursive_interface "go.uber.org/mock/mockgen/internal/tests/recursive_interface"
+)
+
+// MockNode is a mock of Node interface.
+type MockNode struct {
+	ctrl     *gomock.Controller
+	recorder *MockNodeMockRecorder
+}
+
+// MockNodeMockRecorder is the mock recorder for MockNode.
+type MockNodeMockRecorder struct {
+	mock *MockNode
+}
+
+// NewMockNode creates a new mock instance.
+func NewMockNode(ctrl *gomock.Controller) *MockNode {
+	mock := &MockNode{ctrl: ctrl}
+	mock.recorder = &MockNodeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNode) EXPECT() *MockNodeMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockNode; create it with NewMockNode")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockNode) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Next mocks base method.
+func (m *MockNode) Next() recursive_interface.Node {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockNode; create it with NewMockNode")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next")
+	ret0, _ := ret[0].(recursive_interface.Node)
+	return ret0
+}
+
+// Next indicates an expected call of Next.
+func (mr *MockNodeMockRecorder) Next() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockNode)(nil).Next))
+}
+
+// SetNext mocks base method.
+func (m *MockNode) SetNext(arg0 recursive_interface.Node) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockNode; create it with NewMockNode")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetNext", arg0)
+}
+
+// SetNext indicates an expected call of SetNext.
+func (mr *MockNodeMockRecorder) SetNext(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNext", reflect.TypeOf((*MockNode)(nil).SetNext), arg0)
+}
+
+// Walk mocks base method.
+func (m *MockNode) Walk(arg0 func(recursive_interface.Node) bool) recursive_interface.Node {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockNode; create it with NewMockNode")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Walk", arg0)
+	ret0, _ := ret[0].(recursive_interface.Node)
+	return ret0
+}
+
+// Walk indicates an expected call of Walk.
+func (mr *MockNodeMockRecorder) Walk(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Walk", reflect.TypeOf((*MockNode)(nil).Walk), arg0)
+}
+
+// MockTree is a mock of Tree interface.
+type MockTree struct {
+	ctrl     *gomock.Controller
+	recorder *MockTreeMockRecorder
+}
+
+// MockTreeMockRecorder is the mock recorder for MockTree.
+type MockTreeMockRecorder struct {
+	mock *MockTree
+}
+
+// NewMockTree creates a new mock instance.
+func NewMockTree(ctrl *gomock.Controller) *MockTree {
+	mock := &MockTree{ctrl: ctrl}
+	mock.recorder = &MockTreeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTree) EXPECT() *MockTreeMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTree; create it with NewMockTree")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockTree) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Children mocks base method.
+func (m *MockTree) Children() []recursive_interface.Tree {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTree; create it with NewMockTree")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Children")
+	ret0, _ := ret[0].([]recursive_interface.Tree)
+	return ret0
+}
+
+// Children indicates an expected call of Children.
+func (mr *MockTreeMockRecorder) Children() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Children", reflect.TypeOf((*MockTree)(nil).Children))
+}
+
+// Index mocks base method.
+func (m *MockTree) Index() map[string]recursive_interface.Tree {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTree; create it with NewMockTree")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Index")
+	ret0, _ := ret[0].(map[string]recursive_interface.Tree)
+	return ret0
+}
+
+// Index indicates an expected call of Index.
+func (mr *MockTreeMockRecorder) Index() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Index", reflect.TypeOf((*MockTree)(nil).Index))
+}
+
+// List mocks base method.
+func (m *MockTree) List() recursive_interface.Node {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTree; create it with NewMockTree")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].(recursive_interface.Node)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockTreeMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTree)(nil).List))
+}
+
+// Parent mocks base method.
+func (m *MockTree) Parent() (recursive_interface.Tree, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTree; create it with NewMockTree")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parent")
+	ret0, _ := ret[0].(recursive_interface.Tree)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Parent indicates an expected call of Parent.
+func (mr *MockTreeMockRecorder) Parent() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parent", reflect.TypeOf((*MockTree)(nil).Parent))
+}
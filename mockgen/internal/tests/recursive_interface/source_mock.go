@@ -0,0 +1,196 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package recursive_interface -destination source_mock.go -source input.go -mock_names Node=MockSourceNode,Tree=MockSourceTree
+//
+
+// Package recursive_interface is a generated GoMock package.
+package recursive_interface
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSourceNode is a mock of Node interface.
+type MockSourceNode struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceNodeMockRecorder
+}
+
+// MockSourceNodeMockRecorder is the mock recorder for MockSourceNode.
+type MockSourceNodeMockRecorder struct {
+	mock *MockSourceNode
+}
+
+// NewMockSourceNode creates a new mock instance.
+func NewMockSourceNode(ctrl *gomock.Controller) *MockSourceNode {
+	mock := &MockSourceNode{ctrl: ctrl}
+	mock.recorder = &MockSourceNodeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceNode) EXPECT() *MockSourceNodeMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceNode; create it with NewMockSourceNode")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceNode) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Next mocks base method.
+func (m *MockSourceNode) Next() Node {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceNode; create it with NewMockSourceNode")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next")
+	ret0, _ := ret[0].(Node)
+	return ret0
+}
+
+// Next indicates an expected call of Next.
+func (mr *MockSourceNodeMockRecorder) Next() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockSourceNode)(nil).Next))
+}
+
+// SetNext mocks base method.
+func (m *MockSourceNode) SetNext(arg0 Node) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceNode; create it with NewMockSourceNode")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetNext", arg0)
+}
+
+// SetNext indicates an expected call of SetNext.
+func (mr *MockSourceNodeMockRecorder) SetNext(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNext", reflect.TypeOf((*MockSourceNode)(nil).SetNext), arg0)
+}
+
+// Walk mocks base method.
+func (m *MockSourceNode) Walk(arg0 func(Node) bool) Node {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceNode; create it with NewMockSourceNode")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Walk", arg0)
+	ret0, _ := ret[0].(Node)
+	return ret0
+}
+
+// Walk indicates an expected call of Walk.
+func (mr *MockSourceNodeMockRecorder) Walk(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Walk", reflect.TypeOf((*MockSourceNode)(nil).Walk), arg0)
+}
+
+// MockSourceTree is a mock of Tree interface.
+type MockSourceTree struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceTreeMockRecorder
+}
+
+// MockSourceTreeMockRecorder is the mock recorder for MockSourceTree.
+type MockSourceTreeMockRecorder struct {
+	mock *MockSourceTree
+}
+
+// NewMockSourceTree creates a new mock instance.
+func NewMockSourceTree(ctrl *gomock.Controller) *MockSourceTree {
+	mock := &MockSourceTree{ctrl: ctrl}
+	mock.recorder = &MockSourceTreeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceTree) EXPECT() *MockSourceTreeMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceTree; create it with NewMockSourceTree")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceTree) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Children mocks base method.
+func (m *MockSourceTree) Children() []Tree {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceTree; create it with NewMockSourceTree")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Children")
+	ret0, _ := ret[0].([]Tree)
+	return ret0
+}
+
+// Children indicates an expected call of Children.
+func (mr *MockSourceTreeMockRecorder) Children() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Children", reflect.TypeOf((*MockSourceTree)(nil).Children))
+}
+
+// Index mocks base method.
+func (m *MockSourceTree) Index() map[string]Tree {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceTree; create it with NewMockSourceTree")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Index")
+	ret0, _ := ret[0].(map[string]Tree)
+	return ret0
+}
+
+// Index indicates an expected call of Index.
+func (mr *MockSourceTreeMockRecorder) Index() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Index", reflect.TypeOf((*MockSourceTree)(nil).Index))
+}
+
+// List mocks base method.
+func (m *MockSourceTree) List() Node {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceTree; create it with NewMockSourceTree")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].(Node)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockSourceTreeMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSourceTree)(nil).List))
+}
+
+// Parent mocks base method.
+func (m *MockSourceTree) Parent() (Tree, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceTree; create it with NewMockSourceTree")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parent")
+	ret0, _ := ret[0].(Tree)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Parent indicates an expected call of Parent.
+func (mr *MockSourceTreeMockRecorder) Parent() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parent", reflect.TypeOf((*MockSourceTree)(nil).Parent))
+}
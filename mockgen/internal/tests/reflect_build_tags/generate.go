@@ -0,0 +1,5 @@
+// Package reflect_build_tags mocks in reflect mode an interface declared in a
+// file that is only built with the tools build tag.
+package reflect_build_tags
+
+//go:generate mockgen -build_tags tools -package reflect_build_tags -destination mock.go . Helper
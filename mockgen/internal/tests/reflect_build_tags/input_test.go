@@ -0,0 +1,17 @@
+package reflect_build_tags
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestMockFromTaggedFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	m := NewMockHelper(ctrl)
+	m.EXPECT().Run("gofmt", "-l", ".").Return(nil)
+	if err := m.Run("gofmt", "-l", "."); err != nil {
+		t.Errorf("Run() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/reflect_build_tags (interfaces: Helper)
+//
+// Generated by this command:
+//
+//	mockgen -build_tags tools -package reflect_build_tags -destination mock.go . Helper
+//
+
+// Package reflect_build_tags is a generated GoMock package.
+package reflect_build_tags
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHelper is a mock of Helper interface.
+type MockHelper struct {
+	ctrl     *gomock.Controller
+	recorder *MockHelperMockRecorder
+}
+
+// MockHelperMockRecorder is the mock recorder for MockHelper.
+type MockHelperMockRecorder struct {
+	mock *MockHelper
+}
+
+// NewMockHelper creates a new mock instance.
+func NewMockHelper(ctrl *gomock.Controller) *MockHelper {
+	mock := &MockHelper{ctrl: ctrl}
+	mock.recorder = &MockHelperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHelper) EXPECT() *MockHelperMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHelper; create it with NewMockHelper")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockHelper) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Run mocks base method.
+func (m *MockHelper) Run(arg0 string, arg1 ...string) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHelper; create it with NewMockHelper")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Run", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockHelperMockRecorder) Run(arg0 any, arg1 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockHelper)(nil).Run), varargs...)
+}
@@ -0,0 +1,8 @@
+//go:build tools
+
+package reflect_build_tags
+
+// Helper is only visible with the tools build tag.
+type Helper interface {
+	Run(name string, args ...string) error
+}
@@ -0,0 +1,5 @@
+//go:build tools
+
+package reflect_build_tags
+
+var _ Helper = (*MockHelper)(nil)
@@ -0,0 +1,10 @@
+// Package dep stands in for a dependency that the module of this fixture
+// replaces with a local copy.
+package dep
+
+// Store is only declared by the local copy of the dependency, with a method
+// set that no published version has.
+type Store interface {
+	Get(key string) (string, error)
+	LocalOnly()
+}
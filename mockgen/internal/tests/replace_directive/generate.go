@@ -0,0 +1,5 @@
+// Package replace_directive mocks an interface of a dependency that its
+// go.mod replaces with a local directory.
+package replace_directive
+
+//go:generate mockgen -destination mock_dep/mock.go example.com/dep Store
@@ -0,0 +1,20 @@
+package replace_directive
+
+import (
+	"testing"
+
+	"example.com/dep"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/replace_directive/mock_dep"
+)
+
+// The mock has the method set of the local copy of the dependency.
+var _ dep.Store = (*mock_dep.MockStore)(nil)
+
+func TestReplacedDependency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	m := mock_dep.NewMockStore(ctrl)
+	m.EXPECT().LocalOnly()
+	m.LocalOnly()
+}
@@ -0,0 +1,12 @@
+module go.uber.org/mock/mockgen/internal/tests/replace_directive
+
+go 1.18
+
+replace go.uber.org/mock => ../../../..
+
+replace example.com/dep => ./dep
+
+require (
+	example.com/dep v1.0.0
+	go.uber.org/mock v0.0.0-00010101000000-000000000000
+)
@@ -0,0 +1,16 @@
+package return_zero
+
+//go:generate mockgen -package return_zero -destination mock.go -source input.go -typed -return_zero
+
+type Point struct{ X, Y int }
+
+type Store interface {
+	Get(key string) (string, error)
+	Lookup(key string) (value *Point, c bool)
+	List() ([]string, map[string]int, Point, func())
+	Delete(key string)
+}
+
+type Cache[V any] interface {
+	Load(key string) (V, bool)
+}
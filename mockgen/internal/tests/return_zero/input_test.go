@@ -0,0 +1,35 @@
+package return_zero
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestReturnZero(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := NewMockStore(ctrl)
+	store.EXPECT().Get("a").ReturnZero().Times(2)
+	store.EXPECT().Lookup("a").ReturnZero()
+	store.EXPECT().List().ReturnZero()
+	store.EXPECT().Delete("a").ReturnZero()
+
+	for i := 0; i < 2; i++ {
+		if v, err := store.Get("a"); v != "" || err != nil {
+			t.Errorf("Get = %q, %v, want zero values", v, err)
+		}
+	}
+	if v, ok := store.Lookup("a"); v != nil || ok {
+		t.Errorf("Lookup = %v, %v, want zero values", v, ok)
+	}
+	if l, m, p, f := store.List(); l != nil || m != nil || p != (Point{}) || f != nil {
+		t.Errorf("List = %v, %v, %v, %p, want zero values", l, m, p, f)
+	}
+	store.Delete("a")
+
+	cache := NewMockCache[[]int](ctrl)
+	cache.EXPECT().Load("a").ReturnZero()
+	if v, ok := cache.Load("a"); v != nil || ok {
+		t.Errorf("Load = %v, %v, want zero values", v, ok)
+	}
+}
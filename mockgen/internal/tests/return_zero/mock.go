@@ -0,0 +1,365 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package return_zero -destination mock.go -source input.go -typed -return_zero
+//
+
+// Package return_zero is a generated GoMock package.
+package return_zero
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Delete mocks base method.
+func (m *MockStore) Delete(key string) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", key)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStoreMockRecorder) Delete(key any) *MockStoreDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStore)(nil).Delete), key)
+	return &MockStoreDeleteCall{Call: call}
+}
+
+// MockStoreDeleteCall wrap *gomock.Call
+type MockStoreDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreDeleteCall) Return() *MockStoreDeleteCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreDeleteCall) Do(f func(string)) *MockStoreDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreDeleteCall) DoAndReturn(f func(string)) *MockStoreDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnZero rewrite *gomock.Call.Return with the zero values of the results
+func (c *MockStoreDeleteCall) ReturnZero() *MockStoreDeleteCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStoreDeleteCall) ReturnsInOrder(rets ...[]any) *MockStoreDeleteCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// Get mocks base method.
+func (m *MockStore) Get(key string) (string, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStoreMockRecorder) Get(key any) *MockStoreGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), key)
+	return &MockStoreGetCall{Call: call}
+}
+
+// MockStoreGetCall wrap *gomock.Call
+type MockStoreGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreGetCall) Return(arg0 string, arg1 error) *MockStoreGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreGetCall) Do(f func(string) (string, error)) *MockStoreGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreGetCall) DoAndReturn(f func(string) (string, error)) *MockStoreGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnZero rewrite *gomock.Call.Return with the zero values of the results
+func (c *MockStoreGetCall) ReturnZero() *MockStoreGetCall {
+	var (
+		arg0 string
+		arg1 error
+	)
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStoreGetCall) ReturnsInOrder(rets ...[]any) *MockStoreGetCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// List mocks base method.
+func (m *MockStore) List() ([]string, map[string]int, Point, func()) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(map[string]int)
+	ret2, _ := ret[2].(Point)
+	ret3, _ := ret[3].(func())
+	return ret0, ret1, ret2, ret3
+}
+
+// List indicates an expected call of List.
+func (mr *MockStoreMockRecorder) List() *MockStoreListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockStore)(nil).List))
+	return &MockStoreListCall{Call: call}
+}
+
+// MockStoreListCall wrap *gomock.Call
+type MockStoreListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreListCall) Return(arg0 []string, arg1 map[string]int, arg2 Point, arg3 func()) *MockStoreListCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2, arg3)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreListCall) Do(f func() ([]string, map[string]int, Point, func())) *MockStoreListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreListCall) DoAndReturn(f func() ([]string, map[string]int, Point, func())) *MockStoreListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnZero rewrite *gomock.Call.Return with the zero values of the results
+func (c *MockStoreListCall) ReturnZero() *MockStoreListCall {
+	var (
+		arg0 []string
+		arg1 map[string]int
+		arg2 Point
+		arg3 func()
+	)
+	c.Call = c.Call.Return(arg0, arg1, arg2, arg3)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStoreListCall) ReturnsInOrder(rets ...[]any) *MockStoreListCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// Lookup mocks base method.
+func (m *MockStore) Lookup(key string) (*Point, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lookup", key)
+	ret0, _ := ret[0].(*Point)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Lookup indicates an expected call of Lookup.
+func (mr *MockStoreMockRecorder) Lookup(key any) *MockStoreLookupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lookup", reflect.TypeOf((*MockStore)(nil).Lookup), key)
+	return &MockStoreLookupCall{Call: call}
+}
+
+// MockStoreLookupCall wrap *gomock.Call
+type MockStoreLookupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockStoreLookupCall) Return(value *Point, c bool) *MockStoreLookupCall {
+	c_2.Call = c_2.Call.Return(value, c)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockStoreLookupCall) Do(f func(string) (*Point, bool)) *MockStoreLookupCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockStoreLookupCall) DoAndReturn(f func(string) (*Point, bool)) *MockStoreLookupCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// ReturnZero rewrite *gomock.Call.Return with the zero values of the results
+func (c_2 *MockStoreLookupCall) ReturnZero() *MockStoreLookupCall {
+	var (
+		value *Point
+		c     bool
+	)
+	c_2.Call = c_2.Call.Return(value, c)
+	return c_2
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c_2 *MockStoreLookupCall) ReturnsInOrder(rets ...[]any) *MockStoreLookupCall {
+	c_2.Call = c_2.Call.ReturnsInOrder(rets...)
+	return c_2
+}
+
+// MockCache is a mock of Cache interface.
+type MockCache[V any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMockRecorder[V]
+}
+
+// MockCacheMockRecorder is the mock recorder for MockCache.
+type MockCacheMockRecorder[V any] struct {
+	mock *MockCache[V]
+}
+
+// NewMockCache creates a new mock instance.
+func NewMockCache[V any](ctrl *gomock.Controller) *MockCache[V] {
+	mock := &MockCache[V]{ctrl: ctrl}
+	mock.recorder = &MockCacheMockRecorder[V]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCache[V]) EXPECT() *MockCacheMockRecorder[V] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockCache[V]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Load mocks base method.
+func (m *MockCache[V]) Load(key string) (V, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", key)
+	ret0, _ := ret[0].(V)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockCacheMockRecorder[V]) Load(key any) *MockCacheLoadCall[V] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockCache[V])(nil).Load), key)
+	return &MockCacheLoadCall[V]{Call: call}
+}
+
+// MockCacheLoadCall wrap *gomock.Call
+type MockCacheLoadCall[V any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCacheLoadCall[V]) Return(arg0 V, arg1 bool) *MockCacheLoadCall[V] {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCacheLoadCall[V]) Do(f func(string) (V, bool)) *MockCacheLoadCall[V] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCacheLoadCall[V]) DoAndReturn(f func(string) (V, bool)) *MockCacheLoadCall[V] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnZero rewrite *gomock.Call.Return with the zero values of the results
+func (c *MockCacheLoadCall[V]) ReturnZero() *MockCacheLoadCall[V] {
+	var (
+		arg0 V
+		arg1 bool
+	)
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockCacheLoadCall[V]) ReturnsInOrder(rets ...[]any) *MockCacheLoadCall[V] {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
@@ -36,11 +36,22 @@ func NewMockAnyMock(ctrl *gomock.Controller) *MockAnyMock {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockAnyMock) EXPECT() *MockAnyMockMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockAnyMock; create it with NewMockAnyMock")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockAnyMock) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Do mocks base method.
 func (m *MockAnyMock) Do(arg0 *any0.Any, arg1 int) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockAnyMock; create it with NewMockAnyMock")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Do", arg0, arg1)
 }
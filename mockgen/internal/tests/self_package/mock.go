@@ -35,11 +35,22 @@ func NewMockMethods(ctrl *gomock.Controller) *MockMethods {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockMethods) EXPECT() *MockMethodsMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockMethods; create it with NewMockMethods")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockMethods) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // getInfo mocks base method.
 func (m *MockMethods) getInfo() Info {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockMethods; create it with NewMockMethods")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "getInfo")
 	ret0, _ := ret[0].(Info)
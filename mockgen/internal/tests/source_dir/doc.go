@@ -0,0 +1,6 @@
+// Package source_dir declares interfaces across several files, which
+// -source_dir mocks together.
+package source_dir
+
+//go:generate mockgen -destination mock_source_dir/mock.go -source_dir .
+//go:generate mockgen -package source_dir -destination mock_test.go -source_dir . -include_tests
@@ -0,0 +1,24 @@
+package source_dir
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+// Fixture is only mocked with -include_tests.
+type Fixture interface {
+	Setup(store Store) error
+}
+
+func TestIncludeTests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := NewMockStore(ctrl)
+	fixture := NewMockFixture(ctrl)
+	fixture.EXPECT().Setup(store).Return(nil)
+
+	var f Fixture = fixture
+	if err := f.Setup(store); err != nil {
+		t.Errorf("Setup = %v, want nil", err)
+	}
+}
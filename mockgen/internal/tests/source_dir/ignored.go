@@ -0,0 +1,8 @@
+//go:build ignore
+
+package source_dir
+
+// Ignored is not mocked, since the go command does not build its file.
+type Ignored interface {
+	Ignore()
+}
@@ -0,0 +1,39 @@
+package source_dir_test
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/source_dir"
+	"go.uber.org/mock/mockgen/internal/tests/source_dir/mock_source_dir"
+)
+
+// External is not mocked even with -include_tests, since it belongs to
+// another package.
+type External interface {
+	Run()
+}
+
+func TestSourceDir(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := mock_source_dir.NewMockStore(ctrl)
+	m.EXPECT().Read("a").Return([]byte("1"), nil)
+	m.EXPECT().Write("a", []byte("2")).Return(nil)
+	m.EXPECT().Ping(gomock.Any()).Return(nil)
+	m.EXPECT().Close().Return(nil)
+
+	var store source_dir.Store = m
+	if v, err := store.Read("a"); string(v) != "1" || err != nil {
+		t.Errorf("Read = %q, %v, want 1, nil", v, err)
+	}
+	if err := store.Write("a", []byte("2")); err != nil {
+		t.Errorf("Write = %v, want nil", err)
+	}
+	if err := store.Ping(context.Background()); err != nil {
+		t.Errorf("Ping = %v, want nil", err)
+	}
+	if err := store.Close(); err != nil {
+		t.Errorf("Close = %v, want nil", err)
+	}
+}
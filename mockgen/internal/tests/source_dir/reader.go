@@ -0,0 +1,13 @@
+package source_dir
+
+import "io"
+
+type Reader interface {
+	Read(key string) ([]byte, error)
+}
+
+// ReadCloser embeds Closer of another file of the package.
+type ReadCloser interface {
+	Reader
+	io.Closer
+}
@@ -0,0 +1,10 @@
+package source_dir
+
+import "context"
+
+// Store embeds interfaces of other files of the package.
+type Store interface {
+	ReadCloser
+	Writer
+	Ping(ctx context.Context) error
+}
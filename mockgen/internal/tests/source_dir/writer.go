@@ -0,0 +1,5 @@
+package source_dir
+
+type Writer interface {
+	Write(key string, value []byte) error
+}
@@ -0,0 +1,5 @@
+// Package source_dir_imports imports different packages under the same name
+// in different files, which -source_dir resolves per file.
+package source_dir_imports
+
+//go:generate mockgen -destination mock_source_dir_imports/mock.go -source_dir .
@@ -0,0 +1,40 @@
+package source_dir_imports_test
+
+import (
+	htmltemplate "html/template"
+	"math/rand"
+	"testing"
+	texttemplate "text/template"
+
+	"go.uber.org/mock/gomock"
+	"go.uber.org/mock/mockgen/internal/tests/source_dir_imports"
+	"go.uber.org/mock/mockgen/internal/tests/source_dir_imports/mock_source_dir_imports"
+)
+
+var (
+	_ source_dir_imports.Seeder       = (*mock_source_dir_imports.MockSeeder)(nil)
+	_ source_dir_imports.HTMLRenderer = (*mock_source_dir_imports.MockHTMLRenderer)(nil)
+	_ source_dir_imports.TextRenderer = (*mock_source_dir_imports.MockTextRenderer)(nil)
+)
+
+func TestImportsResolvedPerFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	seeder := mock_source_dir_imports.NewMockSeeder(ctrl)
+	html := mock_source_dir_imports.NewMockHTMLRenderer(ctrl)
+	text := mock_source_dir_imports.NewMockTextRenderer(ctrl)
+	r := rand.New(rand.NewSource(1))
+	h, x := htmltemplate.New("h"), texttemplate.New("t")
+	seeder.EXPECT().Rand(int64(1)).Return(r)
+	html.EXPECT().Template("h").Return(h)
+	text.EXPECT().Template("t").Return(x)
+
+	if got := seeder.Rand(1); got != r {
+		t.Errorf("Rand(1) = %p, want %p", got, r)
+	}
+	if got := html.Template("h"); got != h {
+		t.Errorf("html Template(h) = %p, want %p", got, h)
+	}
+	if got := text.Template("t"); got != x {
+		t.Errorf("text Template(t) = %p, want %p", got, x)
+	}
+}
@@ -0,0 +1,17 @@
+package source_dir_imports
+
+import (
+	"crypto/rand"
+	"html/template"
+)
+
+// Key returns a random key, using crypto/rand where seed.go uses math/rand.
+func Key() ([]byte, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	return b, err
+}
+
+type HTMLRenderer interface {
+	Template(name string) *template.Template
+}
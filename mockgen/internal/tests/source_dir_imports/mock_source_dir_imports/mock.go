@@ -0,0 +1,163 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: .
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_source_dir_imports/mock.go -source_dir .
+//
+
+// Package mock_source_dir_imports is a generated GoMock package.
+package mock_source_dir_imports
+
+import (
+	template "html/template"
+	rand "math/rand"
+	reflect "reflect"
+	template0 "text/template"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHTMLRenderer is a mock of HTMLRenderer interface.
+type MockHTMLRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockHTMLRendererMockRecorder
+}
+
+// MockHTMLRendererMockRecorder is the mock recorder for MockHTMLRenderer.
+type MockHTMLRendererMockRecorder struct {
+	mock *MockHTMLRenderer
+}
+
+// NewMockHTMLRenderer creates a new mock instance.
+func NewMockHTMLRenderer(ctrl *gomock.Controller) *MockHTMLRenderer {
+	mock := &MockHTMLRenderer{ctrl: ctrl}
+	mock.recorder = &MockHTMLRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHTMLRenderer) EXPECT() *MockHTMLRendererMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHTMLRenderer; create it with NewMockHTMLRenderer")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockHTMLRenderer) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Template mocks base method.
+func (m *MockHTMLRenderer) Template(name string) *template.Template {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockHTMLRenderer; create it with NewMockHTMLRenderer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template", name)
+	ret0, _ := ret[0].(*template.Template)
+	return ret0
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockHTMLRendererMockRecorder) Template(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockHTMLRenderer)(nil).Template), name)
+}
+
+// MockSeeder is a mock of Seeder interface.
+type MockSeeder struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeederMockRecorder
+}
+
+// MockSeederMockRecorder is the mock recorder for MockSeeder.
+type MockSeederMockRecorder struct {
+	mock *MockSeeder
+}
+
+// NewMockSeeder creates a new mock instance.
+func NewMockSeeder(ctrl *gomock.Controller) *MockSeeder {
+	mock := &MockSeeder{ctrl: ctrl}
+	mock.recorder = &MockSeederMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeeder) EXPECT() *MockSeederMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSeeder; create it with NewMockSeeder")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSeeder) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Rand mocks base method.
+func (m *MockSeeder) Rand(seed int64) *rand.Rand {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSeeder; create it with NewMockSeeder")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rand", seed)
+	ret0, _ := ret[0].(*rand.Rand)
+	return ret0
+}
+
+// Rand indicates an expected call of Rand.
+func (mr *MockSeederMockRecorder) Rand(seed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rand", reflect.TypeOf((*MockSeeder)(nil).Rand), seed)
+}
+
+// MockTextRenderer is a mock of TextRenderer interface.
+type MockTextRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTextRendererMockRecorder
+}
+
+// MockTextRendererMockRecorder is the mock recorder for MockTextRenderer.
+type MockTextRendererMockRecorder struct {
+	mock *MockTextRenderer
+}
+
+// NewMockTextRenderer creates a new mock instance.
+func NewMockTextRenderer(ctrl *gomock.Controller) *MockTextRenderer {
+	mock := &MockTextRenderer{ctrl: ctrl}
+	mock.recorder = &MockTextRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTextRenderer) EXPECT() *MockTextRendererMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTextRenderer; create it with NewMockTextRenderer")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockTextRenderer) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Template mocks base method.
+func (m *MockTextRenderer) Template(name string) *template0.Template {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTextRenderer; create it with NewMockTextRenderer")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template", name)
+	ret0, _ := ret[0].(*template0.Template)
+	return ret0
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockTextRendererMockRecorder) Template(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockTextRenderer)(nil).Template), name)
+}
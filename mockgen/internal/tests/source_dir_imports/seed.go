@@ -0,0 +1,7 @@
+package source_dir_imports
+
+import "math/rand"
+
+type Seeder interface {
+	Rand(seed int64) *rand.Rand
+}
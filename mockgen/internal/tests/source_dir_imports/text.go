@@ -0,0 +1,7 @@
+package source_dir_imports
+
+import "text/template"
+
+type TextRenderer interface {
+	Template(name string) *template.Template
+}
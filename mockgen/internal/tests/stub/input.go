@@ -0,0 +1,13 @@
+package stub
+
+//go:generate mockgen -package stub -destination stub.go -source input.go -stub
+
+import "io"
+
+type Store interface {
+	Get(key string) ([]byte, error)
+	Put(key string, value []byte) error
+	Keys(prefixes ...string) []string
+	Close()
+	Reader() io.Reader
+}
@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package stub -destination stub.go -source input.go -stub
+//
+
+// Package stub is a generated GoMock package.
+package stub
+
+import (
+	io "io"
+)
+
+// StubStore is a stub of Store interface.
+type StubStore struct {
+	CloseFunc  func()
+	GetFunc    func(string) ([]byte, error)
+	KeysFunc   func(...string) []string
+	PutFunc    func(string, []byte) error
+	ReaderFunc func() io.Reader
+}
+
+// Close calls CloseFunc if it is set.
+func (s *StubStore) Close() {
+	if s.CloseFunc != nil {
+		s.CloseFunc()
+		return
+	}
+}
+
+// Get calls GetFunc if it is set and otherwise returns zero values.
+func (s *StubStore) Get(key string) ([]byte, error) {
+	if s.GetFunc != nil {
+		return s.GetFunc(key)
+	}
+	var ret0 []byte
+	var ret1 error
+	return ret0, ret1
+}
+
+// Keys calls KeysFunc if it is set and otherwise returns zero values.
+func (s *StubStore) Keys(prefixes ...string) []string {
+	if s.KeysFunc != nil {
+		return s.KeysFunc(prefixes...)
+	}
+	var ret0 []string
+	return ret0
+}
+
+// Put calls PutFunc if it is set and otherwise returns zero values.
+func (s *StubStore) Put(key string, value []byte) error {
+	if s.PutFunc != nil {
+		return s.PutFunc(key, value)
+	}
+	var ret0 error
+	return ret0
+}
+
+// Reader calls ReaderFunc if it is set and otherwise returns zero values.
+func (s *StubStore) Reader() io.Reader {
+	if s.ReaderFunc != nil {
+		return s.ReaderFunc()
+	}
+	var ret0 io.Reader
+	return ret0
+}
@@ -0,0 +1,54 @@
+package stub
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+var _ Store = (*StubStore)(nil)
+
+func TestStubZeroValues(t *testing.T) {
+	var s StubStore
+	if v, err := s.Get("k"); v != nil || err != nil {
+		t.Errorf("Get() = %v, %v, want nil, nil", v, err)
+	}
+	if err := s.Put("k", nil); err != nil {
+		t.Errorf("Put() = %v, want nil", err)
+	}
+	if keys := s.Keys("a", "b"); keys != nil {
+		t.Errorf("Keys() = %v, want nil", keys)
+	}
+	if r := s.Reader(); r != nil {
+		t.Errorf("Reader() = %v, want nil", r)
+	}
+	s.Close()
+}
+
+func TestStubFuncs(t *testing.T) {
+	errClosed := errors.New("closed")
+	closed := false
+	s := &StubStore{
+		PutFunc: func(string, []byte) error { return errClosed },
+		KeysFunc: func(prefixes ...string) []string {
+			return prefixes
+		},
+		CloseFunc:  func() { closed = true },
+		ReaderFunc: func() io.Reader { return &bytes.Buffer{} },
+	}
+	if err := s.Put("k", nil); !errors.Is(err, errClosed) {
+		t.Errorf("Put() = %v, want %v", err, errClosed)
+	}
+	if keys := s.Keys("a", "b"); !reflect.DeepEqual(keys, []string{"a", "b"}) {
+		t.Errorf("Keys() = %v, want [a b]", keys)
+	}
+	if s.Reader() == nil {
+		t.Error("Reader() = nil, want non-nil")
+	}
+	s.Close()
+	if !closed {
+		t.Error("Close did not call CloseFunc")
+	}
+}
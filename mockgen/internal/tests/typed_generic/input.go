@@ -0,0 +1,16 @@
+package typed_generic
+
+import "io"
+
+//go:generate mockgen -package typed_generic -source=input.go -destination=mock.go -typed=generic
+type Store interface {
+	Close()
+	Get(key string) ([]byte, error)
+	Len() int
+	Open(name string, flags ...int) (io.ReadCloser, int64, error)
+	Stat(key string) (size int64, version int, ok bool, err error)
+}
+
+type Cache[K comparable, V any] interface {
+	Lookup(key K) (V, bool)
+}
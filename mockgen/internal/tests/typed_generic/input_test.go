@@ -0,0 +1,65 @@
+package typed_generic
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestTwoResults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	errNotFound := errors.New("not found")
+	store := NewMockStore(ctrl)
+	gomock.InOrder(
+		store.EXPECT().Get("a").Return([]byte("1"), nil),
+		store.EXPECT().Get("b").DoAndReturn(func(key string) ([]byte, error) {
+			return nil, errNotFound
+		}),
+	)
+
+	if v, err := store.Get("a"); string(v) != "1" || err != nil {
+		t.Errorf(`Get("a") = %q, %v, want "1", nil`, v, err)
+	}
+	if v, err := store.Get("b"); v != nil || err != errNotFound {
+		t.Errorf(`Get("b") = %q, %v, want nil, %v`, v, err, errNotFound)
+	}
+}
+
+func TestGenericInterface(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	cache := NewMockCache[string, int](ctrl)
+	cache.EXPECT().Lookup("a").Return(1, true)
+
+	if v, ok := cache.Lookup("a"); v != 1 || !ok {
+		t.Errorf(`Lookup("a") = %d, %t, want 1, true`, v, ok)
+	}
+}
+
+func TestOtherResultCounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	store := NewMockStore(ctrl)
+	closed := false
+	store.EXPECT().Close().Do(func() { closed = true })
+	store.EXPECT().Len().ReturnsInOrder(1, 2)
+	store.EXPECT().Open("f", 1, 2).Return(nil, 3, nil)
+	// Stat has more results than the gomock.TypedCall types support.
+	store.EXPECT().Stat("a").Return(4, 5, true, nil)
+
+	store.Close()
+	if !closed {
+		t.Error("Close() did not run the Do action")
+	}
+	if a, b := store.Len(), store.Len(); a != 1 || b != 2 {
+		t.Errorf("Len(), Len() = %d, %d, want 1, 2", a, b)
+	}
+	if _, n, err := store.Open("f", 1, 2); n != 3 || err != nil {
+		t.Errorf(`Open("f", 1, 2) = _, %d, %v, want _, 3, nil`, n, err)
+	}
+	if size, version, ok, err := store.Stat("a"); size != 4 || version != 5 || !ok || err != nil {
+		t.Errorf(`Stat("a") = %d, %d, %t, %v, want 4, 5, true, nil`, size, version, ok, err)
+	}
+}
@@ -0,0 +1,226 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package typed_generic -source=input.go -destination=mock.go -typed=generic
+//
+
+// Package typed_generic is a generated GoMock package.
+package typed_generic
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Close mocks base method.
+func (m *MockStore) Close() {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockStoreMockRecorder) Close() *gomock.TypedCall0[func()] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStore)(nil).Close))
+	return &gomock.TypedCall0[func()]{Call: call}
+}
+
+// Get mocks base method.
+func (m *MockStore) Get(key string) ([]byte, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStoreMockRecorder) Get(key any) *gomock.TypedCall2[func(string) ([]byte, error), []byte, error] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), key)
+	return &gomock.TypedCall2[func(string) ([]byte, error), []byte, error]{Call: call}
+}
+
+// Len mocks base method.
+func (m *MockStore) Len() int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Len")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Len indicates an expected call of Len.
+func (mr *MockStoreMockRecorder) Len() *gomock.TypedCall1[func() int, int] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Len", reflect.TypeOf((*MockStore)(nil).Len))
+	return &gomock.TypedCall1[func() int, int]{Call: call}
+}
+
+// Open mocks base method.
+func (m *MockStore) Open(name string, flags ...int) (io.ReadCloser, int64, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range flags {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Open", varargs...)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockStoreMockRecorder) Open(name any, flags ...any) *gomock.TypedCall3[func(string, ...int) (io.ReadCloser, int64, error), io.ReadCloser, int64, error] {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, flags...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockStore)(nil).Open), varargs...)
+	return &gomock.TypedCall3[func(string, ...int) (io.ReadCloser, int64, error), io.ReadCloser, int64, error]{Call: call}
+}
+
+// Stat mocks base method.
+func (m *MockStore) Stat(key string) (int64, int, bool, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stat", key)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// Stat indicates an expected call of Stat.
+func (mr *MockStoreMockRecorder) Stat(key any) *MockStoreStatCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stat", reflect.TypeOf((*MockStore)(nil).Stat), key)
+	return &MockStoreStatCall{Call: call}
+}
+
+// MockStoreStatCall wrap *gomock.Call
+type MockStoreStatCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreStatCall) Return(size int64, version int, ok bool, err error) *MockStoreStatCall {
+	c.Call = c.Call.Return(size, version, ok, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreStatCall) Do(f func(string) (int64, int, bool, error)) *MockStoreStatCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreStatCall) DoAndReturn(f func(string) (int64, int, bool, error)) *MockStoreStatCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStoreStatCall) ReturnsInOrder(rets ...[]any) *MockStoreStatCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// MockCache is a mock of Cache interface.
+type MockCache[K comparable, V any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMockRecorder[K, V]
+}
+
+// MockCacheMockRecorder is the mock recorder for MockCache.
+type MockCacheMockRecorder[K comparable, V any] struct {
+	mock *MockCache[K, V]
+}
+
+// NewMockCache creates a new mock instance.
+func NewMockCache[K comparable, V any](ctrl *gomock.Controller) *MockCache[K, V] {
+	mock := &MockCache[K, V]{ctrl: ctrl}
+	mock.recorder = &MockCacheMockRecorder[K, V]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCache[K, V]) EXPECT() *MockCacheMockRecorder[K, V] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockCache[K, V]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Lookup mocks base method.
+func (m *MockCache[K, V]) Lookup(key K) (V, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lookup", key)
+	ret0, _ := ret[0].(V)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Lookup indicates an expected call of Lookup.
+func (mr *MockCacheMockRecorder[K, V]) Lookup(key any) *gomock.TypedCall2[func(K) (V, bool), V, bool] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lookup", reflect.TypeOf((*MockCache[K, V])(nil).Lookup), key)
+	return &gomock.TypedCall2[func(K) (V, bool), V, bool]{Call: call}
+}
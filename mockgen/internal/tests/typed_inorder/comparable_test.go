@@ -0,0 +1,32 @@
+package typed_inorder
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+// isComparable only compiles for comparable type arguments.
+func isComparable[T comparable]() {}
+
+func TestMocksAreComparable(t *testing.T) {
+	isComparable[MockAnimal]()
+	isComparable[MockAnimalMockRecorder]()
+	isComparable[MockAnimalFeedCall]()
+
+	ctrl := gomock.NewController(t)
+	dog, cat := NewMockAnimal(ctrl), NewMockAnimal(ctrl)
+	sounds := map[*MockAnimal]string{dog: "Woof!", cat: "Meow!"}
+	if len(sounds) != 2 {
+		t.Fatalf("got %d distinct mocks, want 2", len(sounds))
+	}
+	if sounds[dog] != "Woof!" || sounds[cat] != "Meow!" {
+		t.Errorf("mocks are not distinct map keys: %v", sounds)
+	}
+
+	calls := map[*MockAnimalGetSoundCall]bool{dog.EXPECT().GetSound().Return("Woof!"): true}
+	if len(calls) != 1 {
+		t.Errorf("got %d calls, want 1", len(calls))
+	}
+	dog.GetSound()
+}
@@ -4,6 +4,7 @@ package typed_inorder
 type Animal interface {
 	GetSound() string
 	Feed(string) error
+	Fetch(string) (string, bool)
 }
 
 func Interact(a Animal, food string) (string, error) {
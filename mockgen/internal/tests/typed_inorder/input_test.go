@@ -25,3 +25,28 @@ func TestInteract(t *testing.T) {
 		t.Fatalf("sad")
 	}
 }
+
+func TestReturnsInOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockAnimal := NewMockAnimal(ctrl)
+	mockAnimal.EXPECT().GetSound().ReturnsInOrder("Woof!", "Grr!").MaxTimes(3)
+	for _, want := range []string{"Woof!", "Grr!", "Grr!"} {
+		if got := mockAnimal.GetSound(); got != want {
+			t.Errorf("GetSound() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestReturnsInOrderResults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockAnimal := NewMockAnimal(ctrl)
+	mockAnimal.EXPECT().Fetch("ball").ReturnsInOrder([]any{"ball", true}, []any{"", false}).Times(2)
+	if got, ok := mockAnimal.Fetch("ball"); got != "ball" || !ok {
+		t.Errorf("Fetch() = %q, %t, want %q, true", got, ok, "ball")
+	}
+	if got, ok := mockAnimal.Fetch("ball"); got != "" || ok {
+		t.Errorf("Fetch() = %q, %t, want %q, false", got, ok, "")
+	}
+}
@@ -35,11 +35,22 @@ func NewMockAnimal(ctrl *gomock.Controller) *MockAnimal {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockAnimal) EXPECT() *MockAnimalMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockAnimal; create it with NewMockAnimal")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockAnimal) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Feed mocks base method.
 func (m *MockAnimal) Feed(arg0 string) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockAnimal; create it with NewMockAnimal")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Feed", arg0)
 	ret0, _ := ret[0].(error)
@@ -76,8 +87,69 @@ func (c *MockAnimalFeedCall) DoAndReturn(f func(string) error) *MockAnimalFeedCa
 	return c
 }
 
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockAnimalFeedCall) ReturnsInOrder(rets ...error) *MockAnimalFeedCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Fetch mocks base method.
+func (m *MockAnimal) Fetch(arg0 string) (string, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockAnimal; create it with NewMockAnimal")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockAnimalMockRecorder) Fetch(arg0 any) *MockAnimalFetchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockAnimal)(nil).Fetch), arg0)
+	return &MockAnimalFetchCall{Call: call}
+}
+
+// MockAnimalFetchCall wrap *gomock.Call
+type MockAnimalFetchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAnimalFetchCall) Return(arg0 string, arg1 bool) *MockAnimalFetchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAnimalFetchCall) Do(f func(string) (string, bool)) *MockAnimalFetchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAnimalFetchCall) DoAndReturn(f func(string) (string, bool)) *MockAnimalFetchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockAnimalFetchCall) ReturnsInOrder(rets ...[]any) *MockAnimalFetchCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
 // GetSound mocks base method.
 func (m *MockAnimal) GetSound() string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockAnimal; create it with NewMockAnimal")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSound")
 	ret0, _ := ret[0].(string)
@@ -113,3 +185,13 @@ func (c *MockAnimalGetSoundCall) DoAndReturn(f func() string) *MockAnimalGetSoun
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockAnimalGetSoundCall) ReturnsInOrder(rets ...string) *MockAnimalGetSoundCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
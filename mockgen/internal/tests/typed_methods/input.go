@@ -0,0 +1,17 @@
+package typed_methods
+
+//go:generate mockgen -package typed_methods -destination mock.go -source input.go -typed_methods Store.Get,Store.Put,Cache.Load -builder
+
+// Store has typed Get and Put methods and untyped Delete and Keys methods.
+type Store interface {
+	Get(key string) (string, error)
+	Put(key, value string) error
+	Delete(key string) error
+	Keys(prefix string, limit ...int) []string
+}
+
+// Cache has a typed Load method and an untyped Store method.
+type Cache[V any] interface {
+	Load(key string) (V, bool)
+	Store(key string, value V)
+}
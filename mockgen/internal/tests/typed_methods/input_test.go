@@ -0,0 +1,47 @@
+package typed_methods
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestTypedMethods(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := NewMockStore(ctrl)
+
+	var _ *MockStoreGetCall = store.EXPECT().Get("a").Return("1", nil)
+	var _ *MockStorePutCall = store.EXPECT().Put("a", gomock.Any()).DoAndReturn(func(key, value string) error {
+		return errors.New(key + value)
+	})
+	var _ *gomock.Call = store.EXPECT().Delete("a").Return(nil)
+	var _ *gomock.Call = store.EXPECT().Keys("a", 1).Return([]string{"a"})
+
+	if v, err := store.Get("a"); v != "1" || err != nil {
+		t.Errorf("Get = %q, %v, want 1, nil", v, err)
+	}
+	if err := store.Put("a", "2"); err == nil || err.Error() != "a2" {
+		t.Errorf("Put = %v, want a2", err)
+	}
+	if err := store.Delete("a"); err != nil {
+		t.Errorf("Delete = %v, want nil", err)
+	}
+	if keys := store.Keys("a", 1); len(keys) != 1 {
+		t.Errorf("Keys = %v, want [a]", keys)
+	}
+}
+
+func TestTypedMethodsGeneric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	b := NewMockCacheBuilder[int](ctrl)
+
+	var _ *MockCacheLoadCall[int] = b.ExpectLoad("a").Return(1, true)
+	var _ *gomock.Call = b.ExpectStore("a", 2)
+	cache := b.Build()
+
+	if v, ok := cache.Load("a"); v != 1 || !ok {
+		t.Errorf("Load = %v, %v, want 1, true", v, ok)
+	}
+	cache.Store("a", 2)
+}
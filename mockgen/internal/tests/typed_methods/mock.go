@@ -0,0 +1,345 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package typed_methods -destination mock.go -source input.go -typed_methods Store.Get,Store.Put,Cache.Load -builder
+//
+
+// Package typed_methods is a generated GoMock package.
+package typed_methods
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockStore) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Delete mocks base method.
+func (m *MockStore) Delete(key string) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStoreMockRecorder) Delete(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStore)(nil).Delete), key)
+}
+
+// Get mocks base method.
+func (m *MockStore) Get(key string) (string, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStoreMockRecorder) Get(key any) *MockStoreGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), key)
+	return &MockStoreGetCall{Call: call}
+}
+
+// MockStoreGetCall wrap *gomock.Call
+type MockStoreGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreGetCall) Return(arg0 string, arg1 error) *MockStoreGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreGetCall) Do(f func(string) (string, error)) *MockStoreGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreGetCall) DoAndReturn(f func(string) (string, error)) *MockStoreGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStoreGetCall) ReturnsInOrder(rets ...[]any) *MockStoreGetCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// Keys mocks base method.
+func (m *MockStore) Keys(prefix string, limit ...int) []string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	varargs := []any{prefix}
+	for _, a := range limit {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Keys", varargs...)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Keys indicates an expected call of Keys.
+func (mr *MockStoreMockRecorder) Keys(prefix any, limit ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{prefix}, limit...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockStore)(nil).Keys), varargs...)
+}
+
+// Put mocks base method.
+func (m *MockStore) Put(key, value string) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockStore; create it with NewMockStore")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockStoreMockRecorder) Put(key, value any) *MockStorePutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), key, value)
+	return &MockStorePutCall{Call: call}
+}
+
+// MockStorePutCall wrap *gomock.Call
+type MockStorePutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorePutCall) Return(arg0 error) *MockStorePutCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorePutCall) Do(f func(string, string) error) *MockStorePutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorePutCall) DoAndReturn(f func(string, string) error) *MockStorePutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockStorePutCall) ReturnsInOrder(rets ...error) *MockStorePutCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// MockStoreBuilder registers expected calls of a MockStore before building it.
+type MockStoreBuilder struct {
+	mock *MockStore
+}
+
+// NewMockStoreBuilder creates a builder of a new mock instance.
+func NewMockStoreBuilder(ctrl *gomock.Controller) *MockStoreBuilder {
+	return &MockStoreBuilder{mock: NewMockStore(ctrl)}
+}
+
+// Build returns the mock with the expected calls registered by the builder.
+func (b *MockStoreBuilder) Build() *MockStore {
+	return b.mock
+}
+
+// ExpectDelete indicates an expected call of Delete.
+func (b *MockStoreBuilder) ExpectDelete(key any) *gomock.Call {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Delete(key)
+}
+
+// ExpectGet indicates an expected call of Get.
+func (b *MockStoreBuilder) ExpectGet(key any) *MockStoreGetCall {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Get(key)
+}
+
+// ExpectKeys indicates an expected call of Keys.
+func (b *MockStoreBuilder) ExpectKeys(prefix any, limit ...any) *gomock.Call {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Keys(prefix, limit...)
+}
+
+// ExpectPut indicates an expected call of Put.
+func (b *MockStoreBuilder) ExpectPut(key, value any) *MockStorePutCall {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Put(key, value)
+}
+
+// MockCache is a mock of Cache interface.
+type MockCache[V any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMockRecorder[V]
+}
+
+// MockCacheMockRecorder is the mock recorder for MockCache.
+type MockCacheMockRecorder[V any] struct {
+	mock *MockCache[V]
+}
+
+// NewMockCache creates a new mock instance.
+func NewMockCache[V any](ctrl *gomock.Controller) *MockCache[V] {
+	mock := &MockCache[V]{ctrl: ctrl}
+	mock.recorder = &MockCacheMockRecorder[V]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCache[V]) EXPECT() *MockCacheMockRecorder[V] {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockCache[V]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Load mocks base method.
+func (m *MockCache[V]) Load(key string) (V, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", key)
+	ret0, _ := ret[0].(V)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockCacheMockRecorder[V]) Load(key any) *MockCacheLoadCall[V] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockCache[V])(nil).Load), key)
+	return &MockCacheLoadCall[V]{Call: call}
+}
+
+// MockCacheLoadCall wrap *gomock.Call
+type MockCacheLoadCall[V any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCacheLoadCall[V]) Return(arg0 V, arg1 bool) *MockCacheLoadCall[V] {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCacheLoadCall[V]) Do(f func(string) (V, bool)) *MockCacheLoadCall[V] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCacheLoadCall[V]) DoAndReturn(f func(string) (V, bool)) *MockCacheLoadCall[V] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockCacheLoadCall[V]) ReturnsInOrder(rets ...[]any) *MockCacheLoadCall[V] {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
+
+// Store mocks base method.
+func (m *MockCache[V]) Store(key string, value V) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockCache; create it with NewMockCache")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Store", key, value)
+}
+
+// Store indicates an expected call of Store.
+func (mr *MockCacheMockRecorder[V]) Store(key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockCache[V])(nil).Store), key, value)
+}
+
+// MockCacheBuilder registers expected calls of a MockCache before building it.
+type MockCacheBuilder[V any] struct {
+	mock *MockCache[V]
+}
+
+// NewMockCacheBuilder creates a builder of a new mock instance.
+func NewMockCacheBuilder[V any](ctrl *gomock.Controller) *MockCacheBuilder[V] {
+	return &MockCacheBuilder[V]{mock: NewMockCache[V](ctrl)}
+}
+
+// Build returns the mock with the expected calls registered by the builder.
+func (b *MockCacheBuilder[V]) Build() *MockCache[V] {
+	return b.mock
+}
+
+// ExpectLoad indicates an expected call of Load.
+func (b *MockCacheBuilder[V]) ExpectLoad(key any) *MockCacheLoadCall[V] {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Load(key)
+}
+
+// ExpectStore indicates an expected call of Store.
+func (b *MockCacheBuilder[V]) ExpectStore(key, value any) *gomock.Call {
+	b.mock.ctrl.T.Helper()
+	return b.mock.recorder.Store(key, value)
+}
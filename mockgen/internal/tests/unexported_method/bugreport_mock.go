@@ -35,11 +35,22 @@ func NewMockExample(ctrl *gomock.Controller) *MockExample {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockExample) EXPECT() *MockExampleMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExample; create it with NewMockExample")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockExample) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // someMethod mocks base method.
 func (m *MockExample) someMethod(arg0 string) string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockExample; create it with NewMockExample")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "someMethod", arg0)
 	ret0, _ := ret[0].(string)
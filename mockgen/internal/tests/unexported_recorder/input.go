@@ -0,0 +1,14 @@
+package unexported_recorder
+
+//go:generate mockgen -package unexported_recorder -destination mock_test.go -source input.go -mock_prefix mock -unexported_recorder -unexported_expect -typed
+
+type Counter interface {
+	Add(n int) int
+	Reset()
+}
+
+// Report uses the counter of the package.
+func Report(c Counter) int {
+	defer c.Reset()
+	return c.Add(0)
+}
@@ -0,0 +1,21 @@
+package unexported_recorder
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnexportedRecorder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	c := NewmockCounter(ctrl)
+	var recorder *mockCounterRecorder = c.expect()
+	var call *mockCounterAddCall = recorder.Add(0)
+	call.Return(3)
+	c.expect().Reset()
+
+	if got := Report(c); got != 3 {
+		t.Errorf("Report() = %d, want 3", got)
+	}
+}
@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package unexported_recorder -destination mock_test.go -source input.go -mock_prefix mock -unexported_recorder -unexported_expect -typed
+//
+
+// Package unexported_recorder is a generated GoMock package.
+package unexported_recorder
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// mockCounter is a mock of Counter interface.
+type mockCounter struct {
+	ctrl     *gomock.Controller
+	recorder *mockCounterRecorder
+}
+
+// mockCounterRecorder is the mock recorder for mockCounter.
+type mockCounterRecorder struct {
+	mock *mockCounter
+}
+
+// NewmockCounter creates a new mock instance.
+func NewmockCounter(ctrl *gomock.Controller) *mockCounter {
+	mock := &mockCounter{ctrl: ctrl}
+	mock.recorder = &mockCounterRecorder{mock}
+	return mock
+}
+
+// expect returns an object that allows the caller to indicate expected use.
+func (m *mockCounter) expect() *mockCounterRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *mockCounter; create it with NewmockCounter")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *mockCounter) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Add mocks base method.
+func (m *mockCounter) Add(n int) int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *mockCounter; create it with NewmockCounter")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", n)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *mockCounterRecorder) Add(n any) *mockCounterAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*mockCounter)(nil).Add), n)
+	return &mockCounterAddCall{Call: call}
+}
+
+// mockCounterAddCall wrap *gomock.Call
+type mockCounterAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *mockCounterAddCall) Return(arg0 int) *mockCounterAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *mockCounterAddCall) Do(f func(int) int) *mockCounterAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *mockCounterAddCall) DoAndReturn(f func(int) int) *mockCounterAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *mockCounterAddCall) ReturnsInOrder(rets ...int) *mockCounterAddCall {
+	values := make([][]any, len(rets))
+	for i, ret := range rets {
+		values[i] = []any{ret}
+	}
+	c.Call = c.Call.ReturnsInOrder(values...)
+	return c
+}
+
+// Reset mocks base method.
+func (m *mockCounter) Reset() {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *mockCounter; create it with NewmockCounter")
+	}
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Reset")
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *mockCounterRecorder) Reset() *mockCounterResetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*mockCounter)(nil).Reset))
+	return &mockCounterResetCall{Call: call}
+}
+
+// mockCounterResetCall wrap *gomock.Call
+type mockCounterResetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *mockCounterResetCall) Return() *mockCounterResetCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *mockCounterResetCall) Do(f func()) *mockCounterResetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *mockCounterResetCall) DoAndReturn(f func()) *mockCounterResetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *mockCounterResetCall) ReturnsInOrder(rets ...[]any) *mockCounterResetCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
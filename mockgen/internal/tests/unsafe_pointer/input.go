@@ -0,0 +1,15 @@
+// Package unsafe_pointer has interfaces with unsafe.Pointer and uintptr in
+// their signatures.
+package unsafe_pointer
+
+//go:generate mockgen -package unsafe_pointer -destination source_mock.go -source input.go -mock_names Memory=MockSourceMemory
+//go:generate mockgen -package unsafe_pointer -destination reflect_mock.go . Memory
+//go:generate mockgen -destination mock_unsafe_pointer/mock.go . Memory
+
+import "unsafe"
+
+type Memory interface {
+	Do(p unsafe.Pointer, n uintptr) error
+	Alloc(n uintptr) (unsafe.Pointer, uintptr)
+	Pointers(ps []unsafe.Pointer, m map[uintptr]*unsafe.Pointer) func(unsafe.Pointer) uintptr
+}
@@ -0,0 +1,35 @@
+package unsafe_pointer
+
+import (
+	"testing"
+	"unsafe"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnsafePointer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	x := 42
+	p := unsafe.Pointer(&x)
+
+	m := NewMockMemory(ctrl)
+	m.EXPECT().Do(p, unsafe.Sizeof(x)).Return(nil)
+	m.EXPECT().Alloc(uintptr(8)).Return(p, uintptr(8))
+	m.EXPECT().Pointers(gomock.Len(1), gomock.Nil()).Return(func(q unsafe.Pointer) uintptr { return uintptr(q) })
+
+	if err := m.Do(p, unsafe.Sizeof(x)); err != nil {
+		t.Errorf("Do = %v, want nil", err)
+	}
+	if got, n := m.Alloc(8); got != p || n != 8 {
+		t.Errorf("Alloc = %v, %v, want %v, 8", got, n, p)
+	}
+	if f := m.Pointers([]unsafe.Pointer{p}, nil); f(p) != uintptr(p) {
+		t.Error("Pointers returned the wrong function")
+	}
+
+	source := NewMockSourceMemory(ctrl)
+	source.EXPECT().Do(gomock.Nil(), uintptr(0)).Return(nil)
+	if err := source.Do(nil, 0); err != nil {
+		t.Errorf("Do = %v, want nil", err)
+	}
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/unsafe_pointer (interfaces: Memory)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_unsafe_pointer/mock.go . Memory
+//
+
+// Package mock_unsafe_pointer is a generated GoMock package.
+package mock_unsafe_pointer
+
+import (
+	reflect "reflect"
+	unsafe "unsafe"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMemory is a mock of Memory interface.
+type MockMemory struct {
+	ctrl     *gomock.Controller
+	recorder *MockMemoryMockRecorder
+}
+
+// MockMemoryMockRecorder is the mock recorder for MockMemory.
+type MockMemoryMockRecorder struct {
+	mock *MockMemory
+}
+
+// NewMockMemory creates a new mock instance.
+func NewMockMemory(ctrl *gomock.Controller) *MockMemory {
+	mock := &MockMemory{ctrl: ctrl}
+	mock.recorder = &MockMemoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMemory) EXPECT() *MockMemoryMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockMemory; create it with NewMockMemory")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockMemory) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Alloc mocks base method.
+func (m *MockMemory) Alloc(arg0 uintptr) (unsafe.Pointer, uintptr) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockMemory; create it with NewMockMemory")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Alloc", arg0)
+	ret0, _ := ret[0].(unsafe.Pointer)
+	ret1, _ := ret[1].(uintptr)
+	return ret0, ret1
+}
+
+// Alloc indicates an expected call of Alloc.
+func (mr *MockMemoryMockRecorder) Alloc(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Alloc", reflect.TypeOf((*MockMemory)(nil).Alloc), arg0)
+}
+
+// Do mocks base method.
+func (m *MockMemory) Do(arg0 unsafe.Pointer, arg1 uintptr) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockMemory; create it with NewMockMemory")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMemoryMockRecorder) Do(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMemory)(nil).Do), arg0, arg1)
+}
+
+// Pointers mocks base method.
+func (m *MockMemory) Pointers(arg0 []unsafe.Pointer, arg1 map[uintptr]*unsafe.Pointer) func(unsafe.Pointer) uintptr {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockMemory; create it with NewMockMemory")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pointers", arg0, arg1)
+	ret0, _ := ret[0].(func(unsafe.Pointer) uintptr)
+	return ret0
+}
+
+// Pointers indicates an expected call of Pointers.
+func (mr *MockMemoryMockRecorder) Pointers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pointers", reflect.TypeOf((*MockMemory)(nil).Pointers), arg0, arg1)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package unsafe_pointer -destination source_mock.go -source input.go -mock_names Memory=MockSourceMemory
+//
+
+// Package unsafe_pointer is a generated GoMock package.
+package unsafe_pointer
+
+import (
+	reflect "reflect"
+	unsafe "unsafe"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSourceMemory is a mock of Memory interface.
+type MockSourceMemory struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceMemoryMockRecorder
+}
+
+// MockSourceMemoryMockRecorder is the mock recorder for MockSourceMemory.
+type MockSourceMemoryMockRecorder struct {
+	mock *MockSourceMemory
+}
+
+// NewMockSourceMemory creates a new mock instance.
+func NewMockSourceMemory(ctrl *gomock.Controller) *MockSourceMemory {
+	mock := &MockSourceMemory{ctrl: ctrl}
+	mock.recorder = &MockSourceMemoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceMemory) EXPECT() *MockSourceMemoryMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceMemory; create it with NewMockSourceMemory")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockSourceMemory) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Alloc mocks base method.
+func (m *MockSourceMemory) Alloc(n uintptr) (unsafe.Pointer, uintptr) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceMemory; create it with NewMockSourceMemory")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Alloc", n)
+	ret0, _ := ret[0].(unsafe.Pointer)
+	ret1, _ := ret[1].(uintptr)
+	return ret0, ret1
+}
+
+// Alloc indicates an expected call of Alloc.
+func (mr *MockSourceMemoryMockRecorder) Alloc(n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Alloc", reflect.TypeOf((*MockSourceMemory)(nil).Alloc), n)
+}
+
+// Do mocks base method.
+func (m *MockSourceMemory) Do(p unsafe.Pointer, n uintptr) error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceMemory; create it with NewMockSourceMemory")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", p, n)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSourceMemoryMockRecorder) Do(p, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSourceMemory)(nil).Do), p, n)
+}
+
+// Pointers mocks base method.
+func (m_2 *MockSourceMemory) Pointers(ps []unsafe.Pointer, m map[uintptr]*unsafe.Pointer) func(unsafe.Pointer) uintptr {
+	if m_2 == nil || m_2.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockSourceMemory; create it with NewMockSourceMemory")
+	}
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Pointers", ps, m)
+	ret0, _ := ret[0].(func(unsafe.Pointer) uintptr)
+	return ret0
+}
+
+// Pointers indicates an expected call of Pointers.
+func (mr *MockSourceMemoryMockRecorder) Pointers(ps, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pointers", reflect.TypeOf((*MockSourceMemory)(nil).Pointers), ps, m)
+}
@@ -36,11 +36,22 @@ func NewMockVendorsDep(ctrl *gomock.Controller) *MockVendorsDep {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockVendorsDep) EXPECT() *MockVendorsDepMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockVendorsDep; create it with NewMockVendorsDep")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockVendorsDep) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Foo mocks base method.
 func (m *MockVendorsDep) Foo() present.Elem {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockVendorsDep; create it with NewMockVendorsDep")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Foo")
 	ret0, _ := ret[0].(present.Elem)
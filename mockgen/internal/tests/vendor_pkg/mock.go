@@ -35,11 +35,22 @@ func NewMockElem(ctrl *gomock.Controller) *MockElem {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockElem) EXPECT() *MockElemMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockElem; create it with NewMockElem")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockElem) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // TemplateName mocks base method.
 func (m *MockElem) TemplateName() string {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockElem; create it with NewMockElem")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TemplateName")
 	ret0, _ := ret[0].(string)
@@ -0,0 +1,12 @@
+// Package wide_results has an interface whose methods return many results.
+package wide_results
+
+//go:generate mockgen -package wide_results -destination mock.go -source input.go
+//go:generate mockgen -package wide_results -destination typed_mock.go -source input.go -typed -mock_names Wide=MockTypedWide
+//go:generate mockgen -package wide_results -destination generic_mock.go -source input.go -typed=generic -mock_names Wide=MockGenericWide
+//go:generate mockgen -package wide_results -destination reflect_mock.go -mock_names Wide=MockReflectWide . Wide
+
+type Wide interface {
+	Five() (a int, b string, c error, d []byte, e bool)
+	Seven(key string) (int8, int16, int32, int64, uint, float64, error)
+}
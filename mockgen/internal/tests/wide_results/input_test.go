@@ -0,0 +1,78 @@
+package wide_results
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+// fatalReporter records the first fatal error instead of failing the test.
+type fatalReporter struct {
+	fatal string
+}
+
+func (r *fatalReporter) Errorf(format string, args ...any) {}
+
+func (r *fatalReporter) Fatalf(format string, args ...any) {
+	if r.fatal == "" {
+		r.fatal = fmt.Sprintf(format, args...)
+	}
+}
+
+func TestWideResultsInOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errFive := errors.New("five")
+
+	m := NewMockWide(ctrl)
+	m.EXPECT().Five().Return(1, "b", errFive, []byte("d"), true)
+	typed := NewMockTypedWide(ctrl)
+	typed.EXPECT().Five().Return(1, "b", errFive, []byte("d"), true)
+	generic := NewMockGenericWide(ctrl)
+	generic.EXPECT().Five().Return(1, "b", errFive, []byte("d"), true)
+	reflected := NewMockReflectWide(ctrl)
+	reflected.EXPECT().Five().Return(1, "b", errFive, []byte("d"), true)
+
+	for _, w := range []Wide{m, typed, generic, reflected} {
+		a, b, c, d, e := w.Five()
+		if a != 1 || b != "b" || c != errFive || !bytes.Equal(d, []byte("d")) || !e {
+			t.Errorf("%T.Five() = %v, %q, %v, %q, %v, want 1, \"b\", five, \"d\", true", w, a, b, c, d, e)
+		}
+	}
+
+	m.EXPECT().Seven("k").Return(int8(1), int16(2), int32(3), int64(4), uint(5), 6.5, errFive)
+	typed.EXPECT().Seven("k").DoAndReturn(func(string) (int8, int16, int32, int64, uint, float64, error) {
+		return 1, 2, 3, 4, 5, 6.5, errFive
+	})
+	for _, w := range []Wide{m, typed} {
+		r0, r1, r2, r3, r4, r5, r6 := w.Seven("k")
+		if r0 != 1 || r1 != 2 || r2 != 3 || r3 != 4 || r4 != 5 || r5 != 6.5 || r6 != errFive {
+			t.Errorf("%T.Seven(k) = %v, %v, %v, %v, %v, %v, %v, want 1, 2, 3, 4, 5, 6.5, five", w, r0, r1, r2, r3, r4, r5, r6)
+		}
+	}
+}
+
+func TestWideResultsReturnValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		rets []any
+		want string
+	}{
+		{"too few", []any{1, "b", nil, []byte("d")}, "wrong number of arguments to Return for *wide_results.MockWide.Five: got 4, want 5"},
+		{"swapped", []any{1, "b", nil, true, []byte("d")}, "wrong type of argument 3 to Return for *wide_results.MockWide.Five: bool is not assignable to []uint8"},
+		{"not nillable", []any{1, "b", nil, nil, nil}, "argument 4 to Return for *wide_results.MockWide.Five is nil, but bool is not nillable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reporter := &fatalReporter{}
+			m := NewMockWide(gomock.NewController(reporter))
+			m.EXPECT().Five().Return(tt.rets...)
+			if !strings.Contains(reporter.fatal, tt.want) {
+				t.Errorf("Return(%v) reported %q, want %q", tt.rets, reporter.fatal, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package wide_results -destination mock.go -source input.go
+//
+
+// Package wide_results is a generated GoMock package.
+package wide_results
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWide is a mock of Wide interface.
+type MockWide struct {
+	ctrl     *gomock.Controller
+	recorder *MockWideMockRecorder
+}
+
+// MockWideMockRecorder is the mock recorder for MockWide.
+type MockWideMockRecorder struct {
+	mock *MockWide
+}
+
+// NewMockWide creates a new mock instance.
+func NewMockWide(ctrl *gomock.Controller) *MockWide {
+	mock := &MockWide{ctrl: ctrl}
+	mock.recorder = &MockWideMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWide) EXPECT() *MockWideMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockWide; create it with NewMockWide")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockWide) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Five mocks base method.
+func (m *MockWide) Five() (int, string, error, []byte, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockWide; create it with NewMockWide")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Five")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	ret3, _ := ret[3].([]byte)
+	ret4, _ := ret[4].(bool)
+	return ret0, ret1, ret2, ret3, ret4
+}
+
+// Five indicates an expected call of Five.
+func (mr *MockWideMockRecorder) Five() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Five", reflect.TypeOf((*MockWide)(nil).Five))
+}
+
+// Seven mocks base method.
+func (m *MockWide) Seven(key string) (int8, int16, int32, int64, uint, float64, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockWide; create it with NewMockWide")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seven", key)
+	ret0, _ := ret[0].(int8)
+	ret1, _ := ret[1].(int16)
+	ret2, _ := ret[2].(int32)
+	ret3, _ := ret[3].(int64)
+	ret4, _ := ret[4].(uint)
+	ret5, _ := ret[5].(float64)
+	ret6, _ := ret[6].(error)
+	return ret0, ret1, ret2, ret3, ret4, ret5, ret6
+}
+
+// Seven indicates an expected call of Seven.
+func (mr *MockWideMockRecorder) Seven(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seven", reflect.TypeOf((*MockWide)(nil).Seven), key)
+}
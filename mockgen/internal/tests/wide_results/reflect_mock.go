@@ -0,0 +1,91 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: go.uber.org/mock/mockgen/internal/tests/wide_results (interfaces: Wide)
+//
+// Generated by this command:
+//
+//	mockgen -package wide_results -destination reflect_mock.go -mock_names Wide=MockReflectWide . Wide
+//
+
+// Package wide_results is a generated GoMock package.
+package wide_results
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReflectWide is a mock of Wide interface.
+type MockReflectWide struct {
+	ctrl     *gomock.Controller
+	recorder *MockReflectWideMockRecorder
+}
+
+// MockReflectWideMockRecorder is the mock recorder for MockReflectWide.
+type MockReflectWideMockRecorder struct {
+	mock *MockReflectWide
+}
+
+// NewMockReflectWide creates a new mock instance.
+func NewMockReflectWide(ctrl *gomock.Controller) *MockReflectWide {
+	mock := &MockReflectWide{ctrl: ctrl}
+	mock.recorder = &MockReflectWideMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReflectWide) EXPECT() *MockReflectWideMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectWide; create it with NewMockReflectWide")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockReflectWide) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Five mocks base method.
+func (m *MockReflectWide) Five() (int, string, error, []byte, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectWide; create it with NewMockReflectWide")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Five")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	ret3, _ := ret[3].([]byte)
+	ret4, _ := ret[4].(bool)
+	return ret0, ret1, ret2, ret3, ret4
+}
+
+// Five indicates an expected call of Five.
+func (mr *MockReflectWideMockRecorder) Five() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Five", reflect.TypeOf((*MockReflectWide)(nil).Five))
+}
+
+// Seven mocks base method.
+func (m *MockReflectWide) Seven(arg0 string) (int8, int16, int32, int64, uint, float64, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockReflectWide; create it with NewMockReflectWide")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seven", arg0)
+	ret0, _ := ret[0].(int8)
+	ret1, _ := ret[1].(int16)
+	ret2, _ := ret[2].(int32)
+	ret3, _ := ret[3].(int64)
+	ret4, _ := ret[4].(uint)
+	ret5, _ := ret[5].(float64)
+	ret6, _ := ret[6].(error)
+	return ret0, ret1, ret2, ret3, ret4, ret5, ret6
+}
+
+// Seven indicates an expected call of Seven.
+func (mr *MockReflectWideMockRecorder) Seven(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seven", reflect.TypeOf((*MockReflectWide)(nil).Seven), arg0)
+}
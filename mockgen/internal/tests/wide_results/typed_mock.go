@@ -0,0 +1,151 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+//
+// Generated by this command:
+//
+//	mockgen -package wide_results -destination typed_mock.go -source input.go -typed -mock_names Wide=MockTypedWide
+//
+
+// Package wide_results is a generated GoMock package.
+package wide_results
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTypedWide is a mock of Wide interface.
+type MockTypedWide struct {
+	ctrl     *gomock.Controller
+	recorder *MockTypedWideMockRecorder
+}
+
+// MockTypedWideMockRecorder is the mock recorder for MockTypedWide.
+type MockTypedWideMockRecorder struct {
+	mock *MockTypedWide
+}
+
+// NewMockTypedWide creates a new mock instance.
+func NewMockTypedWide(ctrl *gomock.Controller) *MockTypedWide {
+	mock := &MockTypedWide{ctrl: ctrl}
+	mock.recorder = &MockTypedWideMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTypedWide) EXPECT() *MockTypedWideMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedWide; create it with NewMockTypedWide")
+	}
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockTypedWide) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// Five mocks base method.
+func (m *MockTypedWide) Five() (int, string, error, []byte, bool) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedWide; create it with NewMockTypedWide")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Five")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	ret3, _ := ret[3].([]byte)
+	ret4, _ := ret[4].(bool)
+	return ret0, ret1, ret2, ret3, ret4
+}
+
+// Five indicates an expected call of Five.
+func (mr *MockTypedWideMockRecorder) Five() *MockTypedWideFiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Five", reflect.TypeOf((*MockTypedWide)(nil).Five))
+	return &MockTypedWideFiveCall{Call: call}
+}
+
+// MockTypedWideFiveCall wrap *gomock.Call
+type MockTypedWideFiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockTypedWideFiveCall) Return(a int, b string, c error, d []byte, e bool) *MockTypedWideFiveCall {
+	c_2.Call = c_2.Call.Return(a, b, c, d, e)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockTypedWideFiveCall) Do(f func() (int, string, error, []byte, bool)) *MockTypedWideFiveCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockTypedWideFiveCall) DoAndReturn(f func() (int, string, error, []byte, bool)) *MockTypedWideFiveCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c_2 *MockTypedWideFiveCall) ReturnsInOrder(rets ...[]any) *MockTypedWideFiveCall {
+	c_2.Call = c_2.Call.ReturnsInOrder(rets...)
+	return c_2
+}
+
+// Seven mocks base method.
+func (m *MockTypedWide) Seven(key string) (int8, int16, int32, int64, uint, float64, error) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockTypedWide; create it with NewMockTypedWide")
+	}
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seven", key)
+	ret0, _ := ret[0].(int8)
+	ret1, _ := ret[1].(int16)
+	ret2, _ := ret[2].(int32)
+	ret3, _ := ret[3].(int64)
+	ret4, _ := ret[4].(uint)
+	ret5, _ := ret[5].(float64)
+	ret6, _ := ret[6].(error)
+	return ret0, ret1, ret2, ret3, ret4, ret5, ret6
+}
+
+// Seven indicates an expected call of Seven.
+func (mr *MockTypedWideMockRecorder) Seven(key any) *MockTypedWideSevenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seven", reflect.TypeOf((*MockTypedWide)(nil).Seven), key)
+	return &MockTypedWideSevenCall{Call: call}
+}
+
+// MockTypedWideSevenCall wrap *gomock.Call
+type MockTypedWideSevenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTypedWideSevenCall) Return(arg0 int8, arg1 int16, arg2 int32, arg3 int64, arg4 uint, arg5 float64, arg6 error) *MockTypedWideSevenCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTypedWideSevenCall) Do(f func(string) (int8, int16, int32, int64, uint, float64, error)) *MockTypedWideSevenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTypedWideSevenCall) DoAndReturn(f func(string) (int8, int16, int32, int64, uint, float64, error)) *MockTypedWideSevenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder
+func (c *MockTypedWideSevenCall) ReturnsInOrder(rets ...[]any) *MockTypedWideSevenCall {
+	c.Call = c.Call.ReturnsInOrder(rets...)
+	return c
+}
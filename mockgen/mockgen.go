@@ -24,7 +24,12 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/format"
+	"go/parser"
 	"go/token"
+	"go/types"
 	"io"
 	"log"
 	"os"
@@ -35,9 +40,12 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
 	toolsimports "golang.org/x/tools/imports"
 
 	"go.uber.org/mock/mockgen/model"
@@ -55,24 +63,190 @@ var (
 
 var (
 	source                 = flag.String("source", "", "(source mode) Input Go source file; enables source mode.")
+	sourceDir              = flag.String("source_dir", "", "(source mode) Directory of the input package, whose Go files are parsed together; enables source mode. Mutually exclusive with -source.")
+	includeTests           = flag.Bool("include_tests", false, "(source mode) With -source_dir, also parse the _test.go files of the package.")
 	destination            = flag.String("destination", "", "Output file; defaults to stdout.")
+	destinationPattern     = flag.String("destination_pattern", "", "(source mode) Template of the output file, such as '{{.SourceDir}}/{{.SourceBase}}_mock.go', with the variables SourceDir, SourceFile and SourceBase of the -source file. Mutually exclusive with -destination.")
 	mockNames              = flag.String("mock_names", "", "Comma-separated interfaceName=mockName pairs of explicit mock names to use. Mock names default to 'Mock'+ interfaceName suffix.")
+	mockPrefix             = flag.String("mock_prefix", "", "Prefix of generated mock names, replacing the default 'Mock' prefix. Overridden per interface by -mock_names.")
+	mockSuffix             = flag.String("mock_suffix", "", "Suffix of generated mock names. When set without -mock_prefix, mocks are named interfaceName+suffix. Overridden per interface by -mock_names.")
 	packageOut             = flag.String("package", "", "Package of the generated code; defaults to the package of the input with a 'mock_' prefix.")
 	selfPackage            = flag.String("self_package", "", "The full package import path for the generated code. The purpose of this flag is to prevent import cycles in the generated code by trying to include its own package. This can happen if the mock's package is set to one of its inputs (usually the main one) and the output is stdio so mockgen cannot detect the final output package. Setting this flag will then tell mockgen which import to exclude.")
+	outputPackagePathFlag  = flag.String("output_package_path", "", "The import path of the package the generated code belongs to. When set, mockgen leaves out imports of this package and refers to its types unqualified, instead of inferring the package from -destination or -package. Takes precedence over -self_package.")
 	writeCmdComment        = flag.Bool("write_command_comment", true, "Writes the command used as a comment if true.")
 	writePkgComment        = flag.Bool("write_package_comment", true, "Writes package documentation comment (godoc) if true.")
 	writeSourceComment     = flag.Bool("write_source_comment", true, "Writes original file (source mode) or interface names (reflect mode) comment if true.")
 	writeGenerateDirective = flag.Bool("write_generate_directive", false, "Add //go:generate directive to regenerate the mock")
 	copyrightFile          = flag.String("copyright_file", "", "Copyright file used to add copyright header")
-	typed                  = flag.Bool("typed", false, "Generate Type-safe 'Return', 'Do', 'DoAndReturn' function")
+	licenseSPDX            = flag.String("license_spdx", "", "SPDX license identifier, such as Apache-2.0, to add as an SPDX-License-Identifier header before the copyright header")
+	importAliases          = flag.String("import_aliases", "", "Comma-separated alias=path pairs of names to import packages as wherever the generated code uses them, in both source and reflect mode.")
+	localPrefix            = flag.String("local_prefix", "", "Comma-separated import path prefixes whose imports are grouped after the third-party ones, as with goimports -local.")
+	goVersion              = flag.String("go_version", "", "The Go version, such as 1.17, the generated code must compile with. Below 1.18, the empty interface is spelled interface{} and generic interfaces and -typed=generic are rejected.")
+	useAny                 = useAnyFlag("use_any", "Spell the empty interface 'any' with -use_any or -use_any=true and 'interface{}' with -use_any=false throughout the generated code. By default, it is spelled interface{} below -go_version 1.18, and the mocked methods spell it as their source does in source mode.")
+	typed                  = typedFlag("typed", "Generate Type-safe 'Return', 'Do', 'DoAndReturn' function; -typed=generic uses the generic gomock.TypedCall wrappers instead of a call type per method")
+	typedMethods           = flag.String("typed_methods", "", "Comma-separated interfaceName.methodName pairs of methods to generate typed calls for, as -typed does, while the other methods stay untyped. Ignored with -typed.")
+	returnZero             = flag.Bool("return_zero", false, "With -typed or -typed_methods, generate a 'ReturnZero' method on each call type that returns the zero values of the method's results.")
+	nolint                 = nolintFlag("nolint", "Add a file-level //nolint directive for all linters, or with -nolint=linter1,linter2 for the given golangci-lint linters.")
+	docLinks               = flag.Bool("doc_links", false, "Link the doc comment of each generated type to its original interface using a Go doc link.")
+	unexportedRecorder     = flag.Bool("unexported_recorder", false, "Generate unexported recorder types, and call types with -typed, for mocks internal to their package.")
+	unexportedExpect       = flag.Bool("unexported_expect", false, "Name the recorder accessor 'expect' instead of 'EXPECT'.")
+	exposeCtrl             = flag.Bool("expose_ctrl", false, "Generate a 'Ctrl' method returning the gomock.Controller of each mock.")
+	goString               = flag.Bool("gostring", false, "Generate a 'GoString' method on each mock so that %#v prints it concisely, unless its interface has a GoString method.")
+	formatter              = flag.Bool("formatter", false, "Generate a 'Format' method on each mock so that %v prints its name and number of pending expectations, unless its interface has a Format method.")
+	builder                = flag.Bool("builder", false, "Generate a builder for each mock with an 'Expect<Method>' method per method and a 'Build' method returning the mock.")
+	compact                = flag.Bool("compact", false, "Share the type assertions of the results of mock methods with the same result types, and the check for nil mocks, in helper methods, making mocks of large interfaces smaller.")
+	stub                   = flag.Bool("stub", false, "Generate 'Stub'+interfaceName structs with per-method function fields instead of gomock mocks")
 	imports                = flag.String("imports", "", "(source mode) Comma-separated name=path pairs of explicit imports to use.")
 	auxFiles               = flag.String("aux_files", "", "(source mode) Comma-separated pkg=path pairs of auxiliary Go source files.")
 	excludeInterfaces      = flag.String("exclude_interfaces", "", "Comma-separated names of interfaces to be excluded")
+	atLine                 = flag.Int("at_line", 0, "(source mode) Only mock the interface spanning this line of the source file; the mock defaults to an adjacent mock_<interface>.go file.")
+	atOffset               = flag.Int("at_offset", -1, "(source mode) Only mock the interface spanning this byte offset of the source file; the mock defaults to an adjacent mock_<interface>.go file.")
+	ifChanged              = flag.Bool("if_changed", false, "Skip generation if the inputs of the -destination file are unchanged since it was generated with -if_changed.")
+	verifyCompileFlag      = flag.Bool("verify_compile", false, "Build the package of the -destination file with the generated mock before writing it, and fail if it does not compile.")
+
+	configFile = flag.String("config", "", "JSON file describing multiple generation targets to run in one invocation.")
+	failFast   = flag.Bool("fail_fast", false, "(config mode) Stop at the first target that fails to generate.")
 
 	debugParser = flag.Bool("debug_parser", false, "Print out parser results only.")
+	verbose     = flag.Bool("v", false, "Log the generation steps to stderr.")
+	summary     = flag.Bool("summary", false, "Print a line per mocked interface to stderr with its mock, number of methods, whether it is typed, and the destination and size of the output, or a line per mock that -if_changed leaves unchanged.")
+	veryVerbose = flag.Bool("vv", false, "Log the generation steps and the resolved types of each method to stderr.")
 	showVersion = flag.Bool("version", false, "Print version.")
 )
 
+// typedMode is the value of -typed.
+type typedMode int
+
+const (
+	untyped typedMode = iota
+	// typedMonomorphic generates a call type per method.
+	typedMonomorphic
+	// typedGeneric uses the gomock.TypedCall types, falling back to a call
+	// type per method for methods with more results than they support.
+	typedGeneric
+)
+
+// typedFlag defines a flag that is set to typedMonomorphic by -name or
+// -name=true and to typedGeneric by -name=generic.
+func typedFlag(name, usage string) *typedMode {
+	m := new(typedMode)
+	flag.Var(m, name, usage)
+	return m
+}
+
+func (m *typedMode) IsBoolFlag() bool { return true }
+
+func (m *typedMode) String() string {
+	switch *m {
+	case typedMonomorphic:
+		return "true"
+	case typedGeneric:
+		return "generic"
+	default:
+		return "false"
+	}
+}
+
+func (m *typedMode) Set(s string) error {
+	if s == "generic" {
+		*m = typedGeneric
+		return nil
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return errors.New(`must be a boolean or "generic"`)
+	}
+	*m = untyped
+	if b {
+		*m = typedMonomorphic
+	}
+	return nil
+}
+
+// useAnyMode is the value of -use_any.
+type useAnyMode int
+
+const (
+	// useAnyAuto spells the empty interface interface{} below -go_version
+	// 1.18, and otherwise as the input does.
+	useAnyAuto useAnyMode = iota
+	useAnyAlways
+	useAnyNever
+)
+
+// useAnyFlag defines a flag that is set to useAnyAlways by -name or
+// -name=true, to useAnyNever by -name=false and to useAnyAuto by -name=auto.
+func useAnyFlag(name, usage string) *useAnyMode {
+	m := new(useAnyMode)
+	flag.Var(m, name, usage)
+	return m
+}
+
+func (m *useAnyMode) IsBoolFlag() bool { return true }
+
+func (m *useAnyMode) String() string {
+	switch *m {
+	case useAnyAlways:
+		return "true"
+	case useAnyNever:
+		return "false"
+	default:
+		return "auto"
+	}
+}
+
+func (m *useAnyMode) Set(s string) error {
+	if s == "auto" {
+		*m = useAnyAuto
+		return nil
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return errors.New(`must be a boolean or "auto"`)
+	}
+	*m = useAnyNever
+	if b {
+		*m = useAnyAlways
+	}
+	return nil
+}
+
+// nolintLinters is the value of -nolint: "all", a comma-separated list of
+// linters, or "" if unset.
+type nolintLinters string
+
+// nolintFlag defines a flag that is set to "all" by -name or -name=true and
+// to the given linters by -name=linter1,linter2.
+func nolintFlag(name, usage string) *nolintLinters {
+	l := new(nolintLinters)
+	flag.Var(l, name, usage)
+	return l
+}
+
+func (l *nolintLinters) IsBoolFlag() bool { return true }
+
+func (l *nolintLinters) String() string { return string(*l) }
+
+func (l *nolintLinters) Set(s string) error {
+	switch s {
+	case "true":
+		*l = "all"
+		return nil
+	case "false", "":
+		*l = ""
+		return nil
+	}
+	for _, linter := range strings.Split(s, ",") {
+		if linter == "" || strings.TrimFunc(linter, func(r rune) bool {
+			return r == '-' || r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+		}) != "" {
+			return fmt.Errorf("bad linter name %q", linter)
+		}
+	}
+	*l = nolintLinters(s)
+	return nil
+}
+
 func main() {
 	flag.Usage = usage
 	flag.Parse()
@@ -82,40 +256,115 @@ func main() {
 		return
 	}
 
+	if *configFile != "" {
+		if err := runConfig(*configFile); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if err := generateMock(flag.Args()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generateMock generates the mock described by the current flags and the
+// positional reflect mode arguments in args.
+func generateMock(args []string) error {
+	if *outputPackagePathFlag != "" && *selfPackage != "" && *outputPackagePathFlag != *selfPackage {
+		return fmt.Errorf("-output_package_path=%s and -self_package=%s disagree", *outputPackagePathFlag, *selfPackage)
+	}
+	destinationPath, err := resolveDestination()
+	if err != nil {
+		return err
+	}
+	if *verifyCompileFlag && destinationPath == "" {
+		return errors.New("-verify_compile requires -destination")
+	}
+	var fingerprint string
+	if *ifChanged {
+		if destinationPath == "" {
+			return errors.New("-if_changed requires -destination")
+		}
+		var err error
+		fingerprint, err = inputFingerprint(args)
+		if err != nil {
+			return fmt.Errorf("Failed fingerprinting inputs: %v", err)
+		}
+		existing, err := readFingerprint(destinationPath)
+		if err != nil {
+			return fmt.Errorf("Failed reading pre-existing destination file: %v", err)
+		}
+		if existing == fingerprint {
+			if *summary {
+				printUnchangedSummary(destinationPath)
+			}
+			return nil
+		}
+	}
+
 	var pkg *model.Package
-	var err error
 	var packageName string
+	start := time.Now()
+	if *source == "" && (*atLine > 0 || *atOffset >= 0) {
+		return errors.New("-at_line and -at_offset require -source")
+	}
+	if *source != "" && *sourceDir != "" {
+		return errors.New("-source and -source_dir are mutually exclusive")
+	}
+	if *cgo != "" && *cgo != "0" && *cgo != "1" {
+		return fmt.Errorf("-cgo must be 0 or 1, not %q", *cgo)
+	}
 	if *source != "" {
+		logf(1, "parsing %s", *source)
 		pkg, err = sourceMode(*source)
+	} else if *sourceDir != "" {
+		logf(1, "parsing %s", *sourceDir)
+		pkg, err = sourceDirMode(*sourceDir)
 	} else {
-		if flag.NArg() != 2 {
+		if len(args) != 2 {
 			usage()
-			log.Fatal("Expected exactly two arguments")
+			return errors.New("Expected exactly two arguments")
 		}
-		packageName = flag.Arg(0)
-		interfaces := strings.Split(flag.Arg(1), ",")
+		packageName = args[0]
+		interfaces := strings.Split(args[1], ",")
 		if packageName == "." {
 			dir, err := os.Getwd()
 			if err != nil {
-				log.Fatalf("Get current directory failed: %v", err)
+				return fmt.Errorf("Get current directory failed: %v", err)
 			}
 			packageName, err = packageNameOfDir(dir)
 			if err != nil {
-				log.Fatalf("Parse package name failed: %v", err)
+				return fmt.Errorf("Parse package name failed: %v", err)
 			}
 		}
+		logf(1, "reflecting on %s in %s", strings.Join(interfaces, ", "), packageName)
 		pkg, err = reflectMode(packageName, interfaces)
 	}
 	if err != nil {
-		log.Fatalf("Loading input failed: %v", err)
+		return fmt.Errorf("Loading input failed: %v", err)
+	}
+	logf(1, "loaded package %s in %v", pkg.Name, time.Since(start))
+	for _, intf := range pkg.Interfaces {
+		logf(1, "found interface %s with %d methods", intf.Name, len(intf.Methods))
 	}
 
 	if *debugParser {
 		pkg.Print(os.Stdout)
-		return
+		return nil
 	}
 
+	// A mock of the interface at a position is written next to its source
+	// and into its package by default.
 	outputPackageName := *packageOut
+	if *source != "" && (*atLine > 0 || *atOffset >= 0) {
+		if destinationPath == "" {
+			destinationPath = filepath.Join(filepath.Dir(*source), "mock_"+strings.ToLower(pkg.Interfaces[0].Name)+".go")
+		}
+		if outputPackageName == "" {
+			outputPackageName = pkg.Name
+		}
+	}
 	if outputPackageName == "" {
 		// pkg.Name in reflect mode is the base name of the import path,
 		// which might have characters that are illegal to have in package names.
@@ -128,9 +377,9 @@ func main() {
 	// package (i.e. if there is a type called X then we want to print "X" not
 	// "package.X" since "package" is this package). This can happen if the mock
 	// is output into an already existing package.
-	outputPackagePath := *selfPackage
-	if outputPackagePath == "" && *destination != "" {
-		dstPath, err := filepath.Abs(filepath.Dir(*destination))
+	outputPackagePath := selfPackagePath()
+	if outputPackagePath == "" && destinationPath != "" {
+		dstPath, err := filepath.Abs(filepath.Dir(destinationPath))
 		if err == nil {
 			pkgPath, err := parsePackageImport(dstPath)
 			if err == nil {
@@ -142,53 +391,144 @@ func main() {
 			log.Println("Unable to determine destination file path:", err)
 		}
 	}
+	if outputPackagePath == "" && destinationPath == "" {
+		srcPackagePath := pkg.PkgPath
+		if *source == "" && *sourceDir == "" {
+			srcPackagePath = packageName
+		}
+		outputPackagePath = inferSelfPackage(pkg, srcPackagePath, outputPackageName)
+	}
 
 	g := new(generator)
 	if *source != "" {
 		g.filename = *source
+	} else if *sourceDir != "" {
+		g.filename = *sourceDir
 	} else {
 		g.srcPackage = packageName
-		g.srcInterfaces = flag.Arg(1)
+		g.srcInterfaces = args[1]
 	}
-	g.destination = *destination
+	g.destination = destinationPath
+	g.fingerprint = fingerprint
 
 	if *mockNames != "" {
 		g.mockNames = parseMockNames(*mockNames)
 	}
+	if *returnZero && *typed == untyped && *typedMethods == "" {
+		return errors.New("-return_zero requires -typed or -typed_methods")
+	}
+	if *typedMethods != "" {
+		g.typedMethods, err = parseTypedMethods(*typedMethods, pkg)
+		if err != nil {
+			return err
+		}
+	}
+	g.mockPrefix = *mockPrefix
+	g.mockSuffix = *mockSuffix
 	if *copyrightFile != "" {
 		header, err := os.ReadFile(*copyrightFile)
 		if err != nil {
-			log.Fatalf("Failed reading copyright file: %v", err)
+			return fmt.Errorf("Failed reading copyright file: %v", err)
 		}
 
 		g.copyrightHeader = string(header)
 	}
+	if *licenseSPDX != "" {
+		if strings.TrimSpace(*licenseSPDX) == "" || strings.ContainsAny(*licenseSPDX, "\r\n") {
+			return fmt.Errorf("bad -license_spdx %q: want an SPDX license identifier such as Apache-2.0", *licenseSPDX)
+		}
+		g.licenseSPDX = *licenseSPDX
+	}
+	g.localPrefix = *localPrefix
+	if *goVersion != "" {
+		g.goVersion, err = parseGoVersion(*goVersion)
+		if err != nil {
+			return err
+		}
+	}
+	g.useAny = *useAny
+	if *importAliases != "" {
+		g.importAliases, err = parseImportAliases(*importAliases)
+		if err != nil {
+			return err
+		}
+	}
 	if err := g.Generate(pkg, outputPackageName, outputPackagePath); err != nil {
-		log.Fatalf("Failed generating mock: %v", err)
+		return fmt.Errorf("Failed generating mock: %v", err)
 	}
 	output := g.Output()
 	dst := os.Stdout
-	if len(*destination) > 0 {
-		if err := os.MkdirAll(filepath.Dir(*destination), os.ModePerm); err != nil {
-			log.Fatalf("Unable to create directory: %v", err)
+	if len(destinationPath) > 0 {
+		if err := os.MkdirAll(filepath.Dir(destinationPath), os.ModePerm); err != nil {
+			return fmt.Errorf("Unable to create directory: %v", err)
 		}
-		existing, err := os.ReadFile(*destination)
+		if *verifyCompileFlag {
+			logf(1, "verifying that %s compiles", destinationPath)
+			if err := verifyCompile(destinationPath, output); err != nil {
+				return err
+			}
+		}
+		existing, err := os.ReadFile(destinationPath)
 		if err != nil && !errors.Is(err, os.ErrNotExist) {
-			log.Fatalf("Failed reading pre-exiting destination file: %v", err)
+			return fmt.Errorf("Failed reading pre-exiting destination file: %v", err)
 		}
 		if len(existing) == len(output) && bytes.Equal(existing, output) {
-			return
+			if *summary {
+				g.printSummary(pkg, destinationPath, len(output))
+			}
+			return nil
 		}
-		f, err := os.Create(*destination)
+		f, err := os.Create(destinationPath)
 		if err != nil {
-			log.Fatalf("Failed opening destination file: %v", err)
+			return fmt.Errorf("Failed opening destination file: %v", err)
 		}
 		defer f.Close()
 		dst = f
 	}
 	if _, err := dst.Write(output); err != nil {
-		log.Fatalf("Failed writing to destination: %v", err)
+		return fmt.Errorf("Failed writing to destination: %v", err)
 	}
+	if *summary {
+		g.printSummary(pkg, destinationPath, len(output))
+	}
+	return nil
+}
+
+// selfPackagePath returns the import path of the generated code given by
+// -output_package_path or -self_package, falling back to the
+// MOCKGEN_SELF_PACKAGE environment variable.
+func selfPackagePath() string {
+	if *outputPackagePathFlag != "" {
+		return *outputPackagePathFlag
+	}
+	if *selfPackage != "" {
+		return *selfPackage
+	}
+	return os.Getenv("MOCKGEN_SELF_PACKAGE")
+}
+
+// inferSelfPackage guesses the import path of the generated code when it is
+// written to stdout and -self_package is not set. A mock whose package has
+// the name of the input package is assumed to belong to that package, at
+// srcPackagePath. Otherwise, if it has the name of one of the input's
+// imports, a warning suggests -self_package as the mock would likely import
+// its own package.
+func inferSelfPackage(pkg *model.Package, srcPackagePath, outputPackageName string) string {
+	if outputPackageName == pkg.Name && srcPackagePath != "" {
+		return srcPackagePath
+	}
+	imports := make([]string, 0, len(pkg.Imports()))
+	for pth := range pkg.Imports() {
+		imports = append(imports, pth)
+	}
+	sort.Strings(imports)
+	for _, pth := range imports {
+		if sanitize(path.Base(pth)) == outputPackageName {
+			log.Printf("Warning: the mock's package %s has the name of the imported package %s; "+
+				"if that is the package it is written to, set -self_package=%s to avoid an import cycle", outputPackageName, pth, pth)
+		}
+	}
+	return ""
 }
 
 func parseMockNames(names string) map[string]string {
@@ -203,6 +543,87 @@ func parseMockNames(names string) map[string]string {
 	return mocksMap
 }
 
+// parseTypedMethods parses the -typed_methods list of interfaceName.methodName
+// pairs into a set, checking that each names a method of an interface of pkg.
+func parseTypedMethods(names string, pkg *model.Package) (map[string]bool, error) {
+	methods := make(map[string]bool)
+	for _, name := range strings.Split(names, ",") {
+		intfName, methodName, ok := strings.Cut(name, ".")
+		if !ok || intfName == "" || methodName == "" {
+			return nil, fmt.Errorf("bad typed methods spec: %v", name)
+		}
+		if !hasMethod(pkg, intfName, methodName) {
+			return nil, fmt.Errorf("-typed_methods: no method %v of a mocked interface", name)
+		}
+		methods[name] = true
+	}
+	return methods, nil
+}
+
+// parseImportAliases parses the -import_aliases list of alias=path pairs into
+// a map from import path to alias.
+func parseImportAliases(spec string) (map[string]string, error) {
+	aliases := make(map[string]string)
+	paths := make(map[string]string)
+	for _, kv := range strings.Split(spec, ",") {
+		alias, pth, ok := strings.Cut(kv, "=")
+		if !ok || pth == "" || !token.IsIdentifier(alias) || alias == "_" || alias == "any" {
+			return nil, fmt.Errorf("bad import aliases spec: %v", kv)
+		}
+		if prev, ok := paths[alias]; ok && prev != pth {
+			return nil, fmt.Errorf("bad import aliases spec: alias %s is given to both %s and %s", alias, prev, pth)
+		}
+		if prev, ok := aliases[pth]; ok && prev != alias {
+			return nil, fmt.Errorf("bad import aliases spec: %s is given both alias %s and %s", pth, prev, alias)
+		}
+		aliases[pth], paths[alias] = alias, pth
+	}
+	return aliases, nil
+}
+
+// parseGoVersion parses a -go_version such as 1.17, 1.21.3 or go1.17 into a
+// semantic version.
+func parseGoVersion(s string) (string, error) {
+	v := "v" + strings.TrimPrefix(s, "go")
+	if !semver.IsValid(v) || semver.Prerelease(v) != "" || semver.Build(v) != "" || semver.Major(v) != "v1" {
+		return "", fmt.Errorf("bad -go_version %q: want a Go version such as 1.17", s)
+	}
+	return v, nil
+}
+
+// hasGenerics reports whether the Go version of the generated code has
+// generics and the predeclared any, as it does if -go_version is unset.
+func (g *generator) hasGenerics() bool {
+	return g.goVersion == "" || semver.Compare(g.goVersion, "v1.18") >= 0
+}
+
+// emptyInterface is the spelling of the empty interface throughout the
+// generated code, "any" or "interface{}", or "" to leave it as the model and
+// the generator spell it.
+func (g *generator) emptyInterface() string {
+	switch {
+	case g.useAny == useAnyNever || !g.hasGenerics():
+		return "interface{}"
+	case g.useAny == useAnyAlways:
+		return "any"
+	}
+	return ""
+}
+
+func hasMethod(pkg *model.Package, intfName, methodName string) bool {
+	for _, intf := range pkg.Interfaces {
+		if intf.Name != intfName {
+			continue
+		}
+		for _, m := range intf.Methods {
+			if m.Name == methodName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func parseExcludeInterfaces(names string) map[string]struct{} {
 	splitNames := strings.Split(names, ",")
 	namesSet := make(map[string]struct{}, len(splitNames))
@@ -247,12 +668,22 @@ type generator struct {
 	buf                       bytes.Buffer
 	indent                    string
 	mockNames                 map[string]string // may be empty
+	typedMethods              map[string]bool   // may be empty
+	importAliases             map[string]string // import path to alias; may be empty
+	mockPrefix, mockSuffix    string            // may be empty
 	filename                  string            // may be empty
 	destination               string            // may be empty
 	srcPackage, srcInterfaces string            // may be empty
+	srcPackagePath            string            // may be empty
+	fingerprint               string            // may be empty
 	copyrightHeader           string
+	licenseSPDX               string // may be empty
+	localPrefix               string // may be empty
+	goVersion                 string // semantic version of -go_version, such as v1.17; may be empty
+	useAny                    useAnyMode
 
 	packageMap map[string]string // map from import path to package name
+	fields     mockFields        // of the interface being generated
 }
 
 func (g *generator) p(format string, args ...any) {
@@ -292,12 +723,56 @@ func sanitize(s string) string {
 	return t
 }
 
+// combineConstraints returns the conjunction of the given //go:build
+// expressions, ignoring empty ones.
+func combineConstraints(exprs ...string) (string, error) {
+	var combined constraint.Expr
+	for _, s := range exprs {
+		if s == "" {
+			continue
+		}
+		expr, err := constraint.Parse("//go:build " + s)
+		if err != nil {
+			return "", fmt.Errorf("invalid build constraint %q: %v", s, err)
+		}
+		if combined == nil {
+			combined = expr
+		} else {
+			combined = &constraint.AndExpr{X: combined, Y: expr}
+		}
+	}
+	if combined == nil {
+		return "", nil
+	}
+	return combined.String(), nil
+}
+
 func (g *generator) Generate(pkg *model.Package, outputPkgName string, outputPackagePath string) error {
-	if outputPkgName != pkg.Name && *selfPackage == "" {
+	if !g.hasGenerics() {
+		// Rather than generating code that does not compile.
+		goVersion := strings.TrimPrefix(g.goVersion, "v")
+		if *typed == typedGeneric {
+			return fmt.Errorf("-typed=generic requires Go 1.18, but -go_version is %s", goVersion)
+		}
+		if g.useAny == useAnyAlways {
+			return fmt.Errorf("-use_any requires Go 1.18, but -go_version is %s", goVersion)
+		}
+		for _, intf := range pkg.Interfaces {
+			if len(intf.TypeParams) > 0 {
+				return fmt.Errorf("interface %s is generic, which requires Go 1.18, but -go_version is %s", intf.Name, goVersion)
+			}
+		}
+	}
+	if outputPkgName != pkg.Name && selfPackagePath() == "" {
 		// reset outputPackagePath if it's not passed in through -self_package
+		// or -output_package_path
 		outputPackagePath = ""
 	}
 
+	if g.licenseSPDX != "" {
+		g.p("// SPDX-License-Identifier: %s", g.licenseSPDX)
+		g.p("")
+	}
 	if g.copyrightHeader != "" {
 		lines := strings.Split(g.copyrightHeader, "\n")
 		for _, line := range lines {
@@ -314,6 +789,9 @@ func (g *generator) Generate(pkg *model.Package, outputPkgName string, outputPac
 			g.p("// Source: %v (interfaces: %v)", g.srcPackage, g.srcInterfaces)
 		}
 	}
+	if g.fingerprint != "" {
+		g.p("%s%s", fingerprintPrefix, g.fingerprint)
+	}
 	if *writeCmdComment {
 		g.p("//")
 		g.p("// Generated by this command:")
@@ -326,17 +804,36 @@ func (g *generator) Generate(pkg *model.Package, outputPkgName string, outputPac
 		g.p("//\t%v", strings.Join(append([]string{name}, os.Args[1:]...), " "))
 		g.p("//")
 	}
+	constraint, err := combineConstraints(pkg.BuildConstraint, buildConstraint())
+	if err != nil {
+		return err
+	}
+	if constraint != "" {
+		g.p("")
+		g.p("//go:build %s", constraint)
+		g.p("")
+	}
 
 	// Get all required imports, and generate unique names for them all.
 	im := pkg.Imports()
-	im[gomockImportPath] = true
-
-	// Only import reflect if it's used. We only use reflect in mocked methods
-	// so only import if any of the mocked interfaces have methods.
-	for _, intf := range pkg.Interfaces {
-		if len(intf.Methods) > 0 {
-			im["reflect"] = true
-			break
+	if !*stub {
+		im[gomockImportPath] = true
+
+		// Only import reflect if it's used. We only use reflect in mocked methods
+		// so only import if any of the mocked interfaces have methods.
+		for _, intf := range pkg.Interfaces {
+			if len(intf.Methods) > 0 {
+				im["reflect"] = true
+				break
+			}
+		}
+		if *formatter {
+			for _, intf := range pkg.Interfaces {
+				if !hasFormatMethod(intf) {
+					im["fmt"] = true
+					break
+				}
+			}
 		}
 	}
 
@@ -361,9 +858,25 @@ func (g *generator) Generate(pkg *model.Package, outputPkgName string, outputPac
 		}
 	}
 
+	g.srcPackagePath = pkg.PkgPath
+	if g.srcPackagePath == "" {
+		g.srcPackagePath = g.srcPackage
+	}
+
 	g.packageMap = make(map[string]string, len(im))
 	localNames := make(map[string]bool, len(im))
+	// Aliases from -import_aliases are reserved up front, so that the other
+	// packages are named around them.
+	for _, alias := range g.importAliases {
+		localNames[alias] = true
+	}
 	for _, pth := range sortedPaths {
+		if alias, ok := g.importAliases[pth]; ok {
+			if pth != pkg.PkgPath || outputPackagePath != pkg.PkgPath {
+				g.packageMap[pth] = alias
+			}
+			continue
+		}
 		base, ok := packagesName[pth]
 		if !ok {
 			base = sanitize(path.Base(pth))
@@ -395,6 +908,12 @@ func (g *generator) Generate(pkg *model.Package, outputPkgName string, outputPac
 		localNames[pkgName] = true
 	}
 
+	for _, pth := range sortedPaths {
+		if pkgName, ok := g.packageMap[pth]; ok {
+			logf(1, "resolved import %q as %s", pth, pkgName)
+		}
+	}
+
 	if *writePkgComment {
 		// Ensure there's an empty line before the package to follow the recommendations:
 		// https://github.com/golang/go/wiki/CodeReviewComments#package-comments
@@ -402,6 +921,14 @@ func (g *generator) Generate(pkg *model.Package, outputPkgName string, outputPac
 
 		g.p("// Package %v is a generated GoMock package.", outputPkgName)
 	}
+	if *nolint != "" {
+		// golangci-lint applies a directive attached to the package clause to
+		// the whole file. gofmt keeps directives at the end of doc comments.
+		if !*writePkgComment {
+			g.p("")
+		}
+		g.p("//nolint:%s", *nolint)
+	}
 	g.p("package %v", outputPkgName)
 	g.p("")
 	g.p("import (")
@@ -423,6 +950,10 @@ func (g *generator) Generate(pkg *model.Package, outputPkgName string, outputPac
 	}
 
 	for _, intf := range pkg.Interfaces {
+		if *stub {
+			g.GenerateStubInterface(intf, outputPackagePath)
+			continue
+		}
 		if err := g.GenerateMockInterface(intf, outputPackagePath); err != nil {
 			return err
 		}
@@ -432,12 +963,142 @@ func (g *generator) Generate(pkg *model.Package, outputPkgName string, outputPac
 }
 
 // The name of the mock type to use for the given interface identifier.
+// Explicit names from -mock_names take precedence over -mock_prefix and
+// -mock_suffix, which in turn replace the default 'Mock' prefix.
 func (g *generator) mockName(typeName string) string {
 	if mockName, ok := g.mockNames[typeName]; ok {
 		return mockName
 	}
+	if g.mockPrefix == "" && g.mockSuffix == "" {
+		return "Mock" + typeName
+	}
+
+	return g.mockPrefix + typeName + g.mockSuffix
+}
+
+// The name of the recorder type to use for the given interface identifier.
+// Mocks named through -mock_prefix or -mock_suffix get recorders following
+// the same pattern, e.g. FooMockRecorder for -mock_suffix=Mock. Otherwise the
+// recorder is named after the mock with a 'MockRecorder' suffix.
+// With -unexported_recorder, the recorder is unexported.
+func (g *generator) recorderName(typeName string) string {
+	_, explicit := g.mockNames[typeName]
+	if explicit || (g.mockPrefix == "" && g.mockSuffix == "") {
+		return recorderVisibility(g.mockName(typeName) + "MockRecorder")
+	}
+	return recorderVisibility(g.mockPrefix + typeName + g.mockSuffix + "Recorder")
+}
+
+// methodTyped is the typed mode of the method m of intf: typed, or with
+// -typed_methods, typedMonomorphic for the methods listed and untyped for the
+// others.
+func (g *generator) methodTyped(intf *model.Interface, m *model.Method, typed typedMode) typedMode {
+	if typed == untyped && g.typedMethods[intf.Name+"."+m.Name] {
+		return typedMonomorphic
+	}
+	return typed
+}
+
+// callTypeName is the name of the call type generated with -typed for the
+// method m of the mock mockType.
+func callTypeName(mockType string, m *model.Method) string {
+	return recorderVisibility(mockType + m.Name + "Call")
+}
+
+// recorderVisibility unexports the name of a type that is part of the
+// recorder API if -unexported_recorder is set.
+func recorderVisibility(name string) string {
+	if !*unexportedRecorder {
+		return name
+	}
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToLower(r)) + name[size:]
+}
+
+// expectName is the name of the method of mocks that returns their recorder.
+func expectName() string {
+	if *unexportedExpect {
+		return "expect"
+	}
+	return "EXPECT"
+}
+
+// mockFields are the names of the identifiers the generated mock and recorder
+// structs have besides the methods of their interface. Those that collide with
+// a method of the interface get trailing underscores, such as ctrl_ for an
+// unexported method ctrl or EXPECT_ for a method EXPECT.
+type mockFields struct {
+	ctrl, recorder string // fields of the mock
+	mock           string // field of the recorder
+	expect         string // method of the mock returning the recorder
+	marker         bool   // whether the mock has the ISGOMOCK method
+
+	// With -compact, check is the method of the mock checking that it is
+	// not nil, and results maps the result lists shared by several methods,
+	// such as "int, error", to the method of the mock asserting their types.
+	check   string
+	results map[string]string
+}
+
+func newMockFields(intf *model.Interface) mockFields {
+	methods := make(map[string]bool, len(intf.Methods))
+	for _, m := range intf.Methods {
+		methods[m.Name] = true
+	}
+	unique := func(name string) string {
+		for methods[name] {
+			name += "_"
+		}
+		return name
+	}
+	f := mockFields{
+		ctrl:     unique("ctrl"),
+		recorder: unique("recorder"),
+		mock:     unique("mock"),
+		expect:   unique(expectName()),
+		// A method ISGOMOCK of the interface is mocked instead.
+		marker: !methods["ISGOMOCK"],
+	}
+	if *compact {
+		f.check = unique("checkMock")
+		f.results = make(map[string]string)
+	}
+	return f
+}
+
+// sharedResults returns the result types of the methods of intf, such as
+// [int error], that more than one method has, in the order of the methods.
+func (g *generator) sharedResults(intf *model.Interface, pkgOverride string) [][]string {
+	count := make(map[string]int)
+	var shared [][]string
+	for _, m := range intf.Methods {
+		if len(m.Out) == 0 {
+			continue
+		}
+		types := g.getArgTypes(m, pkgOverride, false /* out */)
+		key := strings.Join(types, ", ")
+		if count[key]++; count[key] == 2 {
+			shared = append(shared, types)
+		}
+	}
+	return shared
+}
 
-	return "Mock" + typeName
+// interfaceDocName returns how the doc comments of the generated types refer
+// to the interface name. With -doc_links it is a Go doc link, which uses the
+// local name of the source package if the generated code imports it and the
+// full import path otherwise, so that it resolves without an extra import.
+func (g *generator) interfaceDocName(name, outputPackagePath string) string {
+	if !*docLinks || g.srcPackagePath == "" {
+		return name
+	}
+	if g.srcPackagePath == outputPackagePath {
+		return "[" + name + "]"
+	}
+	if pkgName, ok := g.packageMap[g.srcPackagePath]; ok {
+		return "[" + pkgName + "." + name + "]"
+	}
+	return "[" + g.srcPackagePath + "." + name + "]"
 }
 
 // formattedTypeParams returns a long and short form of type param info used for
@@ -467,22 +1128,35 @@ func (g *generator) formattedTypeParams(it *model.Interface, pkgOverride string)
 
 func (g *generator) GenerateMockInterface(intf *model.Interface, outputPackagePath string) error {
 	mockType := g.mockName(intf.Name)
+	recorderType := g.recorderName(intf.Name)
 	longTp, shortTp := g.formattedTypeParams(intf, outputPackagePath)
+	g.fields = newMockFields(intf)
+	f := g.fields
+
+	if *exposeCtrl {
+		for _, m := range intf.Methods {
+			if m.Name == "Ctrl" {
+				return fmt.Errorf("-expose_ctrl: interface %s has a method Ctrl, which its mock's controller accessor would conflict with", intf.Name)
+			}
+		}
+	}
 
+	// Mocks are compared and used as map keys, so the generated structs only
+	// hold pointers.
 	g.p("")
-	g.p("// %v is a mock of %v interface.", mockType, intf.Name)
+	g.p("// %v is a mock of %v interface.", mockType, g.interfaceDocName(intf.Name, outputPackagePath))
 	g.p("type %v%v struct {", mockType, longTp)
 	g.in()
-	g.p("ctrl     *gomock.Controller")
-	g.p("recorder *%vMockRecorder%v", mockType, shortTp)
+	g.p("%v *gomock.Controller", f.ctrl)
+	g.p("%v *%v%v", f.recorder, recorderType, shortTp)
 	g.out()
 	g.p("}")
 	g.p("")
 
-	g.p("// %vMockRecorder is the mock recorder for %v.", mockType, mockType)
-	g.p("type %vMockRecorder%v struct {", mockType, longTp)
+	g.p("// %v is the mock recorder for %v.", recorderType, mockType)
+	g.p("type %v%v struct {", recorderType, longTp)
 	g.in()
-	g.p("mock *%v%v", mockType, shortTp)
+	g.p("%v *%v%v", f.mock, mockType, shortTp)
 	g.out()
 	g.p("}")
 	g.p("")
@@ -490,32 +1164,237 @@ func (g *generator) GenerateMockInterface(intf *model.Interface, outputPackagePa
 	g.p("// New%v creates a new mock instance.", mockType)
 	g.p("func New%v%v(ctrl *gomock.Controller) *%v%v {", mockType, longTp, mockType, shortTp)
 	g.in()
-	g.p("mock := &%v%v{ctrl: ctrl}", mockType, shortTp)
-	g.p("mock.recorder = &%vMockRecorder%v{mock}", mockType, shortTp)
+	g.p("mock := &%v%v{%v: ctrl}", mockType, shortTp, f.ctrl)
+	g.p("mock.%v = &%v%v{mock}", f.recorder, recorderType, shortTp)
 	g.p("return mock")
 	g.out()
 	g.p("}")
 	g.p("")
 
-	// XXX: possible name collision here if someone has EXPECT in their interface.
-	g.p("// EXPECT returns an object that allows the caller to indicate expected use.")
-	g.p("func (m *%v%v) EXPECT() *%vMockRecorder%v {", mockType, shortTp, mockType, shortTp)
+	g.p("// %v returns an object that allows the caller to indicate expected use.", f.expect)
+	g.p("func (m *%v%v) %v() *%v%v {", mockType, shortTp, f.expect, recorderType, shortTp)
 	g.in()
-	g.p("return m.recorder")
+	g.generateNilMockCheck("m", mockType)
+	g.p("return m.%v", f.recorder)
 	g.out()
 	g.p("}")
 
-	// XXX: possible name collision here if someone has ISGOMOCK in their interface.
-	g.p("// ISGOMOCK indicates that this struct is a gomock mock.")
-	g.p("func (m *%v%v) ISGOMOCK() struct{} {", mockType, shortTp)
+	if f.marker {
+		g.p("")
+		g.p("// ISGOMOCK indicates that this struct is a gomock mock.")
+		g.p("func (m *%v%v) ISGOMOCK() struct{} {", mockType, shortTp)
+		g.in()
+		g.p("return struct{}{}")
+		g.out()
+		g.p("}")
+	}
+
+	if *exposeCtrl {
+		g.p("")
+		g.p("// Ctrl returns the controller of the mock.")
+		g.p("func (m *%v%v) Ctrl() *gomock.Controller {", mockType, shortTp)
+		g.in()
+		g.generateNilMockCheck("m", mockType)
+		g.p("return m.%v", f.ctrl)
+		g.out()
+		g.p("}")
+	}
+
+	if *compact {
+		g.GenerateMockHelpers(intf, mockType, outputPackagePath, shortTp)
+	}
+
+	if *goString {
+		g.GenerateMockGoString(intf, mockType, shortTp)
+	}
+
+	if *formatter {
+		g.GenerateMockFormat(intf, mockType, shortTp)
+	}
+
+	g.GenerateMockMethods(mockType, intf, outputPackagePath, longTp, shortTp, *typed)
+
+	if *builder {
+		g.GenerateMockBuilder(intf, outputPackagePath, longTp, shortTp, *typed)
+	}
+
+	return nil
+}
+
+// GenerateMockHelpers generates the helper methods of -compact, which the
+// methods of the mock of intf call instead of repeating their code: one
+// checking that the mock is not nil, and one per result list shared by
+// several methods asserting the types of the results of a call.
+func (g *generator) GenerateMockHelpers(intf *model.Interface, mockType, pkgOverride, shortTp string) {
+	f := g.fields
+	g.p("")
+	g.p("// %s panics if the mock is nil or was not created with New%s.", f.check, mockType)
+	g.p("func (m *%v%v) %s() {", mockType, shortTp, f.check)
+	g.in()
+	g.p("if m == nil || m.%s == nil {", f.ctrl)
 	g.in()
-	g.p("return struct{}{}")
+	g.p("panic(%q)", fmt.Sprintf("gomock: method called on a nil or uninitialized *%s; create it with New%s", mockType, mockType))
+	g.out()
+	g.p("}")
 	g.out()
 	g.p("}")
 
-	g.GenerateMockMethods(mockType, intf, outputPackagePath, longTp, shortTp, *typed)
+	sort.Sort(byMethodName(intf.Methods))
+	methods := make(map[string]bool, len(intf.Methods))
+	for _, m := range intf.Methods {
+		methods[m.Name] = true
+	}
+	for i, types := range g.sharedResults(intf, pkgOverride) {
+		name := fmt.Sprintf("results%d", i)
+		for methods[name] {
+			name += "_"
+		}
+		rets := strings.Join(types, ", ")
+		f.results[rets] = name
 
-	return nil
+		retString := " " + rets
+		if len(types) > 1 {
+			retString = " (" + rets + ")"
+		}
+		g.p("")
+		g.p("// %s asserts the types of the results of a call returning %s.", name, rets)
+		g.p("func (m *%v%v) %s(ret []any)%s {", mockType, shortTp, name, retString)
+		g.in()
+		retNames := make([]string, len(types))
+		for j, t := range types {
+			retNames[j] = fmt.Sprintf("ret%d", j)
+			g.p("%s, _ := ret[%d].(%s)", retNames[j], j, t)
+		}
+		g.p("return %s", strings.Join(retNames, ", "))
+		g.out()
+		g.p("}")
+	}
+}
+
+// GenerateMockGoString generates a GoString method, so that %#v prints the
+// mock as MockFoo{} instead of its controller and recorder. It is left out,
+// with a warning, if the interface has a GoString method to mock.
+func (g *generator) GenerateMockGoString(intf *model.Interface, mockType, shortTp string) {
+	for _, m := range intf.Methods {
+		if m.Name == "GoString" {
+			log.Printf("Warning: -gostring: interface %s has a GoString method, which is mocked instead", intf.Name)
+			return
+		}
+	}
+	g.p("")
+	g.p("// GoString returns a concise representation of the mock for %%#v.")
+	g.p("func (m *%v%v) GoString() string {", mockType, shortTp)
+	g.in()
+	g.p("return %q", mockType+"{}")
+	g.out()
+	g.p("}")
+}
+
+// hasFormatMethod reports whether intf has a Format method, which -formatter
+// leaves to be mocked.
+func hasFormatMethod(intf *model.Interface) bool {
+	for _, m := range intf.Methods {
+		if m.Name == "Format" {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateMockFormat generates a Format method, so that %v and the other verbs
+// print the mock as MockFoo(expectations: 2 pending), with the number of its
+// expected calls the controller has not yet seen satisfied. It is left out,
+// with a warning, if the interface has a Format method to mock.
+func (g *generator) GenerateMockFormat(intf *model.Interface, mockType, shortTp string) {
+	if hasFormatMethod(intf) {
+		log.Printf("Warning: -formatter: interface %s has a Format method, which is mocked instead", intf.Name)
+		return
+	}
+	fmtPkg := g.packageMap["fmt"]
+	g.p("")
+	g.p("// Format formats the mock concisely, with its number of pending expectations.")
+	g.p("func (m *%v%v) Format(f %v.State, verb rune) {", mockType, shortTp, fmtPkg)
+	g.in()
+	g.p("%v.Fprintf(f, %q, m.%v.PendingCalls(m))", fmtPkg, mockType+"(expectations: %d pending)", g.fields.ctrl)
+	g.out()
+	g.p("}")
+}
+
+// GenerateMockBuilder generates a builder of the mock of intf, whose
+// Expect<Method> methods register expected calls of the mock like its
+// recorder does, and whose Build method returns the mock.
+func (g *generator) GenerateMockBuilder(intf *model.Interface, pkgOverride, longTp, shortTp string, typed typedMode) {
+	mockType := g.mockName(intf.Name)
+	builderType := mockType + "Builder"
+
+	g.p("")
+	g.p("// %v registers expected calls of a %v before building it.", builderType, mockType)
+	g.p("type %v%v struct {", builderType, longTp)
+	g.in()
+	g.p("mock *%v%v", mockType, shortTp)
+	g.out()
+	g.p("}")
+	g.p("")
+	g.p("// New%v creates a builder of a new mock instance.", builderType)
+	g.p("func New%v%v(ctrl *gomock.Controller) *%v%v {", builderType, longTp, builderType, shortTp)
+	g.in()
+	g.p("return &%v%v{mock: New%v%v(ctrl)}", builderType, shortTp, mockType, shortTp)
+	g.out()
+	g.p("}")
+	g.p("")
+	g.p("// Build returns the mock with the expected calls registered by the builder.")
+	g.p("func (b *%v%v) Build() *%v%v {", builderType, shortTp, mockType, shortTp)
+	g.in()
+	g.p("return b.mock")
+	g.out()
+	g.p("}")
+
+	for _, m := range intf.Methods {
+		argNames := g.getArgNames(m, true)
+		ia := newIdentifierAllocator(argNames)
+		idRecv := ia.allocateIdentifier("b")
+
+		mode := g.methodTyped(intf, m, typed)
+		callType := "gomock.Call"
+		if mode != untyped {
+			callType = ""
+			if mode == typedGeneric {
+				callType = g.genericCallType(m, pkgOverride)
+			}
+			if callType == "" {
+				callType = callTypeName(mockType, m) + shortTp
+			}
+		}
+
+		callArgs := strings.Join(argNames, ", ")
+		if m.Variadic != nil {
+			callArgs += "..."
+		}
+
+		g.p("")
+		g.p("// Expect%v indicates an expected call of %v.", m.Name, m.Name)
+		g.p("func (%s *%v%v) Expect%v(%v) *%s {", idRecv, builderType, shortTp, m.Name, recorderParams(m, argNames), callType)
+		g.in()
+		g.p("%s.mock.%s.T.Helper()", idRecv, g.fields.ctrl)
+		g.p("return %s.mock.%s.%v(%v)", idRecv, g.fields.recorder, m.Name, callArgs)
+		g.out()
+		g.p("}")
+	}
+}
+
+// generateNilMockCheck makes a method of a nil or zero mock, which has no
+// controller to report to, panic with a message naming the mock instead of
+// dereferencing nil.
+func (g *generator) generateNilMockCheck(idRecv, mockType string) {
+	if g.fields.check != "" {
+		g.p("%s.%s()", idRecv, g.fields.check)
+		return
+	}
+	g.p("if %s == nil || %s.%s == nil {", idRecv, idRecv, g.fields.ctrl)
+	g.in()
+	g.p("panic(%q)", fmt.Sprintf("gomock: method called on a nil or uninitialized *%s; create it with New%s", mockType, mockType))
+	g.out()
+	g.p("}")
 }
 
 type byMethodName []*model.Method
@@ -524,20 +1403,36 @@ func (b byMethodName) Len() int           { return len(b) }
 func (b byMethodName) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
 func (b byMethodName) Less(i, j int) bool { return b[i].Name < b[j].Name }
 
-func (g *generator) GenerateMockMethods(mockType string, intf *model.Interface, pkgOverride, longTp, shortTp string, typed bool) {
+func (g *generator) GenerateMockMethods(mockType string, intf *model.Interface, pkgOverride, longTp, shortTp string, typed typedMode) {
 	sort.Sort(byMethodName(intf.Methods))
 	for _, m := range intf.Methods {
+		g.logMethod(mockType, m, pkgOverride)
 		g.p("")
 		_ = g.GenerateMockMethod(mockType, m, pkgOverride, shortTp)
 		g.p("")
-		_ = g.GenerateMockRecorderMethod(intf, m, shortTp, typed)
-		if typed {
+		mode := g.methodTyped(intf, m, typed)
+		callType := ""
+		if mode == typedGeneric {
+			callType = g.genericCallType(m, pkgOverride)
+		}
+		_ = g.GenerateMockRecorderMethod(intf, m, shortTp, mode, callType)
+		if mode != untyped && callType == "" {
 			g.p("")
 			_ = g.GenerateMockReturnCallMethod(intf, m, pkgOverride, longTp, shortTp)
 		}
 	}
 }
 
+// logMethod logs the generation of the method m of typeName, and at -vv the
+// types the method resolved to.
+func (g *generator) logMethod(typeName string, m *model.Method, pkgOverride string) {
+	logf(1, "generating method %s.%s", typeName, m.Name)
+	if verbosity() >= 2 {
+		argTypes := g.getArgTypes(m, pkgOverride, true /* in */)
+		logf(2, "  %s(%s)%s", m.Name, strings.Join(argTypes, ", "), g.getRetString(m, pkgOverride))
+	}
+}
+
 func makeArgString(argNames, argTypes []string) string {
 	args := make([]string, len(argNames))
 	for i, name := range argNames {
@@ -576,7 +1471,8 @@ func (g *generator) GenerateMockMethod(mockType string, m *model.Method, pkgOver
 	g.p("// %v mocks base method.", m.Name)
 	g.p("func (%v *%v%v) %v(%v)%v {", idRecv, mockType, shortTp, m.Name, argString, retString)
 	g.in()
-	g.p("%s.ctrl.T.Helper()", idRecv)
+	g.generateNilMockCheck(idRecv, mockType)
+	g.p("%s.%s.T.Helper()", idRecv, g.fields.ctrl)
 
 	var callArgs string
 	if m.Variadic == nil {
@@ -597,10 +1493,12 @@ func (g *generator) GenerateMockMethod(mockType string, m *model.Method, pkgOver
 		callArgs = ", " + idVarArgs + "..."
 	}
 	if len(m.Out) == 0 {
-		g.p(`%v.ctrl.Call(%v, %q%v)`, idRecv, idRecv, m.Name, callArgs)
+		g.p(`%v.%v.Call(%v, %q%v)`, idRecv, g.fields.ctrl, idRecv, m.Name, callArgs)
+	} else if helper, ok := g.fields.results[strings.Join(rets, ", ")]; ok {
+		g.p(`return %v.%v(%v.%v.Call(%v, %q%v))`, idRecv, helper, idRecv, g.fields.ctrl, idRecv, m.Name, callArgs)
 	} else {
 		idRet := ia.allocateIdentifier("ret")
-		g.p(`%v := %v.ctrl.Call(%v, %q%v)`, idRet, idRecv, idRecv, m.Name, callArgs)
+		g.p(`%v := %v.%v.Call(%v, %q%v)`, idRet, idRecv, g.fields.ctrl, idRecv, m.Name, callArgs)
 
 		// Go does not allow "naked" type assertions on nil values, so we use the two-value form here.
 		// The value of that is either (x.(T), true) or (Z, false), where Z is the zero value for T.
@@ -618,39 +1516,45 @@ func (g *generator) GenerateMockMethod(mockType string, m *model.Method, pkgOver
 	return nil
 }
 
-func (g *generator) GenerateMockRecorderMethod(intf *model.Interface, m *model.Method, shortTp string, typed bool) error {
-	mockType := g.mockName(intf.Name)
-	argNames := g.getArgNames(m, true)
-
-	var argString string
-	if m.Variadic == nil {
-		argString = strings.Join(argNames, ", ")
-	} else {
-		argString = strings.Join(argNames[:len(argNames)-1], ", ")
+// genericCallType returns the gomock.TypedCall type of the recorder method
+// of m, or "" if m has more results than the TypedCall types support.
+func (g *generator) genericCallType(m *model.Method, pkgOverride string) string {
+	const maxResults = 3
+	if len(m.Out) > maxResults {
+		return ""
 	}
-	if argString != "" {
-		argString += " any"
+	argTypes := g.getArgTypes(m, pkgOverride, true /* in */)
+	typeArgs := []string{fmt.Sprintf("func(%s)%s", strings.Join(argTypes, ", "), g.getRetString(m, pkgOverride))}
+	for _, p := range m.Out {
+		typeArgs = append(typeArgs, p.Type.String(g.packageMap, pkgOverride))
 	}
+	return fmt.Sprintf("gomock.TypedCall%d[%s]", len(m.Out), strings.Join(typeArgs, ", "))
+}
 
-	if m.Variadic != nil {
-		if argString != "" {
-			argString += ", "
-		}
-		argString += fmt.Sprintf("%s ...any", argNames[len(argNames)-1])
-	}
+// GenerateMockRecorderMethod generates the recorder method of m. If typed,
+// it returns callType, or the call type generated by
+// GenerateMockReturnCallMethod if callType is "".
+func (g *generator) GenerateMockRecorderMethod(intf *model.Interface, m *model.Method, shortTp string, typed typedMode, callType string) error {
+	mockType := g.mockName(intf.Name)
+	argNames := g.getArgNames(m, true)
+	argString := recorderParams(m, argNames)
 
 	ia := newIdentifierAllocator(argNames)
 	idRecv := ia.allocateIdentifier("mr")
 
+	if typed != untyped && callType == "" {
+		callType = callTypeName(mockType, m) + shortTp
+	}
+
 	g.p("// %v indicates an expected call of %v.", m.Name, m.Name)
-	if typed {
-		g.p("func (%s *%vMockRecorder%v) %v(%v) *%s%sCall%s {", idRecv, mockType, shortTp, m.Name, argString, mockType, m.Name, shortTp)
+	if typed != untyped {
+		g.p("func (%s *%v%v) %v(%v) *%s {", idRecv, g.recorderName(intf.Name), shortTp, m.Name, argString, callType)
 	} else {
-		g.p("func (%s *%vMockRecorder%v) %v(%v) *gomock.Call {", idRecv, mockType, shortTp, m.Name, argString)
+		g.p("func (%s *%v%v) %v(%v) *gomock.Call {", idRecv, g.recorderName(intf.Name), shortTp, m.Name, argString)
 	}
 
 	g.in()
-	g.p("%s.mock.ctrl.T.Helper()", idRecv)
+	g.p("%s.%s.%s.T.Helper()", idRecv, g.fields.mock, g.fields.ctrl)
 
 	var callArgs string
 	if m.Variadic == nil {
@@ -671,11 +1575,11 @@ func (g *generator) GenerateMockRecorderMethod(intf *model.Interface, m *model.M
 			callArgs = ", " + idVarArgs + "..."
 		}
 	}
-	if typed {
-		g.p(`call := %s.mock.ctrl.RecordCallWithMethodType(%s.mock, "%s", reflect.TypeOf((*%s%s)(nil).%s)%s)`, idRecv, idRecv, m.Name, mockType, shortTp, m.Name, callArgs)
-		g.p(`return &%s%sCall%s{Call: call}`, mockType, m.Name, shortTp)
+	if typed != untyped {
+		g.p(`call := %s.%s.%s.RecordCallWithMethodType(%s.%s, "%s", reflect.TypeOf((*%s%s)(nil).%s)%s)`, idRecv, g.fields.mock, g.fields.ctrl, idRecv, g.fields.mock, m.Name, mockType, shortTp, m.Name, callArgs)
+		g.p(`return &%s{Call: call}`, callType)
 	} else {
-		g.p(`return %s.mock.ctrl.RecordCallWithMethodType(%s.mock, "%s", reflect.TypeOf((*%s%s)(nil).%s)%s)`, idRecv, idRecv, m.Name, mockType, shortTp, m.Name, callArgs)
+		g.p(`return %s.%s.%s.RecordCallWithMethodType(%s.%s, "%s", reflect.TypeOf((*%s%s)(nil).%s)%s)`, idRecv, g.fields.mock, g.fields.ctrl, idRecv, g.fields.mock, m.Name, mockType, shortTp, m.Name, callArgs)
 	}
 
 	g.out()
@@ -683,6 +1587,29 @@ func (g *generator) GenerateMockRecorderMethod(intf *model.Interface, m *model.M
 	return nil
 }
 
+// recorderParams returns the parameter list of the recorder method of m,
+// whose parameters are named argNames and take matchers or values of any
+// type.
+func recorderParams(m *model.Method, argNames []string) string {
+	var argString string
+	if m.Variadic == nil {
+		argString = strings.Join(argNames, ", ")
+	} else {
+		argString = strings.Join(argNames[:len(argNames)-1], ", ")
+	}
+	if argString != "" {
+		argString += " any"
+	}
+
+	if m.Variadic != nil {
+		if argString != "" {
+			argString += ", "
+		}
+		argString += fmt.Sprintf("%s ...any", argNames[len(argNames)-1])
+	}
+	return argString
+}
+
 func (g *generator) GenerateMockReturnCallMethod(intf *model.Interface, m *model.Method, pkgOverride, longTp, shortTp string) error {
 	mockType := g.mockName(intf.Name)
 	argNames := g.getArgNames(m, true /* in */)
@@ -704,20 +1631,22 @@ func (g *generator) GenerateMockReturnCallMethod(intf *model.Interface, m *model
 		retString = " (" + strings.Join(rets, ", ") + ")"
 	}
 
-	ia := newIdentifierAllocator(argNames)
+	// The receiver must not collide with the results, which Return and
+	// ReturnZero name.
+	ia := newIdentifierAllocator(append(argNames[:len(argNames):len(argNames)], retNames...))
 	idRecv := ia.allocateIdentifier("c")
 
-	recvStructName := mockType + m.Name
+	recvStructName := callTypeName(mockType, m)
 
-	g.p("// %s%sCall wrap *gomock.Call", mockType, m.Name)
-	g.p("type %s%sCall%s struct{", mockType, m.Name, longTp)
+	g.p("// %s wrap *gomock.Call", recvStructName)
+	g.p("type %s%s struct{", recvStructName, longTp)
 	g.in()
 	g.p("*gomock.Call")
 	g.out()
 	g.p("}")
 
 	g.p("// Return rewrite *gomock.Call.Return")
-	g.p("func (%s *%sCall%s) Return(%v) *%sCall%s {", idRecv, recvStructName, shortTp, makeArgString(retNames, retTypes), recvStructName, shortTp)
+	g.p("func (%s *%s%s) Return(%v) *%s%s {", idRecv, recvStructName, shortTp, makeArgString(retNames, retTypes), recvStructName, shortTp)
 	g.in()
 	var retArgs string
 	if len(retNames) > 0 {
@@ -729,7 +1658,7 @@ func (g *generator) GenerateMockReturnCallMethod(intf *model.Interface, m *model
 	g.p("}")
 
 	g.p("// Do rewrite *gomock.Call.Do")
-	g.p("func (%s *%sCall%s) Do(f func(%v)%v) *%sCall%s {", idRecv, recvStructName, shortTp, argString, retString, recvStructName, shortTp)
+	g.p("func (%s *%s%s) Do(f func(%v)%v) *%s%s {", idRecv, recvStructName, shortTp, argString, retString, recvStructName, shortTp)
 	g.in()
 	g.p(`%s.Call = %v.Call.Do(f)`, idRecv, idRecv)
 	g.p("return %s", idRecv)
@@ -737,15 +1666,158 @@ func (g *generator) GenerateMockReturnCallMethod(intf *model.Interface, m *model
 	g.p("}")
 
 	g.p("// DoAndReturn rewrite *gomock.Call.DoAndReturn")
-	g.p("func (%s *%sCall%s) DoAndReturn(f func(%v)%v) *%sCall%s {", idRecv, recvStructName, shortTp, argString, retString, recvStructName, shortTp)
+	g.p("func (%s *%s%s) DoAndReturn(f func(%v)%v) *%s%s {", idRecv, recvStructName, shortTp, argString, retString, recvStructName, shortTp)
 	g.in()
 	g.p(`%s.Call = %v.Call.DoAndReturn(f)`, idRecv, idRecv)
 	g.p("return %s", idRecv)
 	g.out()
 	g.p("}")
+
+	if *returnZero {
+		g.p("// ReturnZero rewrite *gomock.Call.Return with the zero values of the results")
+		g.p("func (%s *%s%s) ReturnZero() *%s%s {", idRecv, recvStructName, shortTp, recvStructName, shortTp)
+		g.in()
+		if len(rets) > 0 {
+			g.p("var (")
+			g.in()
+			for i, name := range retNames {
+				g.p("%s %s", name, rets[i])
+			}
+			g.out()
+			g.p(")")
+		}
+		g.p(`%s.Call = %v.Call.Return(%v)`, idRecv, idRecv, retArgs)
+		g.p("return %s", idRecv)
+		g.out()
+		g.p("}")
+	}
+
+	// The values of a single result are typed, while those of other methods
+	// are checked when the call is recorded, as by *gomock.Call.ReturnsInOrder.
+	reserved := make([]string, 0, len(g.packageMap)+1)
+	for _, pkgName := range g.packageMap {
+		reserved = append(reserved, pkgName)
+	}
+	ia = newIdentifierAllocator(append(reserved, idRecv))
+	idRets := ia.allocateIdentifier("rets")
+	g.p("// ReturnsInOrder rewrite *gomock.Call.ReturnsInOrder")
+	if len(rets) != 1 {
+		g.p("func (%s *%s%s) ReturnsInOrder(%s ...[]any) *%s%s {", idRecv, recvStructName, shortTp, idRets, recvStructName, shortTp)
+		g.in()
+		g.p(`%s.Call = %v.Call.ReturnsInOrder(%s...)`, idRecv, idRecv, idRets)
+		g.p("return %s", idRecv)
+		g.out()
+		g.p("}")
+		return nil
+	}
+	idValues := ia.allocateIdentifier("values")
+	idI := ia.allocateIdentifier("i")
+	idRet := ia.allocateIdentifier("ret")
+	g.p("func (%s *%s%s) ReturnsInOrder(%s ...%s) *%s%s {", idRecv, recvStructName, shortTp, idRets, rets[0], recvStructName, shortTp)
+	g.in()
+	g.p("%s := make([][]any, len(%s))", idValues, idRets)
+	g.p("for %s, %s := range %s {", idI, idRet, idRets)
+	g.in()
+	g.p("%s[%s] = []any{%s}", idValues, idI, idRet)
+	g.out()
+	g.p("}")
+	g.p(`%s.Call = %v.Call.ReturnsInOrder(%s...)`, idRecv, idRecv, idValues)
+	g.p("return %s", idRecv)
+	g.out()
+	g.p("}")
 	return nil
 }
 
+// GenerateStubInterface generates a stub for intf. The stub holds one
+// function field per method, which the method calls if set. Otherwise the
+// method returns the zero values of its results. Stubs do not depend on
+// gomock.
+func (g *generator) GenerateStubInterface(intf *model.Interface, outputPackagePath string) {
+	stubType := "Stub" + intf.Name
+	longTp, shortTp := g.formattedTypeParams(intf, outputPackagePath)
+
+	sort.Sort(byMethodName(intf.Methods))
+
+	g.p("")
+	g.p("// %v is a stub of %v interface.", stubType, g.interfaceDocName(intf.Name, outputPackagePath))
+	g.p("type %v%v struct {", stubType, longTp)
+	g.in()
+	for _, m := range intf.Methods {
+		argTypes := g.getArgTypes(m, outputPackagePath, true /* in */)
+		g.p("%vFunc func(%v)%v", m.Name, strings.Join(argTypes, ", "), g.getRetString(m, outputPackagePath))
+	}
+	g.out()
+	g.p("}")
+
+	for _, m := range intf.Methods {
+		g.logMethod(stubType, m, outputPackagePath)
+		g.p("")
+		g.GenerateStubMethod(stubType, m, outputPackagePath, shortTp)
+	}
+}
+
+// GenerateStubMethod generates a stub method implementation which forwards
+// to the method's function field.
+func (g *generator) GenerateStubMethod(stubType string, m *model.Method, pkgOverride, shortTp string) {
+	argNames := g.getArgNames(m, true /* in */)
+	argTypes := g.getArgTypes(m, pkgOverride, true /* in */)
+	argString := makeArgString(argNames, argTypes)
+
+	ia := newIdentifierAllocator(argNames)
+	idRecv := ia.allocateIdentifier("s")
+
+	callArgs := strings.Join(argNames, ", ")
+	if m.Variadic != nil {
+		callArgs += "..."
+	}
+
+	if len(m.Out) == 0 {
+		g.p("// %v calls %vFunc if it is set.", m.Name, m.Name)
+	} else {
+		g.p("// %v calls %vFunc if it is set and otherwise returns zero values.", m.Name, m.Name)
+	}
+	g.p("func (%v *%v%v) %v(%v)%v {", idRecv, stubType, shortTp, m.Name, argString, g.getRetString(m, pkgOverride))
+	g.in()
+	g.p("if %v.%vFunc != nil {", idRecv, m.Name)
+	g.in()
+	if len(m.Out) == 0 {
+		g.p("%v.%vFunc(%v)", idRecv, m.Name, callArgs)
+		g.p("return")
+	} else {
+		g.p("return %v.%vFunc(%v)", idRecv, m.Name, callArgs)
+	}
+	g.out()
+	g.p("}")
+	if len(m.Out) > 0 {
+		retNames := make([]string, len(m.Out))
+		for i, p := range m.Out {
+			retNames[i] = ia.allocateIdentifier(fmt.Sprintf("ret%d", i))
+			g.p("var %v %v", retNames[i], p.Type.String(g.packageMap, pkgOverride))
+		}
+		g.p("return %v", strings.Join(retNames, ", "))
+	}
+	g.out()
+	g.p("}")
+}
+
+// getRetString returns the result list of m formatted for use after a
+// parameter list, including the leading space if there are any results.
+func (g *generator) getRetString(m *model.Method, pkgOverride string) string {
+	rets := g.getArgTypes(m, pkgOverride, false /* out */)
+	switch len(rets) {
+	case 0:
+		return ""
+	case 1:
+		return " " + rets[0]
+	default:
+		return " (" + strings.Join(rets, ", ") + ")"
+	}
+}
+
+// bodyIdentifiers are the predeclared identifiers generated method bodies
+// refer to, which parameter names must not shadow.
+var bodyIdentifiers = []string{"any", "append", "nil", "panic"}
+
 func (g *generator) getArgNames(m *model.Method, in bool) []string {
 	var params []*model.Parameter
 	if in {
@@ -753,22 +1825,69 @@ func (g *generator) getArgNames(m *model.Method, in bool) []string {
 	} else {
 		params = m.Out
 	}
+	if m.Variadic != nil && in {
+		params = append(params[:len(params):len(params)], m.Variadic)
+	}
+
+	// Parameter names must be unique and must not shadow anything generated
+	// method bodies refer to: the imported packages, a few builtins and the
+	// predeclared types of the method's signature, which the bodies spell out
+	// in type assertions such as ret[0].(string).
+	reserved := make(map[string]bool, len(g.packageMap)+len(bodyIdentifiers))
+	for _, name := range g.packageMap {
+		reserved[name] = true
+	}
+	for _, name := range bodyIdentifiers {
+		reserved[name] = true
+	}
+	for _, name := range g.predeclaredNames(m) {
+		reserved[name] = true
+	}
+	usable := func(name string) bool {
+		return name != "" && name != "_" && !reserved[name] && !token.Lookup(name).IsKeyword()
+	}
+
+	ia := make(identifierAllocator, len(params)+len(reserved))
+	for name := range reserved {
+		ia[name] = struct{}{}
+	}
+	for _, p := range params {
+		if usable(p.Name) {
+			ia[p.Name] = struct{}{}
+		}
+	}
+
 	argNames := make([]string, len(params))
 	for i, p := range params {
-		name := p.Name
-		if name == "" || name == "_" {
-			name = fmt.Sprintf("arg%d", i)
+		switch {
+		case usable(p.Name):
+			argNames[i] = p.Name
+		case p.Name == "" || p.Name == "_":
+			argNames[i] = ia.allocateIdentifier(fmt.Sprintf("arg%d", i))
+		default:
+			argNames[i] = ia.allocateIdentifier(p.Name)
 		}
-		argNames[i] = name
 	}
-	if m.Variadic != nil && in {
-		name := m.Variadic.Name
-		if name == "" {
-			name = fmt.Sprintf("arg%d", len(params))
+	return argNames
+}
+
+// predeclaredNames returns the predeclared identifiers, such as string and
+// error, the types of the parameters and results of m refer to.
+func (g *generator) predeclaredNames(m *model.Method) []string {
+	params := append(m.In[:len(m.In):len(m.In)], m.Out...)
+	if m.Variadic != nil {
+		params = append(params, m.Variadic)
+	}
+	notIdent := func(r rune) bool { return r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) }
+	var names []string
+	for _, p := range params {
+		for _, name := range strings.FieldsFunc(p.Type.String(g.packageMap, ""), notIdent) {
+			if types.Universe.Lookup(name) != nil {
+				names = append(names, name)
+			}
 		}
-		argNames = append(argNames, name)
 	}
-	return argNames
+	return names
 }
 
 func (g *generator) getArgTypes(m *model.Method, pkgOverride string, in bool) []string {
@@ -782,7 +1901,7 @@ func (g *generator) getArgTypes(m *model.Method, pkgOverride string, in bool) []
 	for i, p := range params {
 		argTypes[i] = p.Type.String(g.packageMap, pkgOverride)
 	}
-	if m.Variadic != nil {
+	if m.Variadic != nil && in {
 		argTypes = append(argTypes, "..."+m.Variadic.Type.String(g.packageMap, pkgOverride))
 	}
 	return argTypes
@@ -811,13 +1930,119 @@ func (o identifierAllocator) allocateIdentifier(want string) string {
 
 // Output returns the generator's output, formatted in the standard Go style.
 func (g *generator) Output() []byte {
+	start := time.Now()
+	// goimports groups the standard library imports, then the third-party
+	// ones, then those with a local prefix.
+	toolsimports.LocalPrefix = g.localPrefix
 	src, err := toolsimports.Process(g.destination, g.buf.Bytes(), nil)
 	if err != nil {
 		log.Fatalf("Failed to format generated source code: %s\n%s", err, g.buf.String())
 	}
+	switch g.emptyInterface() {
+	case "interface{}":
+		src, err = spellEmptyInterface(src)
+	case "any":
+		src, err = spellAny(src)
+	}
+	if err != nil {
+		log.Fatalf("Failed to spell out the empty interface: %s\n%s", err, g.buf.String())
+	}
+	logf(1, "formatted %d bytes of output in %v", len(src), time.Since(start))
 	return src
 }
 
+// spellEmptyInterface replaces the predeclared any, which Go versions before
+// 1.18 lack, with interface{} in the formatted source src. Identifiers named
+// any that are selected from something or name a function or field are
+// left alone.
+func spellEmptyInterface(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[*ast.Ident]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			names[n.Sel] = true
+		case *ast.FuncDecl:
+			names[n.Name] = true
+		case *ast.Field:
+			for _, name := range n.Names {
+				names[name] = true
+			}
+		}
+		return true
+	})
+	var offsets []int
+	ast.Inspect(file, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == "any" && id.Obj == nil && !names[id] {
+			offsets = append(offsets, fset.Position(id.Pos()).Offset)
+		}
+		return true
+	})
+	if len(offsets) == 0 {
+		return src, nil
+	}
+	var buf bytes.Buffer
+	prev := 0
+	for _, off := range offsets {
+		buf.Write(src[prev:off])
+		buf.WriteString("interface{}")
+		prev = off + len("any")
+	}
+	buf.Write(src[prev:])
+	return format.Source(buf.Bytes())
+}
+
+// spellAny replaces the empty interface literals of the formatted source src
+// with the predeclared any.
+func spellAny(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	var spans [][2]int
+	ast.Inspect(file, func(n ast.Node) bool {
+		if it, ok := n.(*ast.InterfaceType); ok && len(it.Methods.List) == 0 {
+			spans = append(spans, [2]int{fset.Position(it.Pos()).Offset, fset.Position(it.End()).Offset})
+		}
+		return true
+	})
+	if len(spans) == 0 {
+		return src, nil
+	}
+	var buf bytes.Buffer
+	prev := 0
+	for _, span := range spans {
+		buf.Write(src[prev:span[0]])
+		buf.WriteString("any")
+		prev = span[1]
+	}
+	buf.Write(src[prev:])
+	return format.Source(buf.Bytes())
+}
+
+// verbosity is the logging level set by -v (1) or -vv (2).
+func verbosity() int {
+	switch {
+	case *veryVerbose:
+		return 2
+	case *verbose:
+		return 1
+	}
+	return 0
+}
+
+// logf logs a generation step to stderr if the verbosity is at least level.
+func logf(level int, format string, args ...any) {
+	if verbosity() >= level {
+		log.Printf("mockgen: "+format, args...)
+	}
+}
+
 // createPackageMap returns a map of import path to package name
 // for specified importPaths.
 func createPackageMap(importPaths []string) map[string]string {
@@ -852,31 +2077,44 @@ func printVersion() {
 	}
 }
 
+// moduleRootOf returns the directory of the go.mod of the module containing
+// dir, or "" if dir is not within a module or modules are disabled.
+func moduleRootOf(dir string) (string, error) {
+	if os.Getenv("GO111MODULE") == "off" {
+		return "", nil
+	}
+	for {
+		_, err := os.Stat(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			return dir, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		if dir == filepath.Dir(dir) {
+			// at the root
+			return "", nil
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
 // parseImportPackage get package import path via source file
 // an alternative implementation is to use:
 // cfg := &packages.Config{Mode: packages.NeedName, Tests: true, Dir: srcDir}
 // pkgs, err := packages.Load(cfg, "file="+source)
 // However, it will call "go list" and slow down the performance
 func parsePackageImport(srcDir string) (string, error) {
-	moduleMode := os.Getenv("GO111MODULE")
 	// trying to find the module
-	if moduleMode != "off" {
-		currentDir := srcDir
-		for {
-			dat, err := os.ReadFile(filepath.Join(currentDir, "go.mod"))
-			if os.IsNotExist(err) {
-				if currentDir == filepath.Dir(currentDir) {
-					// at the root
-					break
-				}
-				currentDir = filepath.Dir(currentDir)
-				continue
-			} else if err != nil {
-				return "", err
-			}
-			modulePath := modfile.ModulePath(dat)
-			return filepath.ToSlash(filepath.Join(modulePath, strings.TrimPrefix(srcDir, currentDir))), nil
+	if root, err := moduleRootOf(srcDir); err != nil {
+		return "", err
+	} else if root != "" {
+		dat, err := os.ReadFile(filepath.Join(root, "go.mod"))
+		if err != nil {
+			return "", err
 		}
+		modulePath := modfile.ModulePath(dat)
+		return filepath.ToSlash(filepath.Join(modulePath, strings.TrimPrefix(srcDir, root))), nil
 	}
 	// fall back to GOPATH mode
 	goPaths := os.Getenv("GOPATH")
@@ -1,7 +1,16 @@
 package main
 
 import (
+	"bytes"
+	"errors"
+	"flag"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"log"
 	"os"
 	"path"
 	"path/filepath"
@@ -251,9 +260,13 @@ func TestGenerateMockInterface_Helper(t *testing.T) {
 
 			lines := strings.Split(g.buf.String(), "\n")
 
-			// T.Helper() should be the first line
+			// T.Helper() should be the first line after the nil mock check
 			for _, method := range test.Methods {
-				if strings.TrimSpace(lines[findMethod(t, test.Identifier, method.Name, lines)+1]) != test.HelperLine {
+				i := findMethod(t, test.Identifier, method.Name, lines) + 1
+				if strings.HasSuffix(strings.TrimSpace(lines[i]), ".ctrl == nil {") {
+					i += 3
+				}
+				if strings.TrimSpace(lines[i]) != test.HelperLine {
 					t.Fatalf("method %s.%s did not declare itself a Helper method", test.Identifier, method.Name)
 				}
 			}
@@ -328,9 +341,82 @@ func TestGetArgNames(t *testing.T) {
 			},
 			expected: []string{"firstArg", "arg1"},
 		},
+		{
+			name: "CollidingGeneratedName",
+			method: &model.Method{
+				In: []*model.Parameter{
+					{
+						Name: "_",
+						Type: &model.NamedType{Type: "int"},
+					},
+					{
+						Name: "arg0",
+						Type: &model.NamedType{Type: "string"},
+					},
+				},
+			},
+			expected: []string{"arg0_2", "arg0"},
+		},
+		{
+			name: "ShadowingImport",
+			method: &model.Method{
+				In: []*model.Parameter{
+					{
+						Name: "_",
+						Type: &model.NamedType{Type: "int"},
+					},
+					{
+						Name: "gomock",
+						Type: &model.NamedType{Type: "string"},
+					},
+				},
+			},
+			expected: []string{"arg0", "gomock_2"},
+		},
+		{
+			name: "ShadowingBuiltinVariadic",
+			method: &model.Method{
+				In: []*model.Parameter{
+					{
+						Name: "nil",
+						Type: &model.NamedType{Type: "int"},
+					},
+				},
+				Variadic: &model.Parameter{
+					Name: "any",
+					Type: &model.NamedType{Type: "string"},
+				},
+			},
+			expected: []string{"nil_2", "any_2"},
+		},
+		{
+			name: "ShadowingPredeclaredType",
+			method: &model.Method{
+				In: []*model.Parameter{
+					{
+						Name: "string",
+						Type: model.PredeclaredType("string"),
+					},
+					{
+						Name: "len",
+						Type: &model.MapType{Key: model.PredeclaredType("int"), Value: model.PredeclaredType("int")},
+					},
+				},
+				Out: []*model.Parameter{
+					{
+						Type: model.PredeclaredType("error"),
+					},
+				},
+				Variadic: &model.Parameter{
+					Name: "error",
+					Type: model.PredeclaredType("bool"),
+				},
+			},
+			expected: []string{"string_2", "len", "error_2"},
+		},
 	} {
 		t.Run(testCase.name, func(t *testing.T) {
-			g := generator{}
+			g := generator{packageMap: map[string]string{gomockImportPath: "gomock"}}
 
 			result := g.getArgNames(testCase.method, true)
 			if !reflect.DeepEqual(result, testCase.expected) {
@@ -467,3 +553,1571 @@ func TestParseExcludeInterfaces(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTypedMethods(t *testing.T) {
+	pkg := &model.Package{Interfaces: []*model.Interface{{
+		Name:    "Store",
+		Methods: []*model.Method{{Name: "Get"}, {Name: "Put"}},
+	}}}
+	testCases := []struct {
+		arg     string
+		want    map[string]bool
+		wantErr string
+	}{
+		{arg: "Store.Get", want: map[string]bool{"Store.Get": true}},
+		{arg: "Store.Get,Store.Put", want: map[string]bool{"Store.Get": true, "Store.Put": true}},
+		{arg: "Store", wantErr: "bad typed methods spec: Store"},
+		{arg: "Store.Get,", wantErr: "bad typed methods spec: "},
+		{arg: ".Get", wantErr: "bad typed methods spec: .Get"},
+		{arg: "Store.Delete", wantErr: "-typed_methods: no method Store.Delete of a mocked interface"},
+		{arg: "Cache.Get", wantErr: "-typed_methods: no method Cache.Get of a mocked interface"},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.arg, func(t *testing.T) {
+			got, err := parseTypedMethods(tt.arg, pkg)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("parseTypedMethods(%q) error = %v, want %s", tt.arg, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseTypedMethods(%q): %v", tt.arg, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseTypedMethods(%q) = %v, want %v", tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseImportAliases(t *testing.T) {
+	testCases := []struct {
+		arg     string
+		want    map[string]string
+		wantErr string
+	}{
+		{arg: "bar=github.com/foo/bar", want: map[string]string{"github.com/foo/bar": "bar"}},
+		{arg: "b=github.com/foo/bar,q=github.com/foo/qux", want: map[string]string{"github.com/foo/bar": "b", "github.com/foo/qux": "q"}},
+		{arg: "b=github.com/foo/bar,b=github.com/foo/bar", want: map[string]string{"github.com/foo/bar": "b"}},
+		{arg: "github.com/foo/bar", wantErr: "bad import aliases spec: github.com/foo/bar"},
+		{arg: "b=", wantErr: "bad import aliases spec: b="},
+		{arg: "func=github.com/foo/bar", wantErr: "bad import aliases spec: func=github.com/foo/bar"},
+		{arg: "_=github.com/foo/bar", wantErr: "bad import aliases spec: _=github.com/foo/bar"},
+		{arg: "any=github.com/foo/bar", wantErr: "bad import aliases spec: any=github.com/foo/bar"},
+		{arg: "b=github.com/foo/bar,b=github.com/foo/qux", wantErr: "bad import aliases spec: alias b is given to both github.com/foo/bar and github.com/foo/qux"},
+		{arg: "b=github.com/foo/bar,c=github.com/foo/bar", wantErr: "bad import aliases spec: github.com/foo/bar is given both alias b and c"},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.arg, func(t *testing.T) {
+			got, err := parseImportAliases(tt.arg)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("parseImportAliases(%q) error = %v, want %s", tt.arg, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseImportAliases(%q): %v", tt.arg, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseImportAliases(%q) = %v, want %v", tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMockNamePrefixSuffix(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		g            generator
+		wantMock     string
+		wantRecorder string
+	}{
+		{
+			name:         "default",
+			wantMock:     "MockFoo",
+			wantRecorder: "MockFooMockRecorder",
+		},
+		{
+			name:         "suffix",
+			g:            generator{mockSuffix: "Mock"},
+			wantMock:     "FooMock",
+			wantRecorder: "FooMockRecorder",
+		},
+		{
+			name:         "prefix and suffix",
+			g:            generator{mockPrefix: "Fake", mockSuffix: "Impl"},
+			wantMock:     "FakeFooImpl",
+			wantRecorder: "FakeFooImplRecorder",
+		},
+		{
+			name:         "mock_names overrides suffix",
+			g:            generator{mockSuffix: "Mock", mockNames: map[string]string{"Foo": "CustomFoo"}},
+			wantMock:     "CustomFoo",
+			wantRecorder: "CustomFooMockRecorder",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.g.mockName("Foo"); got != test.wantMock {
+				t.Errorf("mockName() = %q, want %q", got, test.wantMock)
+			}
+			if got := test.g.recorderName("Foo"); got != test.wantRecorder {
+				t.Errorf("recorderName() = %q, want %q", got, test.wantRecorder)
+			}
+		})
+	}
+}
+
+func TestGenerateMockInterface_MockSuffix(t *testing.T) {
+	g := generator{mockSuffix: "Mock"}
+	intf := &model.Interface{Name: "Foo", Methods: []*model.Method{{Name: "Bar"}}}
+	if err := g.GenerateMockInterface(intf, "somepackage"); err != nil {
+		t.Fatal(err)
+	}
+
+	out := g.buf.String()
+	for _, want := range []string{
+		"type FooMock struct {",
+		"recorder *FooMockRecorder",
+		"type FooMockRecorder struct {",
+		"func NewFooMock(ctrl *gomock.Controller) *FooMock {",
+		"func (m *FooMock) EXPECT() *FooMockRecorder {",
+		"func (mr *FooMockRecorder) Bar() *gomock.Call {",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRunConfig(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/config\n",
+		"foo.go": "package config\n\ntype Foo interface {\n\tBar() int\n}\n",
+		"baz.go": "package config\n\ntype Baz interface {\n\tQux(string)\n}\n",
+		"bad.go": "package config\n\ntype Bad interface {\n",
+		"cfg.json": `{"targets": [
+			{"source": "` + filepath.Join(dir, "bad.go") + `", "destination": "` + filepath.Join(dir, "mock_bad.go") + `"},
+			{"name": "foo", "source": "` + filepath.Join(dir, "foo.go") + `", "destination": "` + filepath.Join(dir, "mock_foo.go") + `", "typed": true},
+			{"source": "` + filepath.Join(dir, "baz.go") + `", "destination": "` + filepath.Join(dir, "mock_baz.go") + `", "package": "fakes"}
+		]}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("continue on failure", func(t *testing.T) {
+		err := runConfig(filepath.Join(dir, "cfg.json"))
+		if err == nil || !strings.Contains(err.Error(), "1 of 3 targets failed") {
+			t.Fatalf("runConfig() = %v, want 1 of 3 targets failed", err)
+		}
+
+		foo, err := os.ReadFile(filepath.Join(dir, "mock_foo.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(foo), "type MockFooBarCall struct") {
+			t.Errorf("typed flag of target was not applied:\n%s", foo)
+		}
+		baz, err := os.ReadFile(filepath.Join(dir, "mock_baz.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(baz), "package fakes") {
+			t.Errorf("package flag of target was not applied:\n%s", baz)
+		}
+		if strings.Contains(string(baz), "MockBazQuxCall") {
+			t.Errorf("typed flag leaked from previous target:\n%s", baz)
+		}
+	})
+
+	t.Run("fail fast", func(t *testing.T) {
+		for _, name := range []string{"mock_foo.go", "mock_baz.go"} {
+			if err := os.Remove(filepath.Join(dir, name)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		*failFast = true
+		defer func() { *failFast = false }()
+		err := runConfig(filepath.Join(dir, "cfg.json"))
+		if err == nil || !strings.Contains(err.Error(), "target 0 ("+filepath.Join(dir, "bad.go")+")") {
+			t.Fatalf("runConfig() = %v, want failure naming target 0", err)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "mock_foo.go")); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("target after failure was generated despite -fail_fast: %v", err)
+		}
+	})
+}
+
+func TestRunConfigNolint(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/config\n",
+		"foo.go": "package config\n\ntype Foo interface {\n\tBar() int\n}\n",
+		"baz.go": "package config\n\ntype Baz interface {\n\tQux(string)\n}\n",
+		"cfg.json": `{"targets": [
+			{"source": "` + filepath.Join(dir, "foo.go") + `", "destination": "` + filepath.Join(dir, "mock_foo.go") + `", "nolint": true},
+			{"source": "` + filepath.Join(dir, "baz.go") + `", "destination": "` + filepath.Join(dir, "mock_baz.go") + `"}
+		]}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := runConfig(filepath.Join(dir, "cfg.json")); err != nil {
+		t.Fatalf("runConfig() = %v", err)
+	}
+	if *nolint != "" {
+		t.Errorf("-nolint = %q after runConfig, want it restored to \"\"", *nolint)
+	}
+	foo, err := os.ReadFile(filepath.Join(dir, "mock_foo.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(foo), "//nolint:all") {
+		t.Errorf("nolint flag of target was not applied:\n%s", foo)
+	}
+	baz, err := os.ReadFile(filepath.Join(dir, "mock_baz.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(baz), "//nolint") {
+		t.Errorf("nolint flag leaked from previous target:\n%s", baz)
+	}
+}
+
+// lossyFlag is a flag.Value whose String does not round-trip through Set.
+type lossyFlag []string
+
+func (f *lossyFlag) String() string { return fmt.Sprintf("%d values", len(*f)) }
+
+func (f *lossyFlag) Set(s string) error {
+	*f = append(*f, s)
+	return nil
+}
+
+func TestSaveFlag(t *testing.T) {
+	v := lossyFlag{"a"}
+	f := &flag.Flag{Name: "lossy", Value: &v}
+	restore, err := saveFlag(f)
+	if err != nil {
+		t.Fatalf("saveFlag() = %v", err)
+	}
+	if err := f.Value.Set("b"); err != nil {
+		t.Fatal(err)
+	}
+	restore()
+	if !reflect.DeepEqual(v, lossyFlag{"a"}) {
+		t.Errorf("restored flag = %q, want %q", v, []string{"a"})
+	}
+}
+
+func TestConfigTargetUnknownFlag(t *testing.T) {
+	target := &configTarget{Flags: map[string]string{"no_such_flag": "1"}}
+	if err := runConfigTarget(target); err == nil || !strings.Contains(err.Error(), "unknown flag -no_such_flag") {
+		t.Errorf("runConfigTarget() = %v, want unknown flag error", err)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/summary\n",
+		"foo.go": "package summary\n\ntype Foo interface {\n\tBar() int\n\tBaz(string) error\n}\n\ntype Qux interface {\n\tQuux()\n}\n",
+		"cfg.json": `{"targets": [
+			{"source": "` + filepath.Join(dir, "foo.go") + `", "destination": "` + filepath.Join(dir, "mock_foo.go") + `", "typed": true},
+			{"source": "` + filepath.Join(dir, "foo.go") + `", "destination": "` + filepath.Join(dir, "stub_foo.go") + `", "stub": true, "exclude_interfaces": "Qux"}
+		]}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func(prevSummary bool, prevSource, prevDestination, prevTypedMethods string) {
+		*summary, *source, *destination, *typedMethods = prevSummary, prevSource, prevDestination, prevTypedMethods
+	}(*summary, *source, *destination, *typedMethods)
+	defer func(prev io.Writer) { summaryOutput = prev }(summaryOutput)
+	var report bytes.Buffer
+	summaryOutput = &report
+
+	// size returns the size of the file name in dir.
+	size := func(name string) int {
+		t.Helper()
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return int(info.Size())
+	}
+
+	t.Run("single target", func(t *testing.T) {
+		report.Reset()
+		*summary, *source, *destination, *typedMethods = true, filepath.Join(dir, "foo.go"), filepath.Join(dir, "mock_foo.go"), "Foo.Baz"
+		if err := generateMock(nil); err != nil {
+			t.Fatal(err)
+		}
+		want := fmt.Sprintf("mockgen: summary: Foo -> MockFoo: 2 methods, 1 of 2 typed, %[1]s (%[2]d bytes)\n"+
+			"mockgen: summary: Qux -> MockQux: 1 method, untyped, %[1]s (%[2]d bytes)\n",
+			*destination, size("mock_foo.go"))
+		if got := report.String(); got != want {
+			t.Errorf("report = %q, want %q", got, want)
+		}
+
+		// An unchanged mock is still reported.
+		report.Reset()
+		if err := generateMock(nil); err != nil {
+			t.Fatal(err)
+		}
+		if got := report.String(); got != want {
+			t.Errorf("report of unchanged mock = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("config", func(t *testing.T) {
+		report.Reset()
+		*summary, *source, *destination, *typedMethods = true, "", "", ""
+		if err := runConfig(filepath.Join(dir, "cfg.json")); err != nil {
+			t.Fatal(err)
+		}
+		want := fmt.Sprintf("mockgen: summary: Foo -> MockFoo: 2 methods, typed, %[1]s (%[2]d bytes)\n"+
+			"mockgen: summary: Qux -> MockQux: 1 method, typed, %[1]s (%[2]d bytes)\n"+
+			"mockgen: summary: Foo -> StubFoo: 2 methods, stub, %[3]s (%[4]d bytes)\n",
+			filepath.Join(dir, "mock_foo.go"), size("mock_foo.go"), filepath.Join(dir, "stub_foo.go"), size("stub_foo.go"))
+		if got := report.String(); got != want {
+			t.Errorf("report = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("unchanged with if_changed", func(t *testing.T) {
+		defer func(prev bool) { *ifChanged = prev }(*ifChanged)
+		*summary, *source, *destination, *typedMethods, *ifChanged = true, "", "", "", true
+		// Mocks without a fingerprint are inputs of the others.
+		for _, name := range []string{"mock_foo.go", "stub_foo.go"} {
+			if err := os.Remove(filepath.Join(dir, name)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := runConfig(filepath.Join(dir, "cfg.json")); err != nil {
+			t.Fatal(err)
+		}
+
+		// Every target is still reported when -if_changed skips them all.
+		report.Reset()
+		if err := runConfig(filepath.Join(dir, "cfg.json")); err != nil {
+			t.Fatal(err)
+		}
+		want := fmt.Sprintf("mockgen: summary: %s: unchanged, inputs match its fingerprint (%d bytes)\n"+
+			"mockgen: summary: %s: unchanged, inputs match its fingerprint (%d bytes)\n",
+			filepath.Join(dir, "mock_foo.go"), size("mock_foo.go"), filepath.Join(dir, "stub_foo.go"), size("stub_foo.go"))
+		if got := report.String(); got != want {
+			t.Errorf("report = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("off", func(t *testing.T) {
+		report.Reset()
+		*summary, *source, *destination = false, filepath.Join(dir, "foo.go"), filepath.Join(dir, "mock_foo.go")
+		if err := generateMock(nil); err != nil {
+			t.Fatal(err)
+		}
+		if report.Len() != 0 {
+			t.Errorf("report without -summary = %q, want none", &report)
+		}
+	})
+}
+
+func TestGenerateBuildConstraint(t *testing.T) {
+	defer func(prevOS, prevArch string) { *goos, *goarch = prevOS, prevArch }(*goos, *goarch)
+
+	tests := []struct {
+		goos, goarch string
+		want         string
+	}{
+		{"", "", ""},
+		{"windows", "", "//go:build windows\n"},
+		{"", "arm64", "//go:build arm64\n"},
+		{"windows", "amd64", "//go:build windows && amd64\n"},
+	}
+	for _, test := range tests {
+		*goos, *goarch = test.goos, test.goarch
+		g := generator{}
+		pkg := &model.Package{Name: "foo"}
+		if err := g.Generate(pkg, "mock_foo", ""); err != nil {
+			t.Fatal(err)
+		}
+		out := g.buf.String()
+		if test.want == "" {
+			if strings.Contains(out, "//go:build") {
+				t.Errorf("goos=%q goarch=%q: unexpected build constraint:\n%s", test.goos, test.goarch, out)
+			}
+			continue
+		}
+		if !strings.Contains(out, "\n\n"+test.want+"\n") {
+			t.Errorf("goos=%q goarch=%q: generated code does not contain %q:\n%s", test.goos, test.goarch, test.want, out)
+		}
+	}
+}
+
+func TestInferSelfPackage(t *testing.T) {
+	pkg := &model.Package{
+		Name:    "foo",
+		PkgPath: "example.com/foo",
+		Interfaces: []*model.Interface{{
+			Name: "Foo",
+			Methods: []*model.Method{{
+				Name: "Bar",
+				In:   []*model.Parameter{{Type: &model.NamedType{Package: "example.com/bar", Type: "Bar"}}},
+			}},
+		}},
+	}
+	tests := []struct {
+		name              string
+		srcPackagePath    string
+		outputPackageName string
+		want              string
+		wantWarning       bool
+	}{
+		{"input package", "example.com/foo", "foo", "example.com/foo", false},
+		{"unknown input package path", "", "foo", "", false},
+		{"other package", "example.com/foo", "mock_foo", "", false},
+		{"imported package", "example.com/foo", "bar", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			log.SetOutput(&logs)
+			defer log.SetOutput(os.Stderr)
+
+			if got := inferSelfPackage(pkg, test.srcPackagePath, test.outputPackageName); got != test.want {
+				t.Errorf("inferSelfPackage() = %q, want %q", got, test.want)
+			}
+			if gotWarning := strings.Contains(logs.String(), "-self_package=example.com/bar"); gotWarning != test.wantWarning {
+				t.Errorf("warning logged = %v, want %v: %q", gotWarning, test.wantWarning, logs.String())
+			}
+		})
+	}
+}
+
+func TestSelfPackagePathFromEnv(t *testing.T) {
+	t.Setenv("MOCKGEN_SELF_PACKAGE", "example.com/env")
+	if got := selfPackagePath(); got != "example.com/env" {
+		t.Errorf("selfPackagePath() = %q, want %q", got, "example.com/env")
+	}
+
+	*selfPackage = "example.com/flag"
+	defer func() { *selfPackage = "" }()
+	if got := selfPackagePath(); got != "example.com/flag" {
+		t.Errorf("selfPackagePath() = %q, want %q", got, "example.com/flag")
+	}
+}
+
+func TestGenerate_InferredSelfPackageAvoidsCycle(t *testing.T) {
+	pkg := &model.Package{
+		Name:    "foo",
+		PkgPath: "example.com/foo",
+		Interfaces: []*model.Interface{{
+			Name: "Foo",
+			Methods: []*model.Method{{
+				Name: "Bar",
+				Out:  []*model.Parameter{{Type: &model.NamedType{Package: "example.com/foo", Type: "Baz"}}},
+			}},
+		}},
+	}
+	g := generator{}
+	if err := g.Generate(pkg, "foo", inferSelfPackage(pkg, pkg.PkgPath, "foo")); err != nil {
+		t.Fatal(err)
+	}
+	out := g.buf.String()
+	if strings.Contains(out, `"example.com/foo"`) {
+		t.Errorf("generated code imports its own package:\n%s", out)
+	}
+	if !strings.Contains(out, "func (m *MockFoo) Bar() Baz {") {
+		t.Errorf("generated code does not refer to Baz unqualified:\n%s", out)
+	}
+}
+
+func TestGenerateMock_OutputPackagePathToStdout(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":         "module example.com/out\n",
+		"store/store.go": "package store\n\ntype Item struct{}\n",
+		"api/api.go":     "package api\n\nimport \"example.com/out/store\"\n\ntype Getter interface {\n\tGet() store.Item\n}\n",
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func(prevSource, prevPackage, prevPath string) {
+		*source, *packageOut, *outputPackagePathFlag = prevSource, prevPackage, prevPath
+	}(*source, *packageOut, *outputPackagePathFlag)
+	*source, *packageOut, *outputPackagePathFlag = filepath.Join(dir, "api", "api.go"), "store", "example.com/out/store"
+
+	out, err := os.Create(filepath.Join(dir, "stdout"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	defer func(prev *os.File) { os.Stdout = prev }(os.Stdout)
+	os.Stdout = out
+	if err := generateMock(nil); err != nil {
+		t.Fatal(err)
+	}
+	mock, err := os.ReadFile(out.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(mock), `"example.com/out/store"`) {
+		t.Errorf("mock imports its own package:\n%s", mock)
+	}
+	if !strings.Contains(string(mock), "func (m *MockGetter) Get() Item {") {
+		t.Errorf("mock does not refer to Item unqualified:\n%s", mock)
+	}
+
+	*selfPackage = "example.com/out/other"
+	defer func() { *selfPackage = "" }()
+	if err := generateMock(nil); err == nil || !strings.Contains(err.Error(), "disagree") {
+		t.Errorf("generateMock() = %v, want error for conflicting -self_package", err)
+	}
+}
+
+func TestCombineConstraints(t *testing.T) {
+	tests := []struct {
+		exprs []string
+		want  string
+	}{
+		{nil, ""},
+		{[]string{"", ""}, ""},
+		{[]string{"linux", ""}, "linux"},
+		{[]string{"linux || darwin", "amd64"}, "(linux || darwin) && amd64"},
+		{[]string{"unix && linux", "windows && arm64"}, "unix && linux && windows && arm64"},
+	}
+	for _, test := range tests {
+		got, err := combineConstraints(test.exprs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("combineConstraints(%q) = %q, want %q", test.exprs, got, test.want)
+		}
+	}
+	if _, err := combineConstraints("linux &&"); err == nil {
+		t.Error("combineConstraints() of an invalid expression succeeded")
+	}
+}
+
+func TestGenerate_MockStructsHoldOnlyPointers(t *testing.T) {
+	defer func(prev typedMode) { *typed = prev }(*typed)
+	*typed = typedMonomorphic
+
+	pkg := &model.Package{
+		Name: "foo",
+		Interfaces: []*model.Interface{{
+			Name: "Foo",
+			Methods: []*model.Method{{
+				Name: "Bar",
+				In:   []*model.Parameter{{Name: "x", Type: model.PredeclaredType("int")}},
+				Out:  []*model.Parameter{{Type: model.PredeclaredType("error")}},
+			}},
+		}},
+	}
+	g := generator{}
+	if err := g.Generate(pkg, "mock_foo", ""); err != nil {
+		t.Fatal(err)
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), "mock.go", g.buf.Bytes(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Mocks are used as map keys, so their structs must stay comparable.
+	structs := 0
+	ast.Inspect(file, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		st, ok := ts.Type.(*ast.StructType)
+		if !ok {
+			return true
+		}
+		structs++
+		for _, field := range st.Fields.List {
+			if _, ok := field.Type.(*ast.StarExpr); !ok {
+				t.Errorf("field %v of %s is not a pointer", field.Names, ts.Name)
+			}
+		}
+		return true
+	})
+	if structs != 3 {
+		t.Errorf("got %d structs, want the mock, recorder and call wrapper", structs)
+	}
+}
+
+func TestGenerateMock_AtLine(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/at\n",
+		"foo.go": "package at\n\ntype Foo interface {\n\tFoo()\n}\n\ntype Bar interface {\n\tBar() Foo\n}\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func(prevSource string, prevLine int) { *source, *atLine = prevSource, prevLine }(*source, *atLine)
+	*source, *atLine = filepath.Join(dir, "foo.go"), 8
+
+	if err := generateMock(nil); err != nil {
+		t.Fatal(err)
+	}
+	mock, err := os.ReadFile(filepath.Join(dir, "mock_bar.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"package at\n", "type MockBar struct", "func (m *MockBar) Bar() Foo {"} {
+		if !strings.Contains(string(mock), want) {
+			t.Errorf("mock does not contain %q:\n%s", want, mock)
+		}
+	}
+	if strings.Contains(string(mock), "MockFoo") {
+		t.Errorf("mock contains interface other than the one at line 8:\n%s", mock)
+	}
+
+	*atLine = 6
+	if err := generateMock(nil); err == nil || !strings.Contains(err.Error(), "no interface spans line 6") {
+		t.Errorf("generateMock() = %v, want error for line without interface", err)
+	}
+}
+
+func TestGenerate_DocLinks(t *testing.T) {
+	defer func(prev bool) { *docLinks = prev }(*docLinks)
+	*docLinks = true
+
+	store := &model.Interface{
+		Name: "Store",
+		Methods: []*model.Method{{
+			Name: "Get",
+			In:   []*model.Parameter{{Type: &model.NamedType{Package: "example.com/foo", Type: "Key"}}},
+		}},
+	}
+	pinger := &model.Interface{Name: "Pinger"}
+	tests := []struct {
+		name              string
+		intf              *model.Interface
+		outputPackageName string
+		want              string
+	}{
+		{"same package", store, "foo", "// MockStore is a mock of [Store] interface.\n"},
+		{"imported package", store, "mock_foo", "// MockStore is a mock of [foo.Store] interface.\n"},
+		{"package not imported", pinger, "mock_foo", "// MockPinger is a mock of [example.com/foo.Pinger] interface.\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkg := &model.Package{Name: "foo", PkgPath: "example.com/foo", Interfaces: []*model.Interface{test.intf}}
+			g := generator{}
+			if err := g.Generate(pkg, test.outputPackageName, inferSelfPackage(pkg, pkg.PkgPath, test.outputPackageName)); err != nil {
+				t.Fatal(err)
+			}
+			if out := g.buf.String(); !strings.Contains(out, test.want) {
+				t.Errorf("generated code does not contain %q:\n%s", test.want, out)
+			}
+		})
+	}
+}
+
+func TestGenerateMock_IfChanged(t *testing.T) {
+	dir := t.TempDir()
+	src, dst := filepath.Join(dir, "foo.go"), filepath.Join(dir, "mock_foo.go")
+	files := map[string]string{
+		"go.mod": "module example.com/foo\n",
+		"foo.go": "package foo\n\ntype Foo interface {\n\tFoo()\n}\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func(prevSource, prevDestination string, prevIfChanged bool, prevTyped typedMode) {
+		*source, *destination, *ifChanged, *typed = prevSource, prevDestination, prevIfChanged, prevTyped
+	}(*source, *destination, *ifChanged, *typed)
+	*source, *destination, *ifChanged = src, dst, true
+
+	// generate runs mockgen after marking the existing mock, and reports
+	// whether the mock was rewritten.
+	generate := func() bool {
+		t.Helper()
+		if mock, err := os.ReadFile(dst); err == nil {
+			if err := os.WriteFile(dst, append(mock, "// stale\n"...), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := generateMock(nil); err != nil {
+			t.Fatal(err)
+		}
+		mock, err := os.ReadFile(dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(mock), "\n"+fingerprintPrefix+"sha256:") {
+			t.Errorf("mock has no fingerprint:\n%s", mock)
+		}
+		return !strings.HasSuffix(string(mock), "// stale\n")
+	}
+
+	if !generate() {
+		t.Fatal("mock not generated")
+	}
+	if generate() {
+		t.Error("mock regenerated without changes")
+	}
+	if err := os.WriteFile(src, []byte("package foo\n\ntype Foo interface {\n\tFoo(int)\n}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if !generate() {
+		t.Error("mock not regenerated after its source changed")
+	}
+	*typed = typedMonomorphic
+	if !generate() {
+		t.Error("mock not regenerated after a flag changed")
+	}
+
+	*destination = ""
+	if err := generateMock(nil); err == nil || !strings.Contains(err.Error(), "requires -destination") {
+		t.Errorf("generateMock() = %v, want error without -destination", err)
+	}
+}
+
+func TestExpandDestinationPattern(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "foo.go")
+	tests := []struct {
+		pattern string
+		want    string
+		wantErr string
+	}{
+		{"{{.SourceDir}}/{{.SourceBase}}_mock.go", filepath.Join(dir, "foo_mock.go"), ""},
+		{"{{.SourceDir}}/mocks/mock_{{.SourceFile}}", filepath.Join(dir, "mocks", "mock_foo.go"), ""},
+		{"{{.SourceDir}}/{{.Source}}_mock.go", "", "invalid -destination_pattern"},
+		{"{{.SourceDir}/x.go", "", "invalid -destination_pattern"},
+		{"{{if false}}x.go{{end}}", "", "renders the invalid path"},
+		{"{{.SourceDir}}/{{.SourceFile}}", "", "renders the -source file"},
+		{"{{.SourceDir}}", "", "renders the directory"},
+	}
+	for _, tt := range tests {
+		got, err := expandDestinationPattern(tt.pattern, src)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expandDestinationPattern(%q) = %q, %v, want error containing %q", tt.pattern, got, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("expandDestinationPattern(%q) = %q, %v, want %q", tt.pattern, got, err, tt.want)
+		}
+	}
+}
+
+func TestGenerateMock_DestinationPattern(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "foo.go")
+	files := map[string]string{
+		"go.mod": "module example.com/foo\n",
+		"foo.go": "package foo\n\ntype Foo interface {\n\tFoo()\n}\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func(prevSource, prevDestination, prevPattern, prevPackage string) {
+		*source, *destination, *destinationPattern, *packageOut = prevSource, prevDestination, prevPattern, prevPackage
+	}(*source, *destination, *destinationPattern, *packageOut)
+	*source, *destinationPattern, *packageOut = src, "{{.SourceDir}}/{{.SourceBase}}_mock.go", "foo"
+
+	if err := generateMock(nil); err != nil {
+		t.Fatal(err)
+	}
+	mock, err := os.ReadFile(filepath.Join(dir, "foo_mock.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(mock), "func NewMockFoo(") {
+		t.Errorf("mock has no NewMockFoo:\n%s", mock)
+	}
+
+	*destination = filepath.Join(dir, "mock_foo.go")
+	if err := generateMock(nil); err == nil || !strings.Contains(err.Error(), "mutually exclusive") {
+		t.Errorf("generateMock() = %v, want error with both -destination and -destination_pattern", err)
+	}
+	*source, *destination = "", ""
+	if err := generateMock([]string{"example.com/foo", "Foo"}); err == nil || !strings.Contains(err.Error(), "requires -source") {
+		t.Errorf("generateMock() = %v, want error in reflect mode", err)
+	}
+}
+
+// writeVerifyModule writes a module requiring the local gomock to dir.
+func writeVerifyModule(t testing.TB, dir string, files map[string]string) {
+	t.Helper()
+	root, err := filepath.Abs("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files = mergeFiles(files, map[string]string{
+		"go.mod": "module example.com/foo\n\ngo 1.19\n\nrequire go.uber.org/mock v0.0.0\n\nreplace go.uber.org/mock => " + filepath.ToSlash(root) + "\n",
+		"go.sum": string(sum),
+	})
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// mergeFiles returns the files of both maps, preferring those of b.
+func mergeFiles(a, b map[string]string) map[string]string {
+	files := make(map[string]string, len(a)+len(b))
+	for name, content := range a {
+		files[name] = content
+	}
+	for name, content := range b {
+		files[name] = content
+	}
+	return files
+}
+
+func TestVerifyCompile(t *testing.T) {
+	dir := t.TempDir()
+	writeVerifyModule(t, dir, map[string]string{
+		"foo.go":          "package foo\n\ntype Bar struct{}\n",
+		"baz/baz.go":      "package baz\n\ntype Baz struct{}\n",
+		"baz/mock_baz.go": "package baz\n\nfunc stale() { undefinedFunc() }\n",
+	})
+
+	tests := []struct {
+		name    string
+		dst     string
+		output  string
+		wantErr string
+	}{
+		{"package local type", "mock_foo.go", "package foo\n\nvar _ Bar\n", ""},
+		{"new directory", "mock_foo/mock.go", "package mock_foo\n\nimport foo \"example.com/foo\"\n\nvar _ foo.Bar\n", ""},
+		{"test file", "mock_foo_test.go", "package foo\n\nvar _ Bar\n", ""},
+		{"replaced stale mock", "baz/mock_baz.go", "package baz\n\nvar _ Baz\n", ""},
+		{"undefined type", "mock_foo.go", "package foo\n\nvar _ Baz\n", "undefined: Baz"},
+		{"unqualified type in other package", "mock_foo/mock.go", "package mock_foo\n\nvar _ Bar\n", "undefined: Bar"},
+		{"test file with wrong package", "mock_foo_test.go", "package bar\n\nvar _ Bar\n", "found packages"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := filepath.Join(dir, filepath.FromSlash(tt.dst))
+			if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+				t.Fatal(err)
+			}
+			err := verifyCompile(dst, []byte(tt.output))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("verifyCompile() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), "does not compile") || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("verifyCompile() = %v, want compile error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateMock_VerifyCompile(t *testing.T) {
+	dir := t.TempDir()
+	writeVerifyModule(t, dir, map[string]string{
+		"foo.go": "package foo\n\ntype Bar struct{}\n\ntype Foo interface {\n\tFoo() Bar\n}\n",
+	})
+	dst := filepath.Join(dir, "mock_foo", "mock.go")
+	defer func(prevSource, prevDestination, prevOutputPackagePath string, prevVerify bool) {
+		*source, *destination, *outputPackagePathFlag, *verifyCompileFlag = prevSource, prevDestination, prevOutputPackagePath, prevVerify
+	}(*source, *destination, *outputPackagePathFlag, *verifyCompileFlag)
+	*source, *destination, *verifyCompileFlag = filepath.Join(dir, "foo.go"), dst, true
+
+	if err := generateMock(nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(dst); err != nil {
+		t.Fatalf("mock not written: %v", err)
+	}
+	if err := os.Remove(dst); err != nil {
+		t.Fatal(err)
+	}
+
+	// Claiming the mock is in the package of the interface leaves Bar
+	// unqualified in the mock_foo package.
+	*outputPackagePathFlag = "example.com/foo"
+	if err := generateMock(nil); err == nil || !strings.Contains(err.Error(), "undefined: Bar") {
+		t.Errorf("generateMock() = %v, want compile error", err)
+	}
+	if _, err := os.Stat(dst); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("mock that does not compile was written: %v", err)
+	}
+
+	*destination = ""
+	if err := generateMock(nil); err == nil || !strings.Contains(err.Error(), "requires -destination") {
+		t.Errorf("generateMock() = %v, want error without -destination", err)
+	}
+}
+
+func TestGenerate_PredeclaredParamNames(t *testing.T) {
+	// A method whose parameters are named after every predeclared
+	// identifier, none of which may shadow one the generated code uses.
+	names := types.Universe.Names()
+	m := &model.Method{
+		Name:     "Do",
+		Variadic: &model.Parameter{Name: names[len(names)-1], Type: model.PredeclaredType("int")},
+		Out:      []*model.Parameter{{Type: model.PredeclaredType("error")}},
+	}
+	for _, name := range names[:len(names)-1] {
+		m.In = append(m.In, &model.Parameter{Name: name, Type: model.PredeclaredType("int")})
+	}
+	pkg := &model.Package{
+		Name:       "foo",
+		PkgPath:    "example.com/foo",
+		Interfaces: []*model.Interface{{Name: "Foo", Methods: []*model.Method{m}}},
+	}
+
+	dir := t.TempDir()
+	writeVerifyModule(t, dir, map[string]string{"foo.go": "package foo\n"})
+	defer func(prevTyped typedMode, prevReturnZero, prevCompact, prevBuilder, prevFormatter, prevGoString, prevExposeCtrl, prevStub bool) {
+		*typed, *returnZero, *compact, *builder, *formatter, *goString, *exposeCtrl, *stub = prevTyped, prevReturnZero, prevCompact, prevBuilder, prevFormatter, prevGoString, prevExposeCtrl, prevStub
+	}(*typed, *returnZero, *compact, *builder, *formatter, *goString, *exposeCtrl, *stub)
+	for _, tt := range []struct {
+		name string
+		set  func()
+	}{
+		{"default", func() {}},
+		{"typed", func() { *typed, *returnZero = typedMonomorphic, true }},
+		{"typed=generic", func() { *typed = typedGeneric }},
+		{"compact", func() { *compact = true }},
+		{"helpers", func() { *builder, *formatter, *goString, *exposeCtrl = true, true, true, true }},
+		{"stub", func() { *stub = true }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			*typed, *returnZero, *compact, *builder, *formatter, *goString, *exposeCtrl, *stub = untyped, false, false, false, false, false, false, false
+			tt.set()
+			g := generator{}
+			if err := g.Generate(pkg, "foo", "example.com/foo"); err != nil {
+				t.Fatal(err)
+			}
+			if err := verifyCompile(filepath.Join(dir, "mock_foo.go"), g.Output()); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestVerboseLogging(t *testing.T) {
+	defer func(prevVerbose, prevVeryVerbose bool) {
+		*verbose, *veryVerbose = prevVerbose, prevVeryVerbose
+	}(*verbose, *veryVerbose)
+	defer log.SetOutput(log.Writer())
+
+	pkg := &model.Package{
+		Name:    "foo",
+		PkgPath: "example.com/foo",
+		Interfaces: []*model.Interface{{
+			Name: "Foo",
+			Methods: []*model.Method{{
+				Name: "Bar",
+				In:   []*model.Parameter{{Type: &model.NamedType{Package: "example.com/bar", Type: "Bar"}}},
+				Out:  []*model.Parameter{{Type: model.PredeclaredType("error")}},
+			}},
+		}},
+	}
+	tests := []struct {
+		name                   string
+		verbose, veryVerbose   bool
+		wantLogs, unwantedLogs []string
+	}{
+		{
+			name:         "silent",
+			unwantedLogs: []string{"mockgen:"},
+		},
+		{
+			name:         "v",
+			verbose:      true,
+			wantLogs:     []string{`resolved import "example.com/bar" as bar`, "generating method MockFoo.Bar", "formatted"},
+			unwantedLogs: []string{"Bar(bar.Bar) error"},
+		},
+		{
+			name:        "vv",
+			veryVerbose: true,
+			wantLogs:    []string{"generating method MockFoo.Bar", "Bar(bar.Bar) error"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			*verbose, *veryVerbose = test.verbose, test.veryVerbose
+			var logs bytes.Buffer
+			log.SetOutput(&logs)
+
+			g := generator{}
+			if err := g.Generate(pkg, "mock_foo", ""); err != nil {
+				t.Fatal(err)
+			}
+			g.Output()
+			for _, want := range test.wantLogs {
+				if !strings.Contains(logs.String(), want) {
+					t.Errorf("logs do not contain %q:\n%s", want, &logs)
+				}
+			}
+			for _, unwanted := range test.unwantedLogs {
+				if strings.Contains(logs.String(), unwanted) {
+					t.Errorf("logs contain %q:\n%s", unwanted, &logs)
+				}
+			}
+		})
+	}
+}
+
+func TestTypedFlag(t *testing.T) {
+	fs := flag.NewFlagSet("mockgen", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	var m typedMode
+	fs.Var(&m, "typed", "")
+
+	for _, tt := range []struct {
+		args []string
+		want typedMode
+	}{
+		{nil, untyped},
+		{[]string{"-typed"}, typedMonomorphic},
+		{[]string{"-typed=false"}, untyped},
+		{[]string{"-typed=generic"}, typedGeneric},
+	} {
+		m = untyped
+		if err := fs.Parse(tt.args); err != nil {
+			t.Errorf("Parse(%q) failed: %v", tt.args, err)
+		} else if m != tt.want {
+			t.Errorf("Parse(%q) set %v, want %v", tt.args, &m, &tt.want)
+		}
+	}
+	if err := fs.Parse([]string{"-typed=monomorphic"}); err == nil {
+		t.Error(`Parse("-typed=monomorphic") succeeded`)
+	}
+}
+
+func TestGenerate_Nolint(t *testing.T) {
+	defer func(prevNolint nolintLinters, prevPkgComment bool) {
+		*nolint, *writePkgComment = prevNolint, prevPkgComment
+	}(*nolint, *writePkgComment)
+
+	tests := []struct {
+		name       string
+		nolint     string
+		pkgComment bool
+		want       string
+	}{
+		{"all", "true", true, "//nolint:all"},
+		{"linters", "golint,stylecheck", true, "//nolint:golint,stylecheck"},
+		{"no package comment", "true", false, "//nolint:all"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := nolint.Set(test.nolint); err != nil {
+				t.Fatal(err)
+			}
+			*writePkgComment = test.pkgComment
+			g := generator{filename: "foo.go"}
+			if err := g.Generate(&model.Package{Name: "foo", Interfaces: []*model.Interface{{Name: "Foo"}}}, "mock_foo", ""); err != nil {
+				t.Fatal(err)
+			}
+			src := g.Output()
+
+			// golangci-lint honors the directive only when it is attached
+			// to the package clause.
+			file, err := parser.ParseFile(token.NewFileSet(), "mock_foo.go", src, parser.ParseComments)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if file.Doc == nil || file.Doc.List[len(file.Doc.List)-1].Text != test.want {
+				t.Errorf("package clause is not directly preceded by %q:\n%s", test.want, src)
+			}
+			if !bytes.HasPrefix(src, []byte("// Code generated by MockGen. DO NOT EDIT.\n")) {
+				t.Errorf("generated code does not start with the DO NOT EDIT comment:\n%s", src)
+			}
+		})
+	}
+
+	if err := nolint.Set("golint,"); err == nil {
+		t.Error(`Set("golint,") succeeded`)
+	}
+}
+
+func TestGenerate_ExposeCtrl(t *testing.T) {
+	defer func(prev bool) { *exposeCtrl = prev }(*exposeCtrl)
+	*exposeCtrl = true
+
+	generate := func(methods ...string) (string, error) {
+		intf := &model.Interface{Name: "Foo"}
+		for _, name := range methods {
+			intf.Methods = append(intf.Methods, &model.Method{Name: name})
+		}
+		g := generator{}
+		err := g.Generate(&model.Package{Name: "foo", Interfaces: []*model.Interface{intf}}, "mock_foo", "")
+		return g.buf.String(), err
+	}
+
+	out, err := generate("Bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "func (m *MockFoo) Ctrl() *gomock.Controller {"; !strings.Contains(out, want) {
+		t.Errorf("generated code does not contain %q:\n%s", want, out)
+	}
+
+	if _, err := generate("Bar", "Ctrl"); err == nil || !strings.Contains(err.Error(), "interface Foo has a method Ctrl") {
+		t.Errorf("Generate() of an interface with a Ctrl method = %v, want a conflict error", err)
+	}
+}
+
+func TestGenerate_GoString(t *testing.T) {
+	defer func(prev bool) { *goString = prev }(*goString)
+	*goString = true
+	defer log.SetOutput(log.Writer())
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+
+	generate := func(methods ...string) string {
+		t.Helper()
+		intf := &model.Interface{Name: "Foo"}
+		for _, name := range methods {
+			intf.Methods = append(intf.Methods, &model.Method{Name: name, Out: []*model.Parameter{{Type: model.PredeclaredType("string")}}})
+		}
+		g := generator{}
+		if err := g.Generate(&model.Package{Name: "foo", Interfaces: []*model.Interface{intf}}, "mock_foo", ""); err != nil {
+			t.Fatal(err)
+		}
+		return g.buf.String()
+	}
+
+	out := generate("Bar")
+	if want := "func (m *MockFoo) GoString() string {\n\treturn \"MockFoo{}\"\n}"; !strings.Contains(out, want) {
+		t.Errorf("generated code does not contain %q:\n%s", want, out)
+	}
+	if logs.Len() != 0 {
+		t.Errorf("unexpected warning: %s", logs.String())
+	}
+
+	out = generate("Bar", "GoString")
+	if strings.Count(out, ") GoString() string {") != 1 || !strings.Contains(out, "// GoString mocks base method.") {
+		t.Errorf("generated code does not only mock GoString:\n%s", out)
+	}
+	if !strings.Contains(logs.String(), "interface Foo has a GoString method") {
+		t.Errorf("logs = %q, want a warning", logs.String())
+	}
+}
+
+func TestGenerate_LicenseSPDX(t *testing.T) {
+	g := generator{licenseSPDX: "Apache-2.0", copyrightHeader: "Copyright 2026 Example Authors", srcPackage: "example.com/foo", srcInterfaces: "Foo"}
+	if err := g.Generate(&model.Package{Name: "foo"}, "mock_foo", ""); err != nil {
+		t.Fatal(err)
+	}
+	want := `// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2026 Example Authors
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: example.com/foo (interfaces: Foo)
+`
+	if got := string(g.Output()); !strings.HasPrefix(got, want) {
+		t.Errorf("header =\n%s\nwant\n%s", got, want)
+	}
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "foo.go")
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/foo\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(src, []byte("package foo\n\ntype Foo interface{ Bar() }\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	defer func(prevSource, prevLicense string) {
+		*source, *licenseSPDX = prevSource, prevLicense
+	}(*source, *licenseSPDX)
+	*source = src
+	for _, license := range []string{" ", "Apache-2.0\n// injected"} {
+		*licenseSPDX = license
+		if err := generateMock(nil); err == nil || !strings.Contains(err.Error(), "bad -license_spdx") {
+			t.Errorf("generateMock() with -license_spdx=%q = %v, want an error", license, err)
+		}
+	}
+}
+
+func TestGenerate_UnexportedRecorder(t *testing.T) {
+	defer func(prevRecorder, prevExpect bool, prevTyped typedMode) {
+		*unexportedRecorder, *unexportedExpect, *typed = prevRecorder, prevExpect, prevTyped
+	}(*unexportedRecorder, *unexportedExpect, *typed)
+	*unexportedRecorder, *unexportedExpect, *typed = true, true, typedMonomorphic
+
+	pkg := &model.Package{
+		Name: "foo",
+		Interfaces: []*model.Interface{{
+			Name:    "Foo",
+			Methods: []*model.Method{{Name: "Bar"}},
+		}},
+	}
+	g := generator{}
+	if err := g.Generate(pkg, "foo", ""); err != nil {
+		t.Fatal(err)
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), "mock.go", g.Output(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Of the types, only the mock stays exported.
+	var exported []string
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.TypeSpec:
+			if n.Name.IsExported() {
+				exported = append(exported, n.Name.Name)
+			}
+		case *ast.FuncDecl:
+			if n.Name.Name == "EXPECT" {
+				exported = append(exported, "EXPECT")
+			}
+		}
+		return true
+	})
+	if want := []string{"MockFoo"}; !reflect.DeepEqual(exported, want) {
+		t.Errorf("exported types and EXPECT = %q, want %q", exported, want)
+	}
+}
+
+func TestGenerate_LocalPrefix(t *testing.T) {
+	pkg := &model.Package{
+		Name: "foo",
+		Interfaces: []*model.Interface{{
+			Name: "Foo",
+			Methods: []*model.Method{{
+				Name: "Bar",
+				In: []*model.Parameter{
+					{Name: "r", Type: &model.NamedType{Package: "io", Type: "Reader"}},
+					{Name: "f", Type: &model.PointerType{Type: &model.NamedType{Package: "golang.org/x/mod/modfile", Type: "File"}}},
+					{Name: "p", Type: &model.PointerType{Type: &model.NamedType{Package: "go.uber.org/mock/mockgen/model", Type: "Package"}}},
+				},
+			}},
+		}},
+	}
+	g := generator{localPrefix: "go.uber.org/mock/mockgen"}
+	if err := g.Generate(pkg, "mock_foo", ""); err != nil {
+		t.Fatal(err)
+	}
+	want := `import (
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	modfile "golang.org/x/mod/modfile"
+
+	model "go.uber.org/mock/mockgen/model"
+)
+`
+	if got := string(g.Output()); !strings.Contains(got, want) {
+		t.Errorf("imports of\n%s\nare not grouped as\n%s", got, want)
+	}
+}
+
+func TestGenerate_ImportAliases(t *testing.T) {
+	pkg := &model.Package{
+		Name: "foo",
+		Interfaces: []*model.Interface{{
+			Name: "Foo",
+			Methods: []*model.Method{{
+				Name: "Bar",
+				In: []*model.Parameter{
+					{Name: "a", Type: &model.PointerType{Type: &model.NamedType{Package: "github.com/foo/bar", Type: "A"}}},
+					{Name: "b", Type: &model.NamedType{Package: "github.com/baz/bar", Type: "B"}},
+				},
+				Out: []*model.Parameter{
+					{Type: &model.MapType{
+						Key:   &model.NamedType{Package: "github.com/foo/bar", Type: "K"},
+						Value: &model.NamedType{Package: "github.com/baz/bar", Type: "V"},
+					}},
+				},
+			}},
+		}},
+	}
+	// The forced alias of one package is the natural name of the other, which
+	// is then named around it.
+	g := generator{importAliases: map[string]string{"github.com/baz/bar": "bar", "github.com/foo/bar": "foobar"}}
+	if err := g.Generate(pkg, "mock_foo", ""); err != nil {
+		t.Fatal(err)
+	}
+	got := string(g.Output())
+	for _, want := range []string{
+		`bar "github.com/baz/bar"`,
+		`foobar "github.com/foo/bar"`,
+		`func (m *MockFoo) Bar(a *foobar.A, b bar.B) map[foobar.K]bar.V {`,
+		`ret0, _ := ret[0].(map[foobar.K]bar.V)`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated mock does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestGenerate_GoVersion(t *testing.T) {
+	defer func(prev typedMode) { *typed = prev }(*typed)
+
+	foo := &model.Interface{
+		Name: "Foo",
+		Methods: []*model.Method{{
+			Name:     "Bar",
+			In:       []*model.Parameter{{Name: "a", Type: model.PredeclaredType("any")}},
+			Variadic: &model.Parameter{Name: "rest", Type: model.PredeclaredType("any")},
+			Out:      []*model.Parameter{{Type: &model.MapType{Key: model.PredeclaredType("string"), Value: model.PredeclaredType("any")}}},
+		}, {
+			Name: "any",
+		}},
+	}
+	generic := &model.Interface{
+		Name:       "Generic",
+		TypeParams: []*model.Parameter{{Name: "T", Type: model.PredeclaredType("any")}},
+	}
+
+	for _, mode := range []typedMode{untyped, typedMonomorphic} {
+		*typed = mode
+		g := generator{goVersion: "v1.17"}
+		if err := g.Generate(&model.Package{Name: "foo", Interfaces: []*model.Interface{foo}}, "mock_foo", ""); err != nil {
+			t.Fatal(err)
+		}
+		src := g.Output()
+		file, err := parser.ParseFile(token.NewFileSet(), "mock_foo.go", src, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.SelectorExpr:
+				return false
+			case *ast.FuncDecl:
+				if n.Type.TypeParams != nil {
+					t.Errorf("-typed=%v: function %s has type parameters:\n%s", &mode, n.Name.Name, src)
+				}
+				ast.Inspect(n.Type, func(n ast.Node) bool {
+					if id, ok := n.(*ast.Ident); ok && id.Name == "any" {
+						t.Errorf("-typed=%v: the signature of a method uses any:\n%s", &mode, src)
+					}
+					return true
+				})
+				ast.Inspect(n.Body, func(n ast.Node) bool {
+					if id, ok := n.(*ast.Ident); ok && id.Name == "any" {
+						t.Errorf("-typed=%v: a method body uses any:\n%s", &mode, src)
+					}
+					_, sel := n.(*ast.SelectorExpr)
+					return !sel
+				})
+				return false
+			case *ast.TypeSpec:
+				if n.TypeParams != nil {
+					t.Errorf("-typed=%v: type %s has type parameters:\n%s", &mode, n.Name.Name, src)
+				}
+			}
+			return true
+		})
+		for _, want := range []string{
+			"func (m *MockFoo) Bar(a interface{}, rest ...interface{}) map[string]interface{} {",
+			"func (m *MockFoo) any() {",
+		} {
+			if !bytes.Contains(src, []byte(want)) {
+				t.Errorf("-typed=%v: generated code does not contain %q:\n%s", &mode, want, src)
+			}
+		}
+	}
+
+	*typed = untyped
+	g := generator{goVersion: "v1.17"}
+	err := g.Generate(&model.Package{Name: "foo", Interfaces: []*model.Interface{foo, generic}}, "mock_foo", "")
+	if want := "interface Generic is generic, which requires Go 1.18, but -go_version is 1.17"; err == nil || err.Error() != want {
+		t.Errorf("Generate() of a generic interface = %v, want %q", err, want)
+	}
+	*typed = typedGeneric
+	g = generator{goVersion: "v1.17"}
+	if err := g.Generate(&model.Package{Name: "foo", Interfaces: []*model.Interface{foo}}, "mock_foo", ""); err == nil {
+		t.Error("Generate() with -typed=generic succeeded")
+	}
+
+	*typed = untyped
+	g = generator{goVersion: "v1.18"}
+	if err := g.Generate(&model.Package{Name: "foo", Interfaces: []*model.Interface{foo, generic}}, "mock_foo", ""); err != nil {
+		t.Fatal(err)
+	}
+	if want := "func (m *MockFoo) Bar(a any, rest ...any) map[string]any {"; !bytes.Contains(g.Output(), []byte(want)) {
+		t.Errorf("generated code does not contain %q:\n%s", want, g.Output())
+	}
+}
+
+func TestGenerate_UseAny(t *testing.T) {
+	pkg := &model.Package{
+		Name: "foo",
+		Interfaces: []*model.Interface{{
+			Name: "Foo",
+			Methods: []*model.Method{{
+				Name: "Bar",
+				In:   []*model.Parameter{{Name: "a", Type: model.PredeclaredType("interface{}")}},
+				Out:  []*model.Parameter{{Type: &model.MapType{Key: model.PredeclaredType("string"), Value: model.PredeclaredType("any")}}},
+			}},
+		}},
+	}
+	for _, tt := range []struct {
+		useAny    useAnyMode
+		goVersion string
+		want      string
+	}{
+		{useAnyAuto, "", "Bar(a interface{}) map[string]any {"},
+		{useAnyAuto, "v1.17", "Bar(a interface{}) map[string]interface{} {"},
+		{useAnyAlways, "v1.18", "Bar(a any) map[string]any {"},
+		{useAnyNever, "", "Bar(a interface{}) map[string]interface{} {"},
+	} {
+		g := generator{useAny: tt.useAny, goVersion: tt.goVersion}
+		if err := g.Generate(pkg, "mock_foo", ""); err != nil {
+			t.Fatal(err)
+		}
+		if src := g.Output(); !bytes.Contains(src, []byte(tt.want)) {
+			t.Errorf("-use_any=%v -go_version=%s: generated code does not contain %q:\n%s", &tt.useAny, tt.goVersion, tt.want, src)
+		}
+	}
+
+	g := generator{useAny: useAnyAlways, goVersion: "v1.17"}
+	err := g.Generate(pkg, "mock_foo", "")
+	if want := "-use_any requires Go 1.18, but -go_version is 1.17"; err == nil || err.Error() != want {
+		t.Errorf("Generate() = %v, want %q", err, want)
+	}
+}
+
+func TestUseAnyFlag(t *testing.T) {
+	fs := flag.NewFlagSet("mockgen", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	var m useAnyMode
+	fs.Var(&m, "use_any", "")
+
+	for _, tt := range []struct {
+		args []string
+		want useAnyMode
+	}{
+		{nil, useAnyAuto},
+		{[]string{"-use_any"}, useAnyAlways},
+		{[]string{"-use_any=false"}, useAnyNever},
+		{[]string{"-use_any=auto"}, useAnyAuto},
+	} {
+		m = useAnyAuto
+		if err := fs.Parse(tt.args); err != nil {
+			t.Errorf("Parse(%q) failed: %v", tt.args, err)
+		} else if m != tt.want {
+			t.Errorf("Parse(%q) set %v, want %v", tt.args, &m, &tt.want)
+		}
+	}
+	if err := fs.Parse([]string{"-use_any=always"}); err == nil {
+		t.Error(`Parse("-use_any=always") succeeded`)
+	}
+}
+
+func TestParseGoVersion(t *testing.T) {
+	for _, tt := range []struct {
+		in, want string
+	}{
+		{"1.17", "v1.17"},
+		{"go1.17", "v1.17"},
+		{"1.21.3", "v1.21.3"},
+		{"1.21rc1", ""},
+		{"2.0", ""},
+		{"", ""},
+	} {
+		got, err := parseGoVersion(tt.in)
+		if got != tt.want || (err != nil) != (tt.want == "") {
+			t.Errorf("parseGoVersion(%q) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+// wideInterface returns a package with an interface of n methods that all
+// have the same signature.
+func wideInterface(n int) *model.Package {
+	intf := &model.Interface{Name: "Wide"}
+	for i := 0; i < n; i++ {
+		intf.Methods = append(intf.Methods, &model.Method{
+			Name: fmt.Sprintf("Get%d", i),
+			In:   []*model.Parameter{{Name: "key", Type: model.PredeclaredType("string")}},
+			Out: []*model.Parameter{
+				{Type: model.PredeclaredType("string")},
+				{Type: model.PredeclaredType("error")},
+			},
+		})
+	}
+	return &model.Package{Name: "foo", Interfaces: []*model.Interface{intf}}
+}
+
+func TestGenerate_Compact(t *testing.T) {
+	defer func(prev bool) { *compact = prev }(*compact)
+
+	var sizes [2]int
+	for i, c := range []bool{false, true} {
+		*compact = c
+		g := generator{}
+		if err := g.Generate(wideInterface(50), "mock_foo", ""); err != nil {
+			t.Fatal(err)
+		}
+		sizes[i] = len(g.Output())
+	}
+	t.Logf("mock of 50 methods: %d bytes, %d bytes with -compact", sizes[0], sizes[1])
+	if sizes[1] >= sizes[0]*9/10 {
+		t.Errorf("mock of 50 methods with -compact has %d bytes, want at least 10%% less than %d", sizes[1], sizes[0])
+	}
+
+	// A result list of a single method is not shared.
+	*compact = true
+	g := generator{}
+	if err := g.Generate(wideInterface(1), "mock_foo", ""); err != nil {
+		t.Fatal(err)
+	}
+	if got := string(g.Output()); strings.Contains(got, "results0") {
+		t.Errorf("mock of a single method has a results helper:\n%s", got)
+	}
+}
+
+func BenchmarkGenerate_Compact(b *testing.B) {
+	defer func(prev bool) { *compact = prev }(*compact)
+
+	for _, c := range []bool{false, true} {
+		*compact = c
+		b.Run(fmt.Sprintf("compact=%v", c), func(b *testing.B) {
+			var size int
+			for i := 0; i < b.N; i++ {
+				g := generator{}
+				if err := g.Generate(wideInterface(50), "mock_foo", ""); err != nil {
+					b.Fatal(err)
+				}
+				size = len(g.Output())
+			}
+			b.ReportMetric(float64(size), "bytes/file")
+		})
+	}
+}
@@ -18,8 +18,10 @@ package model
 import (
 	"encoding/gob"
 	"fmt"
+	"go/token"
 	"io"
 	"reflect"
+	"strconv"
 	"strings"
 )
 
@@ -32,6 +34,9 @@ type Package struct {
 	PkgPath    string
 	Interfaces []*Interface
 	DotImports []string
+	// BuildConstraint is the //go:build expression the interfaces are
+	// declared under, if any.
+	BuildConstraint string
 }
 
 // Print writes the package name and its exported interfaces.
@@ -152,9 +157,12 @@ func init() {
 	gob.RegisterName(pkgPath+".ArrayType", &ArrayType{})
 	gob.RegisterName(pkgPath+".ChanType", &ChanType{})
 	gob.RegisterName(pkgPath+".FuncType", &FuncType{})
+	gob.RegisterName(pkgPath+".InterfaceType", &InterfaceType{})
 	gob.RegisterName(pkgPath+".MapType", &MapType{})
 	gob.RegisterName(pkgPath+".NamedType", &NamedType{})
 	gob.RegisterName(pkgPath+".PointerType", &PointerType{})
+	gob.RegisterName(pkgPath+".StructType", &StructType{})
+	gob.RegisterName(pkgPath+".UnionType", &UnionType{})
 
 	// Call gob.RegisterName to make sure it has the consistent name registered
 	// for both gob decoder and encoder.
@@ -170,17 +178,40 @@ func init() {
 type ArrayType struct {
 	Len  int // -1 for slices, >= 0 for arrays
 	Type Type
+
+	// LenConst is the name of the constant the length of an array is
+	// declared with, if any, and LenPackage is the package declaring it.
+	// Only source mode knows them.
+	LenConst, LenPackage string
 }
 
 func (at *ArrayType) String(pm map[string]string, pkgOverride string) string {
 	s := "[]"
 	if at.Len > -1 {
-		s = fmt.Sprintf("[%d]", at.Len)
+		s = "[" + at.lenString(pm, pkgOverride) + "]"
 	}
 	return s + at.Type.String(pm, pkgOverride)
 }
 
-func (at *ArrayType) addImports(im map[string]bool) { at.Type.addImports(im) }
+// lenString returns the constant the array length is declared with, or the
+// length if there is none or it is unexported from another package.
+func (at *ArrayType) lenString(pm map[string]string, pkgOverride string) string {
+	switch {
+	case at.LenConst == "":
+	case at.LenPackage == pkgOverride:
+		return at.LenConst
+	case token.IsExported(at.LenConst) && pm[at.LenPackage] != "":
+		return pm[at.LenPackage] + "." + at.LenConst
+	}
+	return strconv.Itoa(at.Len)
+}
+
+func (at *ArrayType) addImports(im map[string]bool) {
+	if at.LenConst != "" && token.IsExported(at.LenConst) {
+		im[at.LenPackage] = true
+	}
+	at.Type.addImports(im)
+}
 
 // ChanType is a channel type.
 type ChanType struct {
@@ -196,6 +227,10 @@ func (ct *ChanType) String(pm map[string]string, pkgOverride string) string {
 	if ct.Dir == SendDir {
 		return "chan<- " + s
 	}
+	if elem, ok := ct.Type.(*ChanType); ok && elem.Dir == RecvDir {
+		// chan <-chan T would be a send-only channel of chan T.
+		return "chan (" + s + ")"
+	}
 	return "chan " + s
 }
 
@@ -249,6 +284,103 @@ func (ft *FuncType) addImports(im map[string]bool) {
 	}
 }
 
+// InterfaceType is an unnamed interface type with methods, such as
+// interface{ Read([]byte) (int, error) }. Empty interfaces are "any".
+type InterfaceType struct {
+	Embedded []Type // may be empty
+	Methods  []*Method
+}
+
+func (it *InterfaceType) String(pm map[string]string, pkgOverride string) string {
+	elems := make([]string, 0, len(it.Embedded)+len(it.Methods))
+	for _, e := range it.Embedded {
+		elems = append(elems, e.String(pm, pkgOverride))
+	}
+	for _, m := range it.Methods {
+		ft := &FuncType{In: m.In, Out: m.Out, Variadic: m.Variadic}
+		elems = append(elems, m.Name+strings.TrimPrefix(ft.String(pm, pkgOverride), "func"))
+	}
+	if len(elems) == 0 {
+		return "any"
+	}
+	return "interface{ " + strings.Join(elems, "; ") + " }"
+}
+
+func (it *InterfaceType) addImports(im map[string]bool) {
+	for _, e := range it.Embedded {
+		e.addImports(im)
+	}
+	for _, m := range it.Methods {
+		m.addImports(im)
+	}
+}
+
+// StructType is an unnamed struct type with fields, such as
+// struct{ X int; Y string }. Empty structs are "struct{}".
+type StructType struct {
+	Fields []*Field
+}
+
+// Field is a field of a StructType.
+type Field struct {
+	Name string // "" for an embedded field
+	Type Type
+	Tag  string // may be empty
+}
+
+func (st *StructType) String(pm map[string]string, pkgOverride string) string {
+	fields := make([]string, len(st.Fields))
+	for i, f := range st.Fields {
+		fields[i] = f.Type.String(pm, pkgOverride)
+		if f.Name != "" {
+			fields[i] = f.Name + " " + fields[i]
+		}
+		if f.Tag != "" {
+			fields[i] += " " + strconv.Quote(f.Tag)
+		}
+	}
+	if len(fields) == 0 {
+		return "struct{}"
+	}
+	return "struct{ " + strings.Join(fields, "; ") + " }"
+}
+
+func (st *StructType) addImports(im map[string]bool) {
+	for _, f := range st.Fields {
+		f.Type.addImports(im)
+	}
+}
+
+// UnionType is a union of type terms, such as ~int | ~string, which only
+// appears in the constraint of a type parameter.
+type UnionType struct {
+	Terms []*Term
+}
+
+// Term is a term of a union type. With Tilde, it stands for all the types
+// whose underlying type is Type.
+type Term struct {
+	Tilde bool
+	Type  Type
+}
+
+func (ut *UnionType) String(pm map[string]string, pkgOverride string) string {
+	terms := make([]string, len(ut.Terms))
+	for i, t := range ut.Terms {
+		terms[i] = t.Type.String(pm, pkgOverride)
+		if t.Tilde {
+			terms[i] = "~" + terms[i]
+		}
+	}
+	return strings.Join(terms, " | ")
+}
+
+func (ut *UnionType) addImports(im map[string]bool) {
+	for _, t := range ut.Terms {
+		t.Type.addImports(im)
+	}
+}
+
 // MapType is a map type.
 type MapType struct {
 	Key, Value Type
@@ -415,9 +547,14 @@ func typeFromType(t reflect.Type) (Type, error) {
 	}
 
 	if imp := t.PkgPath(); imp != "" {
+		name, tps, err := splitInstanceName(t.Name())
+		if err != nil {
+			return nil, fmt.Errorf("can't yet turn %v into a model.Type: %v", t, err)
+		}
 		return &NamedType{
-			Package: impPath(imp),
-			Type:    t.Name(),
+			Package:    impPath(imp),
+			Type:       name,
+			TypeParams: tps,
 		}, nil
 	}
 
@@ -474,6 +611,21 @@ func typeFromType(t reflect.Type) (Type, error) {
 		if t == errorType {
 			return PredeclaredType("error"), nil
 		}
+		// Reflection only knows the method set of the interface, so
+		// embedded interfaces are flattened into their methods.
+		it := &InterfaceType{}
+		for i := 0; i < t.NumMethod(); i++ {
+			m := t.Method(i)
+			if m.PkgPath != "" {
+				return nil, fmt.Errorf("can't yet turn %v into a model.Type: unexported method %v", t, m.Name)
+			}
+			in, variadic, out, err := funcArgsFromType(m.Type)
+			if err != nil {
+				return nil, err
+			}
+			it.Methods = append(it.Methods, &Method{Name: m.Name, In: in, Variadic: variadic, Out: out})
+		}
+		return it, nil
 	case reflect.Map:
 		kt, err := typeFromType(t.Key())
 		if err != nil {
@@ -496,12 +648,173 @@ func typeFromType(t reflect.Type) (Type, error) {
 		if t.NumField() == 0 {
 			return PredeclaredType("struct{}"), nil
 		}
+		st := &StructType{}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			ft, err := typeFromType(f.Type)
+			if err != nil {
+				return nil, err
+			}
+			name := f.Name
+			if f.Anonymous {
+				name = ""
+			}
+			st.Fields = append(st.Fields, &Field{Name: name, Type: ft, Tag: string(f.Tag)})
+		}
+		return st, nil
 	}
 
-	// TODO: Struct, UnsafePointer
 	return nil, fmt.Errorf("can't yet turn %v (%v) into a model.Type", t, t.Kind())
 }
 
+// splitInstanceName splits the name reflect gives an instantiated generic
+// type, such as "Seq[example.com/pkg.Item]", into the name of the generic type
+// and its type arguments, whose packages reflect spells as full import paths.
+func splitInstanceName(name string) (string, *TypeParametersType, error) {
+	i := strings.IndexByte(name, '[')
+	if i == -1 {
+		return name, nil, nil
+	}
+	if !strings.HasSuffix(name, "]") {
+		return "", nil, fmt.Errorf("malformed type arguments in %q", name)
+	}
+	tps := &TypeParametersType{}
+	for _, arg := range splitTypeArgs(name[i+1 : len(name)-1]) {
+		t, err := typeFromName(arg)
+		if err != nil {
+			return "", nil, err
+		}
+		tps.TypeParameters = append(tps.TypeParameters, t)
+	}
+	return name[:i], tps, nil
+}
+
+// splitTypeArgs splits a list of type arguments at the commas outside of
+// brackets and parentheses.
+func splitTypeArgs(s string) []string {
+	var args []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '[', '(', '{':
+			depth++
+		case ']', ')', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(args, strings.TrimSpace(s[start:]))
+}
+
+// typeFromName parses a type argument as spelled by reflect. Only predeclared
+// and named types and pointers, slices, arrays and maps of them are supported.
+func typeFromName(s string) (Type, error) {
+	switch {
+	case strings.HasPrefix(s, "*"):
+		elem, err := typeFromName(s[1:])
+		if err != nil {
+			return nil, err
+		}
+		return &PointerType{Type: elem}, nil
+	case strings.HasPrefix(s, "[]"):
+		elem, err := typeFromName(s[2:])
+		if err != nil {
+			return nil, err
+		}
+		return &ArrayType{Len: -1, Type: elem}, nil
+	case strings.HasPrefix(s, "["):
+		end := strings.IndexByte(s, ']')
+		if end == -1 {
+			return nil, fmt.Errorf("unsupported type argument %q", s)
+		}
+		n, err := strconv.Atoi(s[1:end])
+		if err != nil {
+			return nil, fmt.Errorf("unsupported type argument %q", s)
+		}
+		elem, err := typeFromName(s[end+1:])
+		if err != nil {
+			return nil, err
+		}
+		return &ArrayType{Len: n, Type: elem}, nil
+	case strings.HasPrefix(s, "map["):
+		end := closingBracket(s, len("map"))
+		if end == -1 {
+			return nil, fmt.Errorf("unsupported type argument %q", s)
+		}
+		key, err := typeFromName(s[len("map["):end])
+		if err != nil {
+			return nil, err
+		}
+		value, err := typeFromName(s[end+1:])
+		if err != nil {
+			return nil, err
+		}
+		return &MapType{Key: key, Value: value}, nil
+	case strings.HasPrefix(s, "chan "), strings.HasPrefix(s, "chan<- "), strings.HasPrefix(s, "<-chan "):
+		dir, elem := ChanDir(0), strings.TrimPrefix(s, "chan ")
+		if strings.HasPrefix(s, "chan<- ") {
+			dir, elem = SendDir, strings.TrimPrefix(s, "chan<- ")
+		} else if strings.HasPrefix(s, "<-chan ") {
+			dir, elem = RecvDir, strings.TrimPrefix(s, "<-chan ")
+		}
+		// reflect parenthesizes receive-only element types of bidirectional
+		// channels, as in "chan (<-chan int)".
+		if strings.HasPrefix(elem, "(") && strings.HasSuffix(elem, ")") {
+			elem = elem[1 : len(elem)-1]
+		}
+		t, err := typeFromName(elem)
+		if err != nil {
+			return nil, err
+		}
+		return &ChanType{Dir: dir, Type: t}, nil
+	case s == "interface {}":
+		return PredeclaredType("any"), nil
+	}
+
+	base := s
+	if i := strings.IndexByte(s, '['); i != -1 {
+		base = s[:i]
+	}
+	if strings.ContainsAny(base, " ({") {
+		return nil, fmt.Errorf("unsupported type argument %q", s)
+	}
+	dot := strings.LastIndexByte(base, '.')
+	if dot == -1 {
+		return PredeclaredType(s), nil
+	}
+	name, tps, err := splitInstanceName(s[dot+1:])
+	if err != nil {
+		return nil, err
+	}
+	return &NamedType{
+		Package:    impPath(s[:dot]),
+		Type:       name,
+		TypeParams: tps,
+	}, nil
+}
+
+// closingBracket returns the index of the bracket closing the one at s[open],
+// or -1.
+func closingBracket(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
 // impPath sanitizes the package path returned by `PkgPath` method of a reflect Type so that
 // it is importable. PkgPath might return a path that includes "vendor". These paths do not
 // compile, so we need to remove everything up to and including "/vendor/".
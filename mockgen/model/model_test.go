@@ -2,6 +2,11 @@ package model
 
 import (
 	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 )
 
@@ -34,3 +39,94 @@ func TestImpPath(t *testing.T) {
 		})
 	}
 }
+
+func TestInterfaceTypeFromType(t *testing.T) {
+	var reader interface {
+		io.Closer
+		Read(p []byte) (int, error)
+	}
+	typ, err := typeFromType(reflect.TypeOf(&reader).Elem())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pm := map[string]string{}
+	want := "interface{ Close() error; Read([]byte) (int, error) }"
+	if got := typ.String(pm, ""); got != want {
+		t.Errorf("got %s; want %s", got, want)
+	}
+}
+
+func TestStructTypeFromType(t *testing.T) {
+	var v struct {
+		X, Y int `mock:"xy"`
+		io.Reader
+		Inner struct{ Items []struct{ B byte } }
+	}
+	typ, err := typeFromType(reflect.TypeOf(v))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pm := map[string]string{"io": "io"}
+	want := `struct{ X int "mock:\"xy\""; Y int "mock:\"xy\""; io.Reader; Inner struct{ Items []struct{ B byte } } }`
+	if got := typ.String(pm, ""); got != want {
+		t.Errorf("got %s; want %s", got, want)
+	}
+}
+
+func TestNamedCompositeTypeFromType(t *testing.T) {
+	for _, tc := range []struct {
+		typ  reflect.Type
+		want string
+	}{
+		{reflect.TypeOf(http.Header{}), "http.Header"},
+		{reflect.TypeOf(sort.StringSlice{}), "sort.StringSlice"},
+		{reflect.TypeOf([]http.Header{}), "[]http.Header"},
+		{reflect.TypeOf(map[string]sort.StringSlice{}), "map[string]sort.StringSlice"},
+	} {
+		typ, err := typeFromType(tc.typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pm := map[string]string{"net/http": "http", "sort": "sort"}
+		if got := typ.String(pm, ""); got != tc.want {
+			t.Errorf("got %s; want %s", got, tc.want)
+		}
+	}
+}
+
+type pair[K comparable, V any] struct{}
+
+func TestNamedTypeFromInstance(t *testing.T) {
+	for _, tc := range []struct {
+		typ  reflect.Type
+		want string
+	}{
+		{reflect.TypeOf(pair[string, int]{}), "model.pair[string, int]"},
+		{reflect.TypeOf(pair[string, *io.SectionReader]{}), "model.pair[string, *io.SectionReader]"},
+		{reflect.TypeOf(pair[[2]int, map[string][]io.Reader]{}), "model.pair[[2]int, map[string][]io.Reader]"},
+		{reflect.TypeOf(pair[int, pair[string, any]]{}), "model.pair[int, model.pair[string, any]]"},
+		{reflect.TypeOf(pair[chan<- pair[string, io.Reader], <-chan int]{}), "model.pair[chan<- model.pair[string, io.Reader], <-chan int]"},
+		{reflect.TypeOf(pair[chan (<-chan int), int]{}), "model.pair[chan (<-chan int), int]"},
+	} {
+		typ, err := typeFromType(tc.typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pm := map[string]string{"io": "io", pkgPath: "model"}
+		if got := typ.String(pm, ""); got != tc.want {
+			t.Errorf("got %s; want %s", got, tc.want)
+		}
+		im := map[string]bool{}
+		typ.addImports(im)
+		if !im[pkgPath] || strings.Contains(tc.want, "io.") != im["io"] {
+			t.Errorf("imports of %s = %v", tc.want, im)
+		}
+	}
+}
+
+func TestNamedTypeFromInstanceUnsupported(t *testing.T) {
+	_, err := typeFromType(reflect.TypeOf(pair[string, func()]{}))
+	if err == nil {
+		t.Fatal("got no error for a func type argument")
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"go/ast"
 	"go/build"
+	"go/build/constraint"
 	"go/importer"
 	"go/parser"
 	"go/token"
@@ -42,15 +43,57 @@ func sourceMode(source string) (*model.Package, error) {
 		return nil, fmt.Errorf("failed getting source directory: %v", err)
 	}
 
-	packageImport, err := parsePackageImport(srcDir)
+	fs := token.NewFileSet()
+	file, err := parser.ParseFile(fs, source, nil, 0)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed parsing source file %v: %v", source, err)
+	}
+	return parseSource(fs, srcDir, source, file, nil)
+}
+
+// sourceDirMode generates mocks via the Go files of the package in dir, which
+// are merged into a single file, so that interfaces may embed interfaces of
+// other files of the package. The files are those the go command builds the
+// package from, and with -include_tests its _test.go files of the same
+// package.
+func sourceDirMode(dir string) (*model.Package, error) {
+	srcDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting source directory: %v", err)
+	}
+	imp, err := build.ImportDir(srcDir, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading source directory %v: %v", dir, err)
+	}
+	names := append(imp.GoFiles, imp.CgoFiles...)
+	if *includeTests {
+		names = append(names, imp.TestGoFiles...)
 	}
 
 	fs := token.NewFileSet()
-	file, err := parser.ParseFile(fs, source, nil, 0)
+	files := make(map[string]*ast.File, len(names))
+	for _, name := range names {
+		filename := filepath.Join(dir, name)
+		file, err := parser.ParseFile(fs, filename, nil, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing source file %v: %v", filename, err)
+		}
+		files[filename] = file
+	}
+	file := ast.MergePackageFiles(&ast.Package{Name: imp.Name, Files: files}, ast.FilterFuncDuplicates|ast.FilterUnassociatedComments|ast.FilterImportDuplicates)
+	merged := make([]*ast.File, 0, len(files))
+	for _, f := range files {
+		merged = append(merged, f)
+	}
+	return parseSource(fs, srcDir, dir, file, merged)
+}
+
+// parseSource returns the model of the interfaces declared in file, which was
+// parsed from source in srcDir, merging the given files if there are any.
+func parseSource(fs *token.FileSet, srcDir, source string, file *ast.File, files []*ast.File) (*model.Package, error) {
+	packageImport, err := parsePackageImport(srcDir)
 	if err != nil {
-		return nil, fmt.Errorf("failed parsing source file %v: %v", source, err)
+		return nil, err
 	}
 
 	p := &fileParser{
@@ -59,16 +102,17 @@ func sourceMode(source string) (*model.Package, error) {
 		importedInterfaces: newInterfaceCache(),
 		auxInterfaces:      newInterfaceCache(),
 		srcDir:             srcDir,
+		constraints:        newBuildConstraints(),
+		files:              files,
 	}
 
 	// Handle -imports.
-	dotImports := make(map[string]bool)
 	if *imports != "" {
 		for _, kv := range strings.Split(*imports, ",") {
 			eq := strings.Index(kv, "=")
 			k, v := kv[:eq], kv[eq+1:]
 			if k == "." {
-				dotImports[v] = true
+				p.dotImports = append(p.dotImports, v)
 			} else {
 				p.imports[k] = importedPkg{path: v}
 			}
@@ -79,6 +123,16 @@ func sourceMode(source string) (*model.Package, error) {
 		p.excludeNamesSet = parseExcludeInterfaces(*excludeInterfaces)
 	}
 
+	// Handle -at_line and -at_offset.
+	if *atLine > 0 && *atOffset >= 0 {
+		return nil, errors.New("-at_line and -at_offset are mutually exclusive")
+	}
+	if *atLine > 0 || *atOffset >= 0 {
+		if p.onlyInterface, err = interfaceAt(fs, file, *atLine, *atOffset); err != nil {
+			return nil, err
+		}
+	}
+
 	// Handle -aux_files.
 	if err := p.parseAuxFiles(*auxFiles); err != nil {
 		return nil, err
@@ -89,9 +143,10 @@ func sourceMode(source string) (*model.Package, error) {
 	if err != nil {
 		return nil, err
 	}
-	for pkgPath := range dotImports {
-		pkg.DotImports = append(pkg.DotImports, pkgPath)
+	if p.onlyInterface != "" && len(pkg.Interfaces) == 0 {
+		return nil, fmt.Errorf("interface %s of %s cannot be mocked", p.onlyInterface, source)
 	}
+	pkg.BuildConstraint = p.constraints.String()
 	return pkg, nil
 }
 
@@ -168,6 +223,42 @@ type fileParser struct {
 	auxInterfaces      *interfaceCache
 	srcDir             string
 	excludeNamesSet    map[string]struct{}
+	onlyInterface      string            // if set, the only interface of the file to mock
+	constraints        *buildConstraints // shared with the parsers of imported packages
+
+	dotImports     []string          // import paths of the dot imports
+	dotImportTypes map[string]string // exported type name => dot-imported package
+
+	files       []*ast.File                              // the files merged into the parsed one, with -source_dir
+	fileImports map[*ast.File]map[string]importedPackage // the imports of files, loaded by importScope
+
+	constImporter types.ImporterFrom // type-checks the packages of array length constants
+}
+
+// importScope returns the imports to resolve the package name at pos with:
+// those of the merged file containing pos if the merged files import
+// different packages as name, and otherwise those of the parsed file.
+func (p *fileParser) importScope(name string, pos token.Pos) map[string]importedPackage {
+	if _, ok := p.imports[name].(duplicateImport); !ok {
+		return p.imports
+	}
+	for _, f := range p.files {
+		if pos < f.Pos() || pos > f.End() {
+			continue
+		}
+		imports, ok := p.fileImports[f]
+		if !ok {
+			imports, _ = importsOfFile(f)
+			if p.fileImports == nil {
+				p.fileImports = make(map[*ast.File]map[string]importedPackage)
+			}
+			p.fileImports[f] = imports
+		}
+		if _, ok := imports[name]; ok {
+			return imports
+		}
+	}
+	return p.imports
 }
 
 func (p *fileParser) errorf(pos token.Pos, format string, args ...any) error {
@@ -215,6 +306,8 @@ func (p *fileParser) parseFile(importPath string, file *ast.File) (*model.Packag
 			p.imports[pkg] = pkgI
 		}
 	}
+	p.dotImports = append(p.dotImports, dotImports...)
+	unresolvedDotImports := p.loadDotImportTypes()
 	// Add imports from auxiliary files, which might be needed for embedded interfaces.
 	// Don't stomp any other imports.
 	for _, f := range p.auxFiles {
@@ -231,6 +324,15 @@ func (p *fileParser) parseFile(importPath string, file *ast.File) (*model.Packag
 		if _, ok := p.excludeNamesSet[ni.name.String()]; ok {
 			continue
 		}
+		if p.onlyInterface != "" && ni.name.String() != p.onlyInterface {
+			continue
+		}
+		// The mock is restricted to the build constraints of the files
+		// declaring the mocked interfaces, which are recorded before those
+		// of the interfaces they embed.
+		if _, err := p.constraints.add(p.fileSet.Position(ni.name.Pos()).Filename); err != nil {
+			return nil, err
+		}
 		i, err := p.parseInterface(ni.name.String(), importPath, ni)
 		if errors.Is(err, errConstraintInterface) {
 			continue
@@ -244,10 +346,64 @@ func (p *fileParser) parseFile(importPath string, file *ast.File) (*model.Packag
 		Name:       file.Name.String(),
 		PkgPath:    importPath,
 		Interfaces: is,
-		DotImports: dotImports,
+		DotImports: unresolvedDotImports,
 	}, nil
 }
 
+// loadDotImportTypes records the exported types of the dot-imported packages,
+// so that the types they name unqualified are qualified in the mock. It
+// returns the dot imports whose packages couldn't be loaded, which the mock
+// dot-imports as well.
+func (p *fileParser) loadDotImportTypes() (unresolved []string) {
+	p.dotImportTypes = make(map[string]string)
+	seen := make(map[string]bool)
+	for _, importPath := range p.dotImports {
+		if seen[importPath] {
+			continue
+		}
+		seen[importPath] = true
+		types, err := exportedTypes(importPath, p.srcDir)
+		if err != nil {
+			log.Printf("Warning: failed loading dot-imported package %s, its types are left unqualified: %v", importPath, err)
+			unresolved = append(unresolved, importPath)
+			continue
+		}
+		for _, name := range types {
+			p.dotImportTypes[name] = importPath
+		}
+	}
+	return unresolved
+}
+
+// exportedTypes returns the names of the exported types declared in the
+// package at importPath.
+func exportedTypes(importPath, srcDir string) ([]string, error) {
+	imp, err := build.Import(importPath, srcDir, 0)
+	if err != nil {
+		return nil, err
+	}
+	fs := token.NewFileSet()
+	var names []string
+	for _, name := range imp.GoFiles {
+		file, err := parser.ParseFile(fs, filepath.Join(imp.Dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				if ts := spec.(*ast.TypeSpec); ts.Name.IsExported() {
+					names = append(names, ts.Name.Name)
+				}
+			}
+		}
+	}
+	return names, nil
+}
+
 // parsePackage loads package specified by path, parses it and returns
 // a new fileParser with the parsed imports and interfaces.
 func (p *fileParser) parsePackage(path string) (*fileParser, error) {
@@ -257,6 +413,7 @@ func (p *fileParser) parsePackage(path string) (*fileParser, error) {
 		importedInterfaces: newInterfaceCache(),
 		auxInterfaces:      newInterfaceCache(),
 		srcDir:             p.srcDir,
+		constraints:        p.constraints,
 	}
 
 	var pkgs map[string]*ast.Package
@@ -271,11 +428,13 @@ func (p *fileParser) parsePackage(path string) (*fileParser, error) {
 		for ni := range iterInterfaces(file) {
 			newP.importedInterfaces.Set(path, ni.name.Name, ni)
 		}
-		imports, _ := importsOfFile(file)
+		imports, dotImports := importsOfFile(file)
 		for pkgName, pkgI := range imports {
 			newP.imports[pkgName] = pkgI
 		}
+		newP.dotImports = append(newP.dotImports, dotImports...)
 	}
+	newP.loadDotImportTypes()
 	return newP, nil
 }
 
@@ -331,22 +490,50 @@ func (p *fileParser) parseInterface(name, pkg string, it *namedInterface) (*mode
 	tps := p.constructTps(it)
 	tp, err := p.parseFieldList(pkg, it.typeParams, tps)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse interface type parameters: %v", name)
+		return nil, fmt.Errorf("unable to parse interface type parameters of %v: %v", name, err)
 	}
 
 	iface.TypeParams = tp
+	sources := make(map[string]string) // method name => where it was declared
 	for _, field := range it.it.Methods.List {
 		var methods []*model.Method
 		if methods, err = p.parseMethod(field, it, iface, pkg, tps); err != nil {
 			return nil, err
 		}
+		source := name
+		if _, ok := field.Type.(*ast.FuncType); !ok {
+			source = types.ExprString(field.Type)
+		}
 		for _, m := range methods {
+			// Methods promoted from several embedded interfaces are allowed
+			// as long as their signatures are identical.
+			if prev, ok := sources[m.Name]; ok {
+				for _, me := range iface.Methods {
+					if me.Name == m.Name && methodSignature(me) != methodSignature(m) {
+						return nil, p.errorf(field.Pos(), "interface %s has conflicting definitions of method %s from %s and %s", name, m.Name, prev, source)
+					}
+				}
+				continue
+			}
+			sources[m.Name] = source
 			iface.AddMethod(m)
 		}
 	}
 	return iface, nil
 }
 
+// methodSignature returns the signature of m with all named types qualified
+// by their full import path, so that signatures can be compared.
+func methodSignature(m *model.Method) string {
+	pkg := &model.Package{Interfaces: []*model.Interface{{Methods: []*model.Method{m}}}}
+	pm := make(map[string]string)
+	for pkgPath := range pkg.Imports() {
+		pm[pkgPath] = strconv.Quote(pkgPath)
+	}
+	ft := &model.FuncType{In: m.In, Out: m.Out, Variadic: m.Variadic}
+	return ft.String(pm, "")
+}
+
 func (p *fileParser) parseMethod(field *ast.Field, it *namedInterface, iface *model.Interface, pkg string, tps map[string]model.Type) ([]*model.Method, error) {
 	// {} for git diff
 	{
@@ -382,7 +569,7 @@ func (p *fileParser) parseMethod(field *ast.Field, it *namedInterface, iface *mo
 				if err != nil {
 					return nil, err
 				}
-
+				p.addEmbeddedConstraint(v.String(), embeddedIfaceType)
 			} else {
 				// This is built-in error interface.
 				if v.String() == model.ErrorInterface.Name {
@@ -405,13 +592,14 @@ func (p *fileParser) parseMethod(field *ast.Field, it *namedInterface, iface *mo
 					if err != nil {
 						return nil, err
 					}
+					ip.addEmbeddedConstraint(v.String(), embeddedIfaceType)
 				}
 			}
 			return embeddedIface.Methods, nil
 		case *ast.SelectorExpr:
 			// Embedded interface in another package.
 			filePkg, sel := v.X.(*ast.Ident).String(), v.Sel.String()
-			embeddedPkg, ok := p.imports[filePkg]
+			embeddedPkg, ok := p.importScope(filePkg, v.X.Pos())[filePkg]
 			if !ok {
 				return nil, p.errorf(v.X.Pos(), "unknown package %s", filePkg)
 			}
@@ -428,6 +616,7 @@ func (p *fileParser) parseMethod(field *ast.Field, it *namedInterface, iface *mo
 				if err != nil {
 					return nil, err
 				}
+				p.addEmbeddedConstraint(filePkg+"."+sel, embeddedIfaceType)
 			} else {
 				path := embeddedPkg.Path()
 				parser := embeddedPkg.Parser()
@@ -437,7 +626,7 @@ func (p *fileParser) parseMethod(field *ast.Field, it *namedInterface, iface *mo
 						return nil, p.errorf(v.Pos(), "could not parse package %s: %v", path, err)
 					}
 					parser = ip
-					p.imports[filePkg] = importedPkg{
+					p.importScope(filePkg, v.X.Pos())[filePkg] = importedPkg{
 						path:   embeddedPkg.Path(),
 						parser: parser,
 					}
@@ -454,6 +643,7 @@ func (p *fileParser) parseMethod(field *ast.Field, it *namedInterface, iface *mo
 				if err != nil {
 					return nil, err
 				}
+				parser.addEmbeddedConstraint(filePkg+"."+sel, embeddedIfaceType)
 			}
 			// TODO: apply shadowing rules.
 			return embeddedIface.Methods, nil
@@ -528,8 +718,9 @@ func (p *fileParser) parseType(pkg string, typ ast.Expr, tps map[string]model.Ty
 	switch v := typ.(type) {
 	case *ast.ArrayType:
 		ln := -1
+		var lenConst, lenPackage string
 		if v.Len != nil {
-			value, err := p.parseArrayLength(v.Len)
+			value, err := p.parseArrayLength(pkg, v.Len)
 			if err != nil {
 				return nil, err
 			}
@@ -537,12 +728,13 @@ func (p *fileParser) parseType(pkg string, typ ast.Expr, tps map[string]model.Ty
 			if err != nil {
 				return nil, p.errorf(v.Len.Pos(), "bad array size: %v", err)
 			}
+			lenConst, lenPackage = p.arrayLengthConst(pkg, v.Len)
 		}
 		t, err := p.parseType(pkg, v.Elt, tps)
 		if err != nil {
 			return nil, err
 		}
-		return &model.ArrayType{Len: ln, Type: t}, nil
+		return &model.ArrayType{Len: ln, Type: t, LenConst: lenConst, LenPackage: lenPackage}, nil
 	case *ast.ChanType:
 		t, err := p.parseType(pkg, v.Value, tps)
 		if err != nil {
@@ -568,6 +760,10 @@ func (p *fileParser) parseType(pkg string, typ ast.Expr, tps map[string]model.Ty
 	case *ast.Ident:
 		it, ok := tps[v.Name]
 		if v.IsExported() && !ok {
+			// Types declared in other files may come from a dot import.
+			if dotPkg, ok := p.dotImportTypes[v.Name]; ok && v.Obj == nil {
+				return &model.NamedType{Package: dotPkg, Type: v.Name}, nil
+			}
 			// `pkg` may be an aliased imported pkg
 			// if so, patch the import w/ the fully qualified import
 			maybeImportedPkg, ok := p.imports[pkg]
@@ -583,10 +779,11 @@ func (p *fileParser) parseType(pkg string, typ ast.Expr, tps map[string]model.Ty
 		// assume predeclared type
 		return model.PredeclaredType(v.Name), nil
 	case *ast.InterfaceType:
-		if v.Methods != nil && len(v.Methods.List) > 0 {
-			return nil, p.errorf(v.Pos(), "can't handle non-empty unnamed interface types")
+		if v.Methods == nil || len(v.Methods.List) == 0 {
+			// As spelled in the source, which -use_any may override.
+			return model.PredeclaredType("interface{}"), nil
 		}
-		return model.PredeclaredType("any"), nil
+		return p.parseInterfaceType(pkg, v, tps)
 	case *ast.MapType:
 		key, err := p.parseType(pkg, v.Key, tps)
 		if err != nil {
@@ -599,7 +796,7 @@ func (p *fileParser) parseType(pkg string, typ ast.Expr, tps map[string]model.Ty
 		return &model.MapType{Key: key, Value: value}, nil
 	case *ast.SelectorExpr:
 		pkgName := v.X.(*ast.Ident).String()
-		pkg, ok := p.imports[pkgName]
+		pkg, ok := p.importScope(pkgName, v.Pos())[pkgName]
 		if !ok {
 			return nil, p.errorf(v.Pos(), "unknown package %q", pkgName)
 		}
@@ -611,12 +808,14 @@ func (p *fileParser) parseType(pkg string, typ ast.Expr, tps map[string]model.Ty
 		}
 		return &model.PointerType{Type: t}, nil
 	case *ast.StructType:
-		if v.Fields != nil && len(v.Fields.List) > 0 {
-			return nil, p.errorf(v.Pos(), "can't handle non-empty unnamed struct types")
+		if v.Fields == nil || len(v.Fields.List) == 0 {
+			return model.PredeclaredType("struct{}"), nil
 		}
-		return model.PredeclaredType("struct{}"), nil
+		return p.parseStructType(pkg, v, tps)
 	case *ast.ParenExpr:
 		return p.parseType(pkg, v.X, tps)
+	case *ast.UnaryExpr, *ast.BinaryExpr:
+		return p.parseUnionType(pkg, typ, tps)
 	default:
 		mt, err := p.parseGenericType(pkg, typ, tps)
 		if err != nil {
@@ -631,32 +830,142 @@ func (p *fileParser) parseType(pkg string, typ ast.Expr, tps map[string]model.Ty
 	return nil, fmt.Errorf("don't know how to parse type %T", typ)
 }
 
-func (p *fileParser) parseArrayLength(expr ast.Expr) (string, error) {
+// parseStructType parses an unnamed struct type of a parameter or result.
+func (p *fileParser) parseStructType(pkg string, v *ast.StructType, tps map[string]model.Type) (model.Type, error) {
+	st := &model.StructType{}
+	for _, field := range v.Fields.List {
+		t, err := p.parseType(pkg, field.Type, tps)
+		if err != nil {
+			return nil, err
+		}
+		var tag string
+		if field.Tag != nil {
+			if tag, err = strconv.Unquote(field.Tag.Value); err != nil {
+				return nil, p.errorf(field.Tag.Pos(), "bad struct tag %s: %v", field.Tag.Value, err)
+			}
+		}
+		if len(field.Names) == 0 {
+			st.Fields = append(st.Fields, &model.Field{Type: t, Tag: tag})
+			continue
+		}
+		for _, name := range field.Names {
+			st.Fields = append(st.Fields, &model.Field{Name: name.Name, Type: t, Tag: tag})
+		}
+	}
+	return st, nil
+}
+
+// parseInterfaceType parses an unnamed interface type of a parameter or
+// result, keeping its embedded interfaces by name.
+func (p *fileParser) parseInterfaceType(pkg string, v *ast.InterfaceType, tps map[string]model.Type) (model.Type, error) {
+	it := &model.InterfaceType{}
+	for _, field := range v.Methods.List {
+		if len(field.Names) == 0 {
+			switch field.Type.(type) {
+			case *ast.Ident, *ast.SelectorExpr, *ast.IndexExpr, *ast.IndexListExpr, *ast.UnaryExpr, *ast.BinaryExpr:
+			default:
+				return nil, p.errorf(field.Pos(), "can't handle type constraints in unnamed interface types")
+			}
+			t, err := p.parseType(pkg, field.Type, tps)
+			if err != nil {
+				return nil, err
+			}
+			it.Embedded = append(it.Embedded, t)
+			continue
+		}
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok {
+			return nil, p.errorf(field.Pos(), "don't know how to parse interface element of type %T", field.Type)
+		}
+		in, variadic, out, err := p.parseFunc(pkg, ft, tps)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range field.Names {
+			it.Methods = append(it.Methods, &model.Method{Name: name.Name, In: in, Variadic: variadic, Out: out})
+		}
+	}
+	return it, nil
+}
+
+// parseUnionType parses a union of terms such as ~int | ~string in a
+// constraint.
+func (p *fileParser) parseUnionType(pkg string, typ ast.Expr, tps map[string]model.Type) (model.Type, error) {
+	ut := &model.UnionType{}
+	var addTerms func(expr ast.Expr) error
+	addTerms = func(expr ast.Expr) error {
+		switch v := expr.(type) {
+		case *ast.BinaryExpr:
+			if v.Op != token.OR {
+				return p.errorf(v.Pos(), "unexpected operator %v in type", v.Op)
+			}
+			if err := addTerms(v.X); err != nil {
+				return err
+			}
+			return addTerms(v.Y)
+		case *ast.UnaryExpr:
+			if v.Op != token.TILDE {
+				return p.errorf(v.Pos(), "unexpected operator %v in type", v.Op)
+			}
+			t, err := p.parseType(pkg, v.X, tps)
+			if err != nil {
+				return err
+			}
+			ut.Terms = append(ut.Terms, &model.Term{Tilde: true, Type: t})
+			return nil
+		}
+		t, err := p.parseType(pkg, expr, tps)
+		if err != nil {
+			return err
+		}
+		ut.Terms = append(ut.Terms, &model.Term{Type: t})
+		return nil
+	}
+	if err := addTerms(typ); err != nil {
+		return nil, err
+	}
+	return ut, nil
+}
+
+func (p *fileParser) parseArrayLength(pkg string, expr ast.Expr) (string, error) {
 	switch val := expr.(type) {
 	case (*ast.BasicLit):
 		return val.Value, nil
 	case (*ast.Ident):
 		// when the length is a const defined locally
-		return val.Obj.Decl.(*ast.ValueSpec).Values[0].(*ast.BasicLit).Value, nil
+		if val.Obj != nil {
+			spec, ok := val.Obj.Decl.(*ast.ValueSpec)
+			if !ok || val.Obj.Kind != ast.Con {
+				return "", p.errorf(expr.Pos(), "%s in array length is not a constant", val.Name)
+			}
+			for i, name := range spec.Names {
+				if name.Name == val.Name && i < len(spec.Values) && !usesIota(spec.Values[i]) {
+					return p.parseArrayLength(pkg, spec.Values[i])
+				}
+			}
+		}
+		// The const is defined in another file of the package, or its value
+		// depends on iota.
+		return p.constantValue(pkg, val.Name, expr.Pos())
 	case (*ast.SelectorExpr):
 		// when the length is a const defined in an external package
-		usedPkg, err := importer.Default().Import(fmt.Sprintf("%s", val.X))
-		if err != nil {
-			return "", p.errorf(expr.Pos(), "unknown package in array length: %v", err)
+		x, ok := val.X.(*ast.Ident)
+		if !ok {
+			return "", p.errorf(expr.Pos(), "invalid expression in array length: %v", val)
 		}
-		ev, err := types.Eval(token.NewFileSet(), usedPkg, token.NoPos, val.Sel.Name)
-		if err != nil {
-			return "", p.errorf(expr.Pos(), "unknown constant in array length: %v", err)
+		importPath := x.Name
+		if imp, ok := p.importScope(x.Name, x.Pos())[x.Name]; ok {
+			importPath = imp.Path()
 		}
-		return ev.Value.String(), nil
+		return p.constantValue(importPath, val.Sel.Name, expr.Pos())
 	case (*ast.ParenExpr):
-		return p.parseArrayLength(val.X)
+		return p.parseArrayLength(pkg, val.X)
 	case (*ast.BinaryExpr):
-		x, err := p.parseArrayLength(val.X)
+		x, err := p.parseArrayLength(pkg, val.X)
 		if err != nil {
 			return "", err
 		}
-		y, err := p.parseArrayLength(val.Y)
+		y, err := p.parseArrayLength(pkg, val.Y)
 		if err != nil {
 			return "", err
 		}
@@ -671,6 +980,52 @@ func (p *fileParser) parseArrayLength(expr ast.Expr) (string, error) {
 	}
 }
 
+// arrayLengthConst returns the name of the constant the array length expr
+// consists of and the import path of the package declaring it, or "" if the
+// length is not a constant. Lengths computed from constants are not kept.
+func (p *fileParser) arrayLengthConst(pkg string, expr ast.Expr) (name, importPath string) {
+	switch val := expr.(type) {
+	case *ast.Ident:
+		return val.Name, pkg
+	case *ast.SelectorExpr:
+		if x, ok := val.X.(*ast.Ident); ok {
+			if imp, ok := p.importScope(x.Name, x.Pos())[x.Name]; ok {
+				return val.Sel.Name, imp.Path()
+			}
+		}
+	}
+	return "", ""
+}
+
+// usesIota reports whether the constant expression expr refers to iota.
+func usesIota(expr ast.Expr) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == "iota" && id.Obj == nil {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// constantValue returns the value of the constant name of the package at
+// importPath, type-checking the package from source.
+func (p *fileParser) constantValue(importPath, name string, pos token.Pos) (string, error) {
+	if p.constImporter == nil {
+		p.constImporter = importer.ForCompiler(token.NewFileSet(), "source", nil).(types.ImporterFrom)
+	}
+	pkg, err := p.constImporter.ImportFrom(importPath, p.srcDir, 0)
+	if err != nil {
+		return "", p.errorf(pos, "unresolved constant %s in array length: %v", name, err)
+	}
+	c, ok := pkg.Scope().Lookup(name).(*types.Const)
+	if !ok {
+		return "", p.errorf(pos, "%s in array length is not a constant of %s", name, importPath)
+	}
+	return c.Val().ExactString(), nil
+}
+
 // importsOfFile returns a map of package name to import path
 // of the imports in file.
 func importsOfFile(file *ast.File) (normalImports map[string]importedPackage, dotImports []string) {
@@ -740,7 +1095,112 @@ type namedInterface struct {
 	instTypes              []model.Type
 }
 
+// interfaceAt returns the name of the interface declared in file that spans
+// the given line, or the given byte offset if line is not positive.
+func interfaceAt(fs *token.FileSet, file *ast.File, line, offset int) (string, error) {
+	var name string
+	for ni := range iterInterfaces(file) {
+		start, end := fs.Position(ni.name.Pos()), fs.Position(ni.it.End())
+		if line > 0 && start.Line <= line && line <= end.Line ||
+			line <= 0 && start.Offset <= offset && offset < end.Offset {
+			name = ni.name.Name
+		}
+	}
+	if name != "" {
+		return name, nil
+	}
+	if line > 0 {
+		return "", fmt.Errorf("no interface spans line %d of %s", line, fs.File(file.Pos()).Name())
+	}
+	return "", fmt.Errorf("no interface spans offset %d of %s", offset, fs.File(file.Pos()).Name())
+}
+
 // Create an iterator over all interfaces in file.
+// addEmbeddedConstraint records the build constraint of the file declaring
+// the embedded interface it. A constraint other than those already recorded
+// further restricts the mock, which is reported as a warning.
+func (p *fileParser) addEmbeddedConstraint(name string, it *namedInterface) {
+	filename := p.fileSet.Position(it.name.Pos()).Filename
+	expr, err := p.constraints.add(filename)
+	if err != nil {
+		log.Printf("Warning: failed reading build constraint of embedded interface %s: %v", name, err)
+		return
+	}
+	if expr != nil {
+		log.Printf("Warning: embedded interface %s is declared in %s under the build constraint %q; the mock is restricted to it as well", name, filename, expr)
+	}
+}
+
+// buildConstraints collects the distinct //go:build constraints of the files
+// the mocked interfaces are declared in.
+type buildConstraints struct {
+	files map[string]bool
+	exprs []constraint.Expr
+}
+
+func newBuildConstraints() *buildConstraints {
+	return &buildConstraints{files: make(map[string]bool)}
+}
+
+// add records the build constraint of filename and returns it if it was not
+// recorded before.
+func (c *buildConstraints) add(filename string) (constraint.Expr, error) {
+	if c == nil || c.files[filename] {
+		return nil, nil
+	}
+	c.files[filename] = true
+
+	expr, err := fileBuildConstraint(filename)
+	if err != nil || expr == nil {
+		return nil, err
+	}
+	for _, e := range c.exprs {
+		if e.String() == expr.String() {
+			return nil, nil
+		}
+	}
+	c.exprs = append(c.exprs, expr)
+	return expr, nil
+}
+
+// String returns the conjunction of the recorded constraints, or "" if there
+// are none.
+func (c *buildConstraints) String() string {
+	if c == nil || len(c.exprs) == 0 {
+		return ""
+	}
+	expr := c.exprs[0]
+	for _, e := range c.exprs[1:] {
+		expr = &constraint.AndExpr{X: expr, Y: e}
+	}
+	return expr.String()
+}
+
+// fileBuildConstraint returns the //go:build constraint of the Go file at
+// filename, or nil if it has none.
+func fileBuildConstraint(filename string) (constraint.Expr, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	// Constraints must precede the package clause, so only the leading
+	// blank and comment lines need to be looked at.
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "//") {
+			break
+		}
+		if constraint.IsGoBuild(line) {
+			expr, err := constraint.Parse(line)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", filename, err)
+			}
+			return expr, nil
+		}
+	}
+	return nil, nil
+}
+
 func iterInterfaces(file *ast.File) <-chan *namedInterface {
 	ch := make(chan *namedInterface)
 	go func() {
@@ -3,6 +3,9 @@ package main
 import (
 	"go/parser"
 	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -115,9 +118,10 @@ func Benchmark_parseFile(b *testing.B) {
 
 func TestParseArrayWithConstLength(t *testing.T) {
 	fs := token.NewFileSet()
-	srcDir := "internal/tests/const_array_length/input.go"
+	srcDir := "internal/tests/const_array_length"
+	importPath := "go.uber.org/mock/mockgen/internal/tests/const_array_length"
 
-	file, err := parser.ParseFile(fs, srcDir, nil, 0)
+	file, err := parser.ParseFile(fs, filepath.Join(srcDir, "input.go"), nil, 0)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -130,16 +134,181 @@ func TestParseArrayWithConstLength(t *testing.T) {
 		srcDir:             srcDir,
 	}
 
-	pkg, err := p.parseFile("", file)
+	pkg, err := p.parseFile(importPath, file)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
 
-	expects := []string{"[2]int", "[2]int", "[127]int", "[3]int", "[3]int", "[7]int"}
+	expects := []string{"[C]int", "[2]int", "[127]int", "[3]int", "[3]int", "[7]int"}
 	for i, e := range expects {
-		got := pkg.Interfaces[0].Methods[i].Out[0].Type.String(nil, "")
+		got := pkg.Interfaces[0].Methods[i].Out[0].Type.String(nil, importPath)
 		if got != e {
 			t.Fatalf("got %v; expected %v", got, e)
 		}
 	}
 }
+
+func TestParseUnionConstraints(t *testing.T) {
+	fs := token.NewFileSet()
+	src := "package p\n\ntype Index[K ~int | string | ~[]byte, V interface{ ~int64 | ~uint64; String() string }] interface {\n\tGet(K) V\n}\n"
+
+	file, err := parser.ParseFile(fs, "p.go", src, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	p := fileParser{
+		fileSet:            fs,
+		imports:            make(map[string]importedPackage),
+		importedInterfaces: newInterfaceCache(),
+		auxInterfaces:      newInterfaceCache(),
+	}
+
+	pkg, err := p.parseFile("", file)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expects := []string{"~int | string | ~[]byte", "interface{ ~int64 | ~uint64; String() string }"}
+	for i, e := range expects {
+		got := pkg.Interfaces[0].TypeParams[i].Type.String(nil, "")
+		if got != e {
+			t.Errorf("got %v; expected %v", got, e)
+		}
+	}
+}
+
+func TestParseConflictingEmbeddedMethods(t *testing.T) {
+	fs := token.NewFileSet()
+	srcFile := "internal/tests/embedded_duplicates/testdata/conflict.go"
+
+	file, err := parser.ParseFile(fs, srcFile, nil, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	p := fileParser{
+		fileSet:            fs,
+		imports:            make(map[string]importedPackage),
+		importedInterfaces: newInterfaceCache(),
+		auxInterfaces:      newInterfaceCache(),
+		srcDir:             filepath.Dir(srcFile),
+	}
+	p.addAuxInterfacesFromFile("", file)
+
+	_, err = p.parseFile("", file)
+	if err == nil {
+		t.Fatal("expected an error for conflicting embedded methods")
+	}
+	for _, want := range []string{"Conflicting", "Close", "Closer", "ForceCloser"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
+
+func TestSourceModeBuildConstraint(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"internal/tests/build_constraint/input.go", "unix && linux"},
+		{"internal/tests/platform_specific/input.go", "windows"},
+		{"internal/tests/array_params/input.go", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			pkg, err := sourceMode(test.source)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pkg.BuildConstraint != test.want {
+				t.Errorf("BuildConstraint = %q, want %q", pkg.BuildConstraint, test.want)
+			}
+		})
+	}
+}
+
+func TestFileBuildConstraint(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"none", "package foo\n", ""},
+		{"after other comments", "// Copyright.\n\n//go:build linux || darwin\n\npackage foo\n", "linux || darwin"},
+		{"after package clause", "package foo\n\n//go:build linux\n", ""},
+		{"plus build only", "// +build linux\n\npackage foo\n", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "foo.go")
+			if err := os.WriteFile(filename, []byte(test.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			expr, err := fileBuildConstraint(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got string
+			if expr != nil {
+				got = expr.String()
+			}
+			if got != test.want {
+				t.Errorf("fileBuildConstraint() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestInterfaceAt(t *testing.T) {
+	const src = `package foo
+
+type Foo interface {
+	Foo()
+}
+
+var x int
+
+type (
+	Bar interface {
+		Bar()
+	}
+	Baz interface{ Baz() }
+)
+`
+	fs := token.NewFileSet()
+	file, err := parser.ParseFile(fs, "foo.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		line, offset int
+		want         string
+		wantErr      string
+	}{
+		{line: 3, want: "Foo"},
+		{line: 5, want: "Foo"},
+		{line: 11, want: "Bar"},
+		{line: 13, want: "Baz"},
+		{line: 7, wantErr: "no interface spans line 7 of foo.go"},
+		{offset: strings.Index(src, "Foo()"), want: "Foo"},
+		{offset: strings.Index(src, "Baz()"), want: "Baz"},
+		{offset: strings.Index(src, "var"), wantErr: "no interface spans offset 44 of foo.go"},
+	}
+	for _, test := range tests {
+		got, err := interfaceAt(fs, file, test.line, test.offset)
+		if test.wantErr != "" {
+			if err == nil || err.Error() != test.wantErr {
+				t.Errorf("interfaceAt(%d, %d) error = %v, want %q", test.line, test.offset, err, test.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("interfaceAt(%d, %d) error = %v", test.line, test.offset, err)
+		} else if got != test.want {
+			t.Errorf("interfaceAt(%d, %d) = %q, want %q", test.line, test.offset, got, test.want)
+		}
+	}
+}
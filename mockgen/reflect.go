@@ -18,10 +18,16 @@ package main
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/gob"
+	"encoding/hex"
+	"errors"
 	"flag"
 	"fmt"
+	"go/ast"
 	"go/build"
+	"go/parser"
+	"go/token"
 	"io"
 	"log"
 	"os"
@@ -38,6 +44,16 @@ var (
 	progOnly   = flag.Bool("prog_only", false, "(reflect mode) Only generate the reflection program; write it to stdout and exit.")
 	execOnly   = flag.String("exec_only", "", "(reflect mode) If set, execute this reflection program.")
 	buildFlags = flag.String("build_flags", "", "(reflect mode) Additional flags for go build.")
+	buildTags  = flag.String("build_tags", "", "(reflect mode) Comma-separated build tags to build the reflection program with, so that interfaces in files constrained to them are visible.")
+	goos       = flag.String("goos", "", "(reflect mode) GOOS to build the reflection program for, or to read the export data of without an exec wrapper for platforms the host can't run. The mock is constrained to it.")
+	goarch     = flag.String("goarch", "", "(reflect mode) GOARCH to build the reflection program for, or to read the export data of without an exec wrapper for platforms the host can't run. The mock is constrained to it.")
+	cgo        = flag.String("cgo", "", "(reflect mode) CGO_ENABLED to build the reflection program with, 0 or 1; defaults to that of the environment.")
+	moduleRoot = flag.String("module_root", "", "(reflect mode) Directory of the go.mod to resolve the package and build the reflection program with; defaults to the current directory, and outside of modules to also trying the package directory and a temporary directory.")
+
+	exportData = flag.Bool("export_data", false, "(reflect mode) Read the interfaces from the compiler's export data of the package instead of building and running a reflection program, falling back to the program if that fails.")
+
+	modelCache    = flag.Bool("model_cache", false, "(reflect mode) Cache the reflected model on disk and reuse it until a source file of the package changes.")
+	modelCacheDir = flag.String("model_cache_dir", "", "(reflect mode) Directory of the -model_cache cache; defaults to mockgen in the user cache directory.")
 )
 
 // reflectMode generates mocks via reflection on an interface.
@@ -46,6 +62,54 @@ func reflectMode(importPath string, symbols []string) (*model.Package, error) {
 		return run(*execOnly)
 	}
 
+	// A reflection program built for another platform can't run on this
+	// one without an exec wrapper, but the go command builds the export data
+	// of the package for any platform, so it is read instead.
+	crossPlatform := isCrossPlatform() && execWrapper() == ""
+	if (*exportData || crossPlatform) && !*progOnly {
+		pkg, err := exportDataMode(importPath, symbols)
+		if err == nil {
+			return pkg, nil
+		}
+		if crossPlatform {
+			targetOS, targetArch := targetPlatform()
+			return nil, fmt.Errorf("reading the export data for %s/%s: %v; put a go_%s_%s_exec wrapper on the PATH to use the reflection program instead", targetOS, targetArch, err, targetOS, targetArch)
+		}
+		logf(1, "falling back to the reflection program: %v", err)
+	}
+
+	var cachePath string
+	if *modelCache && !*progOnly {
+		var err error
+		if cachePath, err = modelCachePath(importPath, symbols); err != nil {
+			log.Printf("Not using the model cache: %v", err)
+		} else if pkg, err := readModelCache(cachePath); err == nil {
+			return pkg, nil
+		}
+	}
+
+	pkg, err := reflectProgramMode(importPath, symbols)
+	if err == nil && cachePath != "" {
+		if err := writeModelCache(cachePath, pkg); err != nil {
+			log.Printf("Failed writing the model cache: %v", err)
+		}
+	}
+	return pkg, err
+}
+
+// reflectProgramMode builds and runs the reflection program for the given
+// symbols of the package at importPath.
+func reflectProgramMode(importPath string, symbols []string) (*model.Package, error) {
+	wd := reflectWorkDir()
+	if *moduleRoot != "" {
+		if _, err := os.Stat(filepath.Join(*moduleRoot, "go.mod")); err != nil {
+			return nil, fmt.Errorf("-module_root %s has no go.mod: %v", *moduleRoot, err)
+		}
+	}
+	if err := checkSymbols(importPath, wd, symbols); err != nil {
+		return nil, err
+	}
+
 	program, err := writeProgram(importPath, symbols)
 	if err != nil {
 		return nil, err
@@ -58,23 +122,51 @@ func reflectMode(importPath string, symbols []string) (*model.Package, error) {
 		os.Exit(0)
 	}
 
-	wd, _ := os.Getwd()
-
-	// Try to run the reflection program  in the current working directory.
-	if p, err := runInDir(program, wd); err == nil {
+	if *moduleRoot != "" {
+		p, err := runInDir(program, *moduleRoot)
+		if err != nil {
+			return nil, fmt.Errorf("failed building or running the reflection program in %s: %w", *moduleRoot, err)
+		}
 		return p, nil
 	}
 
-	// Try to run the program in the same directory as the input package.
-	if p, err := build.Import(importPath, wd, build.FindOnly); err == nil {
-		dir := p.Dir
-		if p, err := runInDir(program, dir); err == nil {
+	// Within a module, build the reflection program in the current working
+	// directory only, so that the replace directives and versions of the
+	// module apply. The directory of the input package may belong to another
+	// module, such as a module in the module cache or the local target of a
+	// replace directive. Outside of modules, try the same directory as the
+	// input package, and finally a standard temp directory.
+	dirs := []string{wd}
+	if root, err := moduleRootOf(wd); err != nil {
+		return nil, err
+	} else if root == "" {
+		if p, err := build.Import(importPath, wd, build.FindOnly); err == nil {
+			dirs = append(dirs, p.Dir)
+		}
+		dirs = append(dirs, "")
+	}
+	var failures []string
+	for _, dir := range dirs {
+		p, err := runInDir(program, dir)
+		if err == nil {
 			return p, nil
 		}
+		if dir == "" {
+			dir = "a temporary directory"
+		}
+		failures = append(failures, fmt.Sprintf("in %s: %v", dir, err))
 	}
+	return nil, fmt.Errorf("failed building or running the reflection program:\n%s", strings.Join(failures, "\n"))
+}
 
-	// Try to run it in a standard temp directory.
-	return runInDir(program, "")
+// reflectWorkDir is the directory the input package is resolved from: the
+// -module_root directory, defaulting to the current directory.
+func reflectWorkDir() string {
+	if *moduleRoot != "" {
+		return *moduleRoot
+	}
+	wd, _ := os.Getwd()
+	return wd
 }
 
 func writeProgram(importPath string, symbols []string) ([]byte, error) {
@@ -103,10 +195,13 @@ func run(program string) (*model.Package, error) {
 	}
 
 	// Run the program.
-	cmd := exec.Command(program, "-output", filename)
+	cmd := reflectCommand(program, "-output", filename)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	if err := cmd.Run(); err != nil {
+		if targetOS, targetArch := targetPlatform(); cmd.Args[0] == program && isCrossPlatform() {
+			return nil, fmt.Errorf("running reflection program built for %s/%s: %v; put a go_%s_%s_exec wrapper on the PATH to run it", targetOS, targetArch, err, targetOS, targetArch)
+		}
 		return nil, err
 	}
 
@@ -143,7 +238,7 @@ func runInDir(program []byte, dir string) (*model.Package, error) {
 	}()
 	const progSource = "prog.go"
 	var progBinary = "prog.bin"
-	if runtime.GOOS == "windows" {
+	if targetOS, _ := targetPlatform(); targetOS == "windows" {
 		// Windows won't execute a program unless it has a ".exe" suffix.
 		progBinary += ".exe"
 	}
@@ -152,10 +247,9 @@ func runInDir(program []byte, dir string) (*model.Package, error) {
 		return nil, err
 	}
 
-	cmdArgs := []string{}
-	cmdArgs = append(cmdArgs, "build")
-	if *buildFlags != "" {
-		cmdArgs = append(cmdArgs, strings.Split(*buildFlags, " ")...)
+	cmdArgs, err := goBuildArgs()
+	if err != nil {
+		return nil, err
 	}
 	cmdArgs = append(cmdArgs, "-o", progBinary, progSource)
 
@@ -163,19 +257,342 @@ func runInDir(program []byte, dir string) (*model.Package, error) {
 	buf := bytes.NewBuffer(nil)
 	cmd := exec.Command("go", cmdArgs...)
 	cmd.Dir = tmpDir
+	cmd.Env = goCommandEnv()
 	cmd.Stdout = os.Stdout
-	cmd.Stderr = io.MultiWriter(os.Stderr, buf)
+	cmd.Stderr = buf
 	if err := cmd.Run(); err != nil {
-		sErr := buf.String()
-		if strings.Contains(sErr, `cannot find package "."`) &&
-			strings.Contains(sErr, "go.uber.org/mock/mockgen/model") {
-			fmt.Fprint(os.Stderr, "Please reference the steps in the README to fix this error:\n\thttps://go.uber.org/mock#reflect-vendoring-error.\n")
-			return nil, err
+		sErr := strings.TrimSpace(buf.String())
+		return nil, fmt.Errorf("go build: %v\n%s%s", err, sErr, buildErrorHint(sErr))
+	}
+
+	return run(filepath.Join(tmpDir, progBinary))
+}
+
+// goBuildArgs returns the go build command line of the reflection program,
+// up to the output and source file, from -build_flags and -build_tags.
+func goBuildArgs() ([]string, error) {
+	cmdArgs := []string{"build"}
+	if *buildFlags != "" {
+		cmdArgs = append(cmdArgs, strings.Split(*buildFlags, " ")...)
+	}
+	if *buildTags != "" {
+		for _, arg := range cmdArgs[1:] {
+			if arg == "-tags" || strings.HasPrefix(arg, "-tags=") || arg == "--tags" || strings.HasPrefix(arg, "--tags=") {
+				return nil, errors.New("-build_tags can't be combined with -tags in -build_flags")
+			}
+		}
+		cmdArgs = append(cmdArgs, "-tags="+*buildTags)
+	}
+	return cmdArgs, nil
+}
+
+// buildErrorHint suggests a fix for common failures in the go build output
+// stderr, or returns "".
+func buildErrorHint(stderr string) string {
+	switch {
+	case strings.Contains(stderr, `cannot find package "."`) && strings.Contains(stderr, "go.uber.org/mock/mockgen/model"):
+		return "\nPlease reference the steps in the README to fix this error:\n\thttps://go.uber.org/mock#reflect-vendoring-error."
+	case strings.Contains(stderr, "no required module provides package"), strings.Contains(stderr, "missing go.sum entry"):
+		return "\nThe go.mod of this directory doesn't provide the package: add its module with go get, or set -module_root to a module that requires it."
+	}
+	return ""
+}
+
+// targetPlatform returns the GOOS and GOARCH the reflection program is built
+// for: the -goos and -goarch flags, defaulting to the go command's defaults.
+func targetPlatform() (targetOS, targetArch string) {
+	targetOS, targetArch = build.Default.GOOS, build.Default.GOARCH
+	if *goos != "" {
+		targetOS = *goos
+	}
+	if *goarch != "" {
+		targetArch = *goarch
+	}
+	return targetOS, targetArch
+}
+
+// goCommandEnv returns the environment of the go commands building the
+// reflection program, its export data and the mock: mockgen's own, so that
+// the ambient CGO_ENABLED applies, with the platform set by -goos and -goarch
+// and the cgo setting of -cgo. It is nil, which exec takes for mockgen's
+// environment, if none of these flags is set.
+func goCommandEnv() []string {
+	var env []string
+	if *goos != "" || *goarch != "" {
+		targetOS, targetArch := targetPlatform()
+		env = append(env, "GOOS="+targetOS, "GOARCH="+targetArch)
+	}
+	if *cgo != "" {
+		env = append(env, "CGO_ENABLED="+*cgo)
+	}
+	if env == nil {
+		return nil
+	}
+	return append(os.Environ(), env...)
+}
+
+// cgoEnabled returns the CGO_ENABLED the reflection program is built with,
+// or "" if it is left to the go command.
+func cgoEnabled() string {
+	if *cgo != "" {
+		return *cgo
+	}
+	return os.Getenv("CGO_ENABLED")
+}
+
+// isCrossPlatform reports whether the reflection program is built for a
+// platform other than the one mockgen runs on.
+func isCrossPlatform() bool {
+	targetOS, targetArch := targetPlatform()
+	return targetOS != runtime.GOOS || targetArch != runtime.GOARCH
+}
+
+// reflectCommand returns the command running the given reflection program.
+// Like go run, a program built for another platform is run through a
+// go_$GOOS_$GOARCH_exec wrapper found on the PATH, such as one using wine or
+// qemu. Without a wrapper the program is run directly, which works where the
+// host can execute it natively.
+func reflectCommand(program string, args ...string) *exec.Cmd {
+	if isCrossPlatform() {
+		if wrapper := execWrapper(); wrapper != "" {
+			return exec.Command(wrapper, append([]string{program}, args...)...)
+		}
+	}
+	return exec.Command(program, args...)
+}
+
+// execWrapper returns the path of the go_$GOOS_$GOARCH_exec wrapper for the
+// target platform on the PATH, or "" if there is none.
+func execWrapper() string {
+	targetOS, targetArch := targetPlatform()
+	wrapper, err := exec.LookPath(fmt.Sprintf("go_%s_%s_exec", targetOS, targetArch))
+	if err != nil {
+		return ""
+	}
+	return wrapper
+}
+
+// buildConstraint returns the expression of the //go:build line restricting
+// the generated mock to the platform set by -goos and -goarch, if any.
+func buildConstraint() string {
+	var terms []string
+	if *goos != "" {
+		terms = append(terms, *goos)
+	}
+	if *goarch != "" {
+		terms = append(terms, *goarch)
+	}
+	return strings.Join(terms, " && ")
+}
+
+// modelCachePath returns the -model_cache file of the model of the given
+// symbols of the package at importPath.
+func modelCachePath(importPath string, symbols []string) (string, error) {
+	dir := *modelCacheDir
+	if dir == "" {
+		userDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(userDir, "mockgen")
+	}
+	p, err := build.Import(importPath, reflectWorkDir(), build.FindOnly)
+	if err != nil {
+		return "", err
+	}
+	key, err := modelCacheKey(p.Dir, importPath, symbols)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, key+".gob"), nil
+}
+
+// modelCacheKey hashes everything the reflected model depends on: the
+// mockgen version, which the model types may change with, the reflection
+// program's inputs and the Go files in pkgDir. Changes to other packages,
+// such as those of embedded interfaces, are not detected.
+func modelCacheKey(pkgDir, importPath string, symbols []string) (string, error) {
+	targetOS, targetArch := targetPlatform()
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%s\n%s\n%s\n%s\n%s/%s\n%s\n", mockgenVersion(), runtime.Version(), importPath, strings.Join(symbols, ","), *buildFlags, *buildTags, targetOS, targetArch, cgoEnabled())
+
+	if err := hashGoFiles(h, pkgDir, nil); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashGoFiles writes the names and contents of the Go files in dir to h,
+// leaving out the files skip reports, if it is not nil.
+func hashGoFiles(h io.Writer, dir string, skip func(path string) bool) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		if skip != nil && skip(path) {
+			continue
+		}
+		if err := hashFile(h, path); err != nil {
+			return err
 		}
+	}
+	return nil
+}
+
+// hashFile writes the base name and contents of the file at path to h.
+func hashFile(h io.Writer, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(h, "%s %d\n", filepath.Base(path), len(data))
+	_, err = h.Write(data)
+	return err
+}
+
+func readModelCache(path string) (*model.Package, error) {
+	f, err := os.Open(path)
+	if err != nil {
 		return nil, err
 	}
+	defer f.Close()
 
-	return run(filepath.Join(tmpDir, progBinary))
+	var pkg model.Package
+	if err := gob.NewDecoder(f).Decode(&pkg); err != nil {
+		return nil, err
+	}
+	return &pkg, nil
+}
+
+// writeModelCache stores pkg at path. The file is written under a temporary
+// name first so that concurrent runs never read a partial model.
+func writeModelCache(path string, pkg *model.Package) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), "model_*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if err := gob.NewEncoder(f).Encode(pkg); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// checkSymbols reports in a single error every symbol of the package at
+// importPath that can't be mocked in reflect mode, rather than letting the
+// reflection program fail to build on the first. Types that can't be judged
+// without type checking, such as aliases, are left to the reflection program,
+// as are packages that fail to load or parse here.
+func checkSymbols(importPath, srcDir string, symbols []string) error {
+	imp, err := build.Import(importPath, srcDir, 0)
+	if err != nil {
+		return nil
+	}
+	// Files excluded by build constraints count, since -build_tags and
+	// -build_flags may include them.
+	specs := make(map[string]*ast.TypeSpec)
+	fs := token.NewFileSet()
+	files := append(append(imp.GoFiles, imp.CgoFiles...), imp.IgnoredGoFiles...)
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fs, filepath.Join(imp.Dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil
+		}
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if prev, ok := specs[ts.Name.Name]; !ok || symbolProblem(prev) != "" {
+					specs[ts.Name.Name] = ts
+				}
+			}
+		}
+	}
+
+	var problems []string
+	for _, sym := range symbols {
+		ts, ok := specs[sym]
+		problem := "not found"
+		if ok {
+			problem = symbolProblem(ts)
+		}
+		if problem != "" {
+			problems = append(problems, sym+": "+problem)
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("cannot mock %d of %d symbols of %s:\n\t%s", len(problems), len(symbols), importPath, strings.Join(problems, "\n\t"))
+	}
+	return nil
+}
+
+// symbolProblem describes why the type declared by ts can't be mocked in
+// reflect mode, or returns "" if it may be mocked.
+func symbolProblem(ts *ast.TypeSpec) string {
+	if !ts.Name.IsExported() {
+		return "not exported"
+	}
+	if ts.Assign.IsValid() {
+		return ""
+	}
+	var kind string
+	switch t := ts.Type.(type) {
+	case *ast.InterfaceType:
+		for _, field := range t.Methods.List {
+			if len(field.Names) > 0 {
+				continue
+			}
+			switch e := field.Type.(type) {
+			case *ast.UnaryExpr, *ast.BinaryExpr:
+				return "constraint-only interface"
+			case *ast.Ident:
+				if e.Name == "comparable" {
+					return "constraint-only interface"
+				}
+			}
+		}
+		if ts.TypeParams != nil && len(ts.TypeParams.List) > 0 {
+			return "generic interface; use source mode"
+		}
+		return ""
+	case *ast.Ident, *ast.SelectorExpr, *ast.IndexExpr, *ast.IndexListExpr, *ast.ParenExpr:
+		// Defined from another type, which may be an interface.
+		return ""
+	case *ast.StructType:
+		kind = "struct"
+	case *ast.FuncType:
+		kind = "func"
+	case *ast.MapType:
+		kind = "map"
+	case *ast.ArrayType:
+		kind = "array or slice"
+	case *ast.ChanType:
+		kind = "channel"
+	case *ast.StarExpr:
+		kind = "pointer"
+	default:
+		return "not an interface"
+	}
+	return "not an interface but a " + kind + " type"
 }
 
 type reflectData struct {
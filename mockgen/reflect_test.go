@@ -0,0 +1,407 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/mockgen/model"
+)
+
+func TestModelCacheKey(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	key := func(symbols ...string) string {
+		t.Helper()
+		k, err := modelCacheKey(dir, "example.com/foo", symbols)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return k
+	}
+
+	write("foo.go", "package foo\n\ntype Foo interface{ Bar() }\n")
+	write("README.md", "docs")
+	base := key("Foo")
+	if got := key("Foo"); got != base {
+		t.Errorf("key changed without any change: %s != %s", got, base)
+	}
+	if got := key("Foo", "Baz"); got == base {
+		t.Error("key did not change with the symbols")
+	}
+
+	write("README.md", "more docs")
+	if got := key("Foo"); got != base {
+		t.Error("key changed with a non-Go file")
+	}
+
+	prevVersion := version
+	version = "v0.0.0-upgraded"
+	if got := key("Foo"); got == base {
+		t.Error("key did not change with the mockgen version")
+	}
+	version = prevVersion
+
+	write("foo.go", "package foo\n\ntype Foo interface{ Bar() int }\n")
+	if got := key("Foo"); got == base {
+		t.Error("key did not change with a modified source file")
+	}
+	modified := key("Foo")
+
+	write("baz.go", "package foo\n")
+	if got := key("Foo"); got == modified {
+		t.Error("key did not change with an added source file")
+	}
+}
+
+func TestModelCacheRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "key.gob")
+	if _, err := readModelCache(path); err == nil {
+		t.Fatal("readModelCache() of missing file succeeded")
+	}
+
+	want := &model.Package{
+		Name: "foo",
+		Interfaces: []*model.Interface{{
+			Name: "Foo",
+			Methods: []*model.Method{{
+				Name: "Bar",
+				In:   []*model.Parameter{{Name: "x", Type: &model.ArrayType{Len: 2, Type: model.PredeclaredType("int")}}},
+				Out:  []*model.Parameter{{Type: &model.NamedType{Package: "example.com/bar", Type: "Bar"}}},
+			}},
+		}},
+	}
+	if err := writeModelCache(path, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readModelCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readModelCache() = %+v, want %+v", got, want)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("cache directory has %d entries, want only the model", len(entries))
+	}
+}
+
+func TestCheckSymbols(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/foo\n",
+		"foo/foo.go": `package foo
+
+type Foo interface{ Foo() }
+type Number interface{ ~int | ~float64 }
+type Key interface{ comparable }
+type Generic[T any] interface{ Get() T }
+type Config struct{}
+type Handler func()
+type Reader = interface{ Read() }
+type unexported interface{}
+`,
+		"foo/foo_windows.go": "package foo\n\ntype Windows interface{ Handle() }\n",
+		"foo/cgo.go":         "package foo\n\nimport \"C\"\n\ntype Doer interface{ Do() }\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := checkSymbols("./foo", dir, []string{"Foo", "Reader", "Windows", "Doer"}); err != nil {
+		t.Errorf("checkSymbols() of valid symbols = %v", err)
+	}
+
+	err := checkSymbols("./foo", dir, []string{"Foo", "Fooo", "Number", "Key", "Generic", "Config", "Handler", "unexported"})
+	if err == nil {
+		t.Fatal("checkSymbols() of invalid symbols succeeded")
+	}
+	want := "cannot mock 7 of 8 symbols of ./foo:\n" +
+		"\tFooo: not found\n" +
+		"\tNumber: constraint-only interface\n" +
+		"\tKey: constraint-only interface\n" +
+		"\tGeneric: generic interface; use source mode\n" +
+		"\tConfig: not an interface but a struct type\n" +
+		"\tHandler: not an interface but a func type\n" +
+		"\tunexported: not exported"
+	if err.Error() != want {
+		t.Errorf("checkSymbols() = %q, want %q", err, want)
+	}
+}
+
+func TestRunInDir_BuildErrorIncludesOutput(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/prog\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, err := runInDir([]byte("package main\n\nfunc main() { undefinedFunc() }\n"), dir)
+	if err == nil {
+		t.Fatal("runInDir() of a broken program succeeded")
+	}
+	if !strings.Contains(err.Error(), "go build: ") || !strings.Contains(err.Error(), "undefined: undefinedFunc") {
+		t.Errorf("runInDir() = %q, want the go build output", err)
+	}
+}
+
+func TestBuildErrorHint(t *testing.T) {
+	tests := []struct {
+		stderr string
+		want   string
+	}{
+		{"prog.go:1:1: undefined: foo", ""},
+		{"prog.go:12:2: no required module provides package example.com/dep; to add it:\n\tgo get example.com/dep", "-module_root"},
+		{"prog.go:12:2: missing go.sum entry for module providing package example.com/dep", "-module_root"},
+		{`cannot find package "." in:` + "\n\t/src/vendor/go.uber.org/mock/mockgen/model", "reflect-vendoring-error"},
+	}
+	for _, tt := range tests {
+		got := buildErrorHint(tt.stderr)
+		if tt.want == "" && got != "" || !strings.Contains(got, tt.want) {
+			t.Errorf("buildErrorHint(%q) = %q, want it to contain %q", tt.stderr, got, tt.want)
+		}
+	}
+}
+
+func TestGoBuildArgs(t *testing.T) {
+	defer func(prevFlags, prevTags string) { *buildFlags, *buildTags = prevFlags, prevTags }(*buildFlags, *buildTags)
+
+	tests := []struct {
+		flags, tags string
+		want        []string
+		wantErr     bool
+	}{
+		{"", "", []string{"build"}, false},
+		{"-mod=mod", "", []string{"build", "-mod=mod"}, false},
+		{"", "tools,integration", []string{"build", "-tags=tools,integration"}, false},
+		{"-mod=mod", "tools", []string{"build", "-mod=mod", "-tags=tools"}, false},
+		{"-tags=other", "", []string{"build", "-tags=other"}, false},
+		{"-tags=other", "tools", nil, true},
+		{"-tags other", "tools", nil, true},
+	}
+	for _, tt := range tests {
+		*buildFlags, *buildTags = tt.flags, tt.tags
+		got, err := goBuildArgs()
+		if (err != nil) != tt.wantErr || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("goBuildArgs() with -build_flags %q -build_tags %q = %q, %v, want %q", tt.flags, tt.tags, got, err, tt.want)
+		}
+	}
+}
+
+// exportDataTestFiles is a package whose interfaces use the types the
+// reflection program and export data tell apart differently.
+var exportDataTestFiles = map[string]string{
+	"foo/foo.go": `package foo
+
+import (
+	"io"
+	"time"
+	"unsafe"
+)
+
+type Pair[K comparable, V any] struct{}
+
+type ID = string
+
+type Foo interface {
+	io.Reader
+	Bytes(b []byte, r rune, u uint8) (byte, int32)
+	Funcs(f func(int, ...string) error, ch <-chan chan<- struct{}) map[ID][2]*time.Duration
+	Literal(interface{ Close() error }, interface{}, any) error
+	Generic(Pair[byte, []rune], Pair[string, Pair[int, io.Reader]]) *Pair[ID, any]
+	Variadic(format string, args ...interface{})
+	Struct(struct {
+		X, y int
+		io.Reader ` + "`json:\"r\"`" + `
+		*time.Timer
+	}) struct{ A []struct{ B byte } }
+	Unsafe(p unsafe.Pointer, n uintptr) []*unsafe.Pointer
+	unexported()
+}
+`,
+}
+
+func TestExportDataMode(t *testing.T) {
+	dir := t.TempDir()
+	writeVerifyModule(t, dir, exportDataTestFiles)
+	defer func(prev string) { *moduleRoot = prev }(*moduleRoot)
+	*moduleRoot = dir
+
+	want, err := reflectProgramMode("example.com/foo/foo", []string{"Foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := exportDataMode("example.com/foo/foo", []string{"Foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		var gotOut, wantOut strings.Builder
+		got.Print(&gotOut)
+		want.Print(&wantOut)
+		t.Errorf("exportDataMode() =\n%s\nwant the model of the reflection program\n%s", gotOut.String(), wantOut.String())
+	}
+
+	for _, sym := range []string{"Pair", "ID", "Missing"} {
+		if _, err := exportDataMode("example.com/foo/foo", []string{sym}); err == nil {
+			t.Errorf("exportDataMode() of %s succeeded", sym)
+		}
+	}
+	if _, err := exportDataMode("example.com/foo/missing", []string{"Foo"}); err == nil {
+		t.Error("exportDataMode() of a missing package succeeded")
+	}
+}
+
+func TestReflectMode_CgoEnabled(t *testing.T) {
+	dir := t.TempDir()
+	writeVerifyModule(t, dir, map[string]string{
+		"foo/cgo.go": `//go:build cgo
+
+package foo
+
+type Foo interface{ Cgo() }
+`,
+		"foo/nocgo.go": `//go:build !cgo
+
+package foo
+
+type Foo interface{ NoCgo() }
+`,
+	})
+	defer func(prev string) { *moduleRoot = prev }(*moduleRoot)
+	*moduleRoot = dir
+	defer func(prev string) { *cgo = prev }(*cgo)
+
+	// The environment's CGO_ENABLED reaches the go commands, and -cgo
+	// overrides it.
+	tests := []struct {
+		env, flag, want string
+	}{
+		{"0", "", "NoCgo"},
+		{"1", "0", "NoCgo"},
+		{"0", "1", "Cgo"},
+	}
+	for _, tt := range tests {
+		t.Setenv("CGO_ENABLED", tt.env)
+		*cgo = tt.flag
+		for name, load := range map[string]func(string, []string) (*model.Package, error){
+			"program":     reflectProgramMode,
+			"export_data": exportDataMode,
+		} {
+			pkg, err := load("example.com/foo/foo", []string{"Foo"})
+			if err != nil {
+				t.Fatalf("%s with CGO_ENABLED=%s -cgo=%q: %v", name, tt.env, tt.flag, err)
+			}
+			if got := pkg.Interfaces[0].Methods[0].Name; got != tt.want {
+				t.Errorf("%s with CGO_ENABLED=%s -cgo=%q mocked %s, want %s", name, tt.env, tt.flag, got, tt.want)
+			}
+		}
+	}
+
+	*cgo = ""
+	if env := goCommandEnv(); env != nil {
+		t.Errorf("goCommandEnv() without -cgo, -goos and -goarch = %q, want nil", env)
+	}
+}
+
+func TestReflectMode_CrossPlatform(t *testing.T) {
+	defer func(prevOS, prevArch string) { *goos, *goarch = prevOS, prevArch }(*goos, *goarch)
+	*goos, *goarch = "windows", "amd64"
+	if runtime.GOOS == "windows" {
+		*goos = "linux"
+	}
+	// Only the go command is on the PATH, without an exec wrapper that could
+	// run the program.
+	goCmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	t.Setenv("PATH", filepath.Dir(goCmd))
+
+	pkg, err := reflectMode("go.uber.org/mock/mockgen/internal/tests/platform_specific", []string{"Console"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var methods []string
+	for _, m := range pkg.Interfaces[0].Methods {
+		methods = append(methods, m.Name)
+	}
+	want := []string{"SetTitle"}
+	if *goos == "windows" {
+		want = []string{"Handle", "SetTitle"}
+	}
+	sort.Strings(methods)
+	if !reflect.DeepEqual(methods, want) {
+		t.Errorf("methods of Console for %s = %v, want %v", *goos, methods, want)
+	}
+}
+
+func BenchmarkReflectMode(b *testing.B) {
+	dir := b.TempDir()
+	writeVerifyModule(b, dir, exportDataTestFiles)
+	defer func(prev string) { *moduleRoot = prev }(*moduleRoot)
+	*moduleRoot = dir
+
+	for name, load := range map[string]func(string, []string) (*model.Package, error){
+		"program":     reflectProgramMode,
+		"export_data": exportDataMode,
+	} {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := load("example.com/foo/foo", []string{"Foo"}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestReflectProgramMode_ModuleRootWithoutGoMod(t *testing.T) {
+	defer func(prev string) { *moduleRoot = prev }(*moduleRoot)
+	*moduleRoot = t.TempDir()
+
+	_, err := reflectProgramMode("example.com/foo", []string{"Foo"})
+	if err == nil || !strings.Contains(err.Error(), "has no go.mod") {
+		t.Errorf("reflectProgramMode() = %v, want error for -module_root without go.mod", err)
+	}
+}
+
+func TestModuleRootOf(t *testing.T) {
+	root := t.TempDir()
+	pkgDir := filepath.Join(root, "a", "b")
+	if err := os.MkdirAll(pkgDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := moduleRootOf(pkgDir); err != nil || got != "" {
+		t.Errorf("moduleRootOf() outside of modules = %q, %v, want \"\"", got, err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/root\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := moduleRootOf(pkgDir); err != nil || got != root {
+		t.Errorf("moduleRootOf() = %q, %v, want %q", got, err, root)
+	}
+	t.Setenv("GO111MODULE", "off")
+	if got, err := moduleRootOf(pkgDir); err != nil || got != "" {
+		t.Errorf("moduleRootOf() with modules disabled = %q, %v, want \"\"", got, err)
+	}
+}
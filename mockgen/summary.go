@@ -0,0 +1,66 @@
+package main
+
+// This file contains the -summary report of generated mocks.
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"go.uber.org/mock/mockgen/model"
+)
+
+// summaryOutput is where -summary writes its report.
+var summaryOutput io.Writer = os.Stderr
+
+// printSummary writes a line per interface of pkg to summaryOutput naming its
+// mock, its number of methods and whether they are typed, and where the size
+// bytes of output were written. An empty destination is stdout.
+func (g *generator) printSummary(pkg *model.Package, destination string, size int) {
+	if destination == "" {
+		destination = "stdout"
+	}
+	for _, intf := range pkg.Interfaces {
+		mockType, kind := g.mockName(intf.Name), g.typedSummary(intf)
+		if *stub {
+			mockType, kind = "Stub"+intf.Name, "stub"
+		}
+		methods := "methods"
+		if len(intf.Methods) == 1 {
+			methods = "method"
+		}
+		fmt.Fprintf(summaryOutput, "mockgen: summary: %s -> %s: %d %s, %s, %s (%d bytes)\n",
+			intf.Name, mockType, len(intf.Methods), methods, kind, destination, size)
+	}
+}
+
+// printUnchangedSummary writes a line to summaryOutput for the mock at
+// destination, which -if_changed left as it was without loading its
+// interfaces.
+func printUnchangedSummary(destination string) {
+	size := 0
+	if info, err := os.Stat(destination); err == nil {
+		size = int(info.Size())
+	}
+	fmt.Fprintf(summaryOutput, "mockgen: summary: %s: unchanged, inputs match its fingerprint (%d bytes)\n", destination, size)
+}
+
+// typedSummary describes whether the methods of the mock of intf are typed.
+func (g *generator) typedSummary(intf *model.Interface) string {
+	switch *typed {
+	case typedMonomorphic:
+		return "typed"
+	case typedGeneric:
+		return "typed=generic"
+	}
+	n := 0
+	for _, m := range intf.Methods {
+		if g.methodTyped(intf, m, untyped) != untyped {
+			n++
+		}
+	}
+	if n == 0 {
+		return "untyped"
+	}
+	return fmt.Sprintf("%d of %d typed", n, len(intf.Methods))
+}
@@ -0,0 +1,58 @@
+package main
+
+// This file contains the -verify_compile check of generated mocks.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// verifyCompile builds the package of the mock at dst as if the mock were
+// output, without writing it, and reports the compile errors if it fails.
+// The package is built in its own module with go build -overlay, so that
+// mocks in the package of their interface and their imports resolve as they
+// will once written. Mocks in _test.go files are built with their tests.
+func verifyCompile(dst string, output []byte) error {
+	dst, err := filepath.Abs(dst)
+	if err != nil {
+		return err
+	}
+	tmpDir, err := os.MkdirTemp("", "mockgen_verify_")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	mock := filepath.Join(tmpDir, "mock.go")
+	if err := os.WriteFile(mock, output, 0o600); err != nil {
+		return err
+	}
+	overlay, err := json.Marshal(struct{ Replace map[string]string }{map[string]string{dst: mock}})
+	if err != nil {
+		return err
+	}
+	overlayFile := filepath.Join(tmpDir, "overlay.json")
+	if err := os.WriteFile(overlayFile, overlay, 0o600); err != nil {
+		return err
+	}
+
+	args := []string{"build", "-overlay", overlayFile}
+	if strings.HasSuffix(dst, "_test.go") {
+		// Before Go 1.21 vet ignores -overlay, and the mock only needs to compile.
+		args = []string{"test", "-vet=off", "-overlay", overlayFile, "-c", "-o", filepath.Join(tmpDir, "test.bin")}
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command("go", append(args, ".")...)
+	cmd.Dir = filepath.Dir(dst)
+	cmd.Env = goCommandEnv()
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("generated mock does not compile: %v\n%s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
@@ -35,11 +35,22 @@ func NewMockMath(ctrl *gomock.Controller) *MockMath {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockMath) EXPECT() *MockMathMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockMath; create it with NewMockMath")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockMath) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Sum mocks base method.
 func (m *MockMath) Sum(arg0, arg1 int) int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockMath; create it with NewMockMath")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Sum", arg0, arg1)
 	ret0, _ := ret[0].(int)
@@ -46,11 +46,22 @@ func NewMockIndex(ctrl *gomock.Controller) *MockIndex {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockIndex) EXPECT() *MockIndexMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockIndex) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // Anon mocks base method.
 func (m *MockIndex) Anon(arg0 string) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Anon", arg0)
 }
@@ -63,6 +74,9 @@ func (mr *MockIndexMockRecorder) Anon(arg0 any) *gomock.Call {
 
 // Chan mocks base method.
 func (m *MockIndex) Chan(arg0 chan int, arg1 chan<- hash.Hash) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Chan", arg0, arg1)
 }
@@ -75,6 +89,9 @@ func (mr *MockIndexMockRecorder) Chan(arg0, arg1 any) *gomock.Call {
 
 // ConcreteRet mocks base method.
 func (m *MockIndex) ConcreteRet() chan<- bool {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ConcreteRet")
 	ret0, _ := ret[0].(chan<- bool)
@@ -89,6 +106,9 @@ func (mr *MockIndexMockRecorder) ConcreteRet() *gomock.Call {
 
 // Ellip mocks base method.
 func (m *MockIndex) Ellip(arg0 string, arg1 ...any) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	varargs := []any{arg0}
 	for _, a := range arg1 {
@@ -106,6 +126,9 @@ func (mr *MockIndexMockRecorder) Ellip(arg0 any, arg1 ...any) *gomock.Call {
 
 // EllipOnly mocks base method.
 func (m *MockIndex) EllipOnly(arg0 ...string) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	varargs := []any{}
 	for _, a := range arg0 {
@@ -122,6 +145,9 @@ func (mr *MockIndexMockRecorder) EllipOnly(arg0 ...any) *gomock.Call {
 
 // ForeignFour mocks base method.
 func (m *MockIndex) ForeignFour(arg0 imp_four.Imp4) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "ForeignFour", arg0)
 }
@@ -134,6 +160,9 @@ func (mr *MockIndexMockRecorder) ForeignFour(arg0 any) *gomock.Call {
 
 // ForeignOne mocks base method.
 func (m *MockIndex) ForeignOne(arg0 imp1.Imp1) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "ForeignOne", arg0)
 }
@@ -146,6 +175,9 @@ func (mr *MockIndexMockRecorder) ForeignOne(arg0 any) *gomock.Call {
 
 // ForeignThree mocks base method.
 func (m *MockIndex) ForeignThree(arg0 imp3.Imp3) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "ForeignThree", arg0)
 }
@@ -158,6 +190,9 @@ func (mr *MockIndexMockRecorder) ForeignThree(arg0 any) *gomock.Call {
 
 // ForeignTwo mocks base method.
 func (m *MockIndex) ForeignTwo(arg0 imp2.Imp2) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "ForeignTwo", arg0)
 }
@@ -170,6 +205,9 @@ func (mr *MockIndexMockRecorder) ForeignTwo(arg0 any) *gomock.Call {
 
 // Func mocks base method.
 func (m *MockIndex) Func(arg0 func(http.Request) (int, bool)) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Func", arg0)
 }
@@ -182,6 +220,9 @@ func (mr *MockIndexMockRecorder) Func(arg0 any) *gomock.Call {
 
 // Get mocks base method.
 func (m *MockIndex) Get(arg0 string) any {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Get", arg0)
 	ret0, _ := ret[0].(any)
@@ -196,6 +237,9 @@ func (mr *MockIndexMockRecorder) Get(arg0 any) *gomock.Call {
 
 // GetTwo mocks base method.
 func (m *MockIndex) GetTwo(arg0, arg1 string) (any, any) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTwo", arg0, arg1)
 	ret0, _ := ret[0].(any)
@@ -211,6 +255,9 @@ func (mr *MockIndexMockRecorder) GetTwo(arg0, arg1 any) *gomock.Call {
 
 // Map mocks base method.
 func (m *MockIndex) Map(arg0 map[int]hash.Hash) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Map", arg0)
 }
@@ -223,6 +270,9 @@ func (mr *MockIndexMockRecorder) Map(arg0 any) *gomock.Call {
 
 // NillableRet mocks base method.
 func (m *MockIndex) NillableRet() error {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NillableRet")
 	ret0, _ := ret[0].(error)
@@ -237,6 +287,9 @@ func (mr *MockIndexMockRecorder) NillableRet() *gomock.Call {
 
 // Other mocks base method.
 func (m *MockIndex) Other() hash.Hash {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Other")
 	ret0, _ := ret[0].(hash.Hash)
@@ -251,6 +304,9 @@ func (mr *MockIndexMockRecorder) Other() *gomock.Call {
 
 // Ptr mocks base method.
 func (m *MockIndex) Ptr(arg0 *int) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Ptr", arg0)
 }
@@ -263,6 +319,9 @@ func (mr *MockIndexMockRecorder) Ptr(arg0 any) *gomock.Call {
 
 // Put mocks base method.
 func (m *MockIndex) Put(arg0 string, arg1 any) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Put", arg0, arg1)
 }
@@ -275,6 +334,9 @@ func (mr *MockIndexMockRecorder) Put(arg0, arg1 any) *gomock.Call {
 
 // Slice mocks base method.
 func (m *MockIndex) Slice(arg0 []int, arg1 []byte) [3]int {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Slice", arg0, arg1)
 	ret0, _ := ret[0].([3]int)
@@ -289,6 +351,9 @@ func (mr *MockIndexMockRecorder) Slice(arg0, arg1 any) *gomock.Call {
 
 // Struct mocks base method.
 func (m *MockIndex) Struct(arg0 struct{}) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Struct", arg0)
 }
@@ -301,6 +366,9 @@ func (mr *MockIndexMockRecorder) Struct(arg0 any) *gomock.Call {
 
 // StructChan mocks base method.
 func (m *MockIndex) StructChan(arg0 chan struct{}) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "StructChan", arg0)
 }
@@ -313,6 +381,9 @@ func (mr *MockIndexMockRecorder) StructChan(arg0 any) *gomock.Call {
 
 // Summary mocks base method.
 func (m *MockIndex) Summary(arg0 *bytes.Buffer, arg1 io.Writer) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Summary", arg0, arg1)
 }
@@ -325,6 +396,9 @@ func (mr *MockIndexMockRecorder) Summary(arg0, arg1 any) *gomock.Call {
 
 // Templates mocks base method.
 func (m *MockIndex) Templates(arg0 template.CSS, arg1 template0.FuncMap) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockIndex; create it with NewMockIndex")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Templates", arg0, arg1)
 }
@@ -355,11 +429,22 @@ func NewMockEmbed(ctrl *gomock.Controller) *MockEmbed {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockEmbed) EXPECT() *MockEmbedMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockEmbed; create it with NewMockEmbed")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockEmbed) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // EmbeddedMethod mocks base method.
 func (m *MockEmbed) EmbeddedMethod() {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockEmbed; create it with NewMockEmbed")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "EmbeddedMethod")
 }
@@ -372,6 +457,9 @@ func (mr *MockEmbedMockRecorder) EmbeddedMethod() *gomock.Call {
 
 // ForeignEmbeddedMethod mocks base method.
 func (m *MockEmbed) ForeignEmbeddedMethod() *bufio.Reader {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockEmbed; create it with NewMockEmbed")
+	}
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ForeignEmbeddedMethod")
 	ret0, _ := ret[0].(*bufio.Reader)
@@ -386,6 +474,9 @@ func (mr *MockEmbedMockRecorder) ForeignEmbeddedMethod() *gomock.Call {
 
 // ImplicitPackage mocks base method.
 func (m *MockEmbed) ImplicitPackage(arg0 string, arg1 imp1.ImpT, arg2 []imp1.ImpT, arg3 *imp1.ImpT, arg4 chan imp1.ImpT) {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockEmbed; create it with NewMockEmbed")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "ImplicitPackage", arg0, arg1, arg2, arg3, arg4)
 }
@@ -398,6 +489,9 @@ func (mr *MockEmbedMockRecorder) ImplicitPackage(arg0, arg1, arg2, arg3, arg4 an
 
 // RegularMethod mocks base method.
 func (m *MockEmbed) RegularMethod() {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockEmbed; create it with NewMockEmbed")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "RegularMethod")
 }
@@ -428,11 +522,22 @@ func NewMockEmbedded(ctrl *gomock.Controller) *MockEmbedded {
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockEmbedded) EXPECT() *MockEmbeddedMockRecorder {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockEmbedded; create it with NewMockEmbedded")
+	}
 	return m.recorder
 }
 
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockEmbedded) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
 // EmbeddedMethod mocks base method.
 func (m *MockEmbedded) EmbeddedMethod() {
+	if m == nil || m.ctrl == nil {
+		panic("gomock: method called on a nil or uninitialized *MockEmbedded; create it with NewMockEmbedded")
+	}
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "EmbeddedMethod")
 }